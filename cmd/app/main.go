@@ -44,6 +44,12 @@ func main() {
 	// Carregar variáveis de ambiente
 	configs.LoadEnv()
 
+	// Carregar configurações da aplicação
+	cfg, err := configs.Load()
+	if err != nil {
+		log.Fatal("Failed to load configuration:", err)
+	}
+
 	// Conectar ao banco de dados
 	ctx := context.Background()
 	db, err := configs.ConnectDB(ctx)
@@ -52,14 +58,20 @@ func main() {
 	}
 	defer db.Close()
 
-	// Obter JWT secret do ambiente
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "your-secret-key" // Fallback para desenvolvimento
-	}
-
 	// Criar container de dependências
-	container := container.NewContainer(db, jwtSecret)
+	container := container.NewContainer(db, cfg)
+
+	// Iniciar workers de execução de testes
+	container.WorkerPool.Start(ctx)
+
+	// Iniciar o envio de webhooks
+	container.WebhookDispatcher.Start(ctx)
+
+	// Iniciar o agendador de execuções
+	container.Scheduler.Start(ctx)
+
+	// Iniciar a limpeza das revogações de tokens expiradas
+	container.RevocationStore.Start(ctx)
 
 	// Configurar Gin
 	gin.SetMode(gin.ReleaseMode)
@@ -114,5 +126,17 @@ func main() {
 		log.Fatal("❌ Server forced to shutdown:", err)
 	}
 
+	// Encerrar o agendador antes dos workers para não enfileirar novas execuções
+	container.Scheduler.Stop()
+
+	// Encerrar workers; execuções em andamento voltam para a fila
+	container.WorkerPool.Stop()
+
+	// Encerrar a limpeza das revogações de tokens
+	container.RevocationStore.Stop()
+
+	// Encerrar o envio de webhooks por último; entregas pendentes são retomadas no próximo início
+	container.WebhookDispatcher.Stop()
+
 	log.Println("✅ Server exited gracefully")
 }
@@ -16,6 +16,19 @@ func main() {
 		&database_models.TestRun{},
 		&database_models.TestSuite{},
 		&database_models.TestResult{},
+		&database_models.TestSuiteAssertion{},
+		&database_models.TestSuiteExtraction{},
+		&database_models.JSONSchema{},
+		&database_models.Environment{},
+		&database_models.Secret{},
+		&database_models.Schedule{},
+		&database_models.Webhook{},
+		&database_models.WebhookDelivery{},
+		&database_models.ChatChannel{},
+		&database_models.RefreshToken{},
+		&database_models.TokenRevocation{},
+		&database_models.Session{},
+		&database_models.CompanyMember{},
 	)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to load gorm schema: %v\n", err)
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
@@ -45,3 +48,111 @@ func ConnectDB(ctx context.Context) (*pgxpool.Pool, error) {
 	
 	return pool, nil
 }
+
+// Config agrupa as configurações da aplicação lidas do ambiente
+type Config struct {
+	JWTSecret        string
+	SecretsMasterKey string
+	Runner           RunnerConfig
+	SMTP             SMTPConfig
+}
+
+// RunnerConfig agrupa as configurações de execução de testes
+type RunnerConfig struct {
+	RequestTimeout     time.Duration
+	Workers            int
+	CompanyConcurrency int
+	SchedulerInterval  time.Duration
+}
+
+// SMTPConfig agrupa as configurações de envio de alertas por email.
+// Host vazio desativa os alertas.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// Load lê as configurações do ambiente, aplicando valores padrão.
+// SECRETS_MASTER_KEY só tem valor padrão quando APP_ENV indica
+// desenvolvimento ou teste; fora disso a ausência é um erro.
+func Load() (*Config, error) {
+	jwtSecret := os.Getenv("JWT_SECRET")
+	if jwtSecret == "" {
+		jwtSecret = "your-secret-key" // Fallback para desenvolvimento
+	}
+
+	secretsMasterKey := os.Getenv("SECRETS_MASTER_KEY")
+	if secretsMasterKey == "" {
+		if !isDevelopmentEnv() {
+			return nil, errors.New("SECRETS_MASTER_KEY não definida (defina APP_ENV=development ou APP_ENV=test para usar a chave de desenvolvimento)")
+		}
+		log.Println("Aviso: SECRETS_MASTER_KEY não definida, usando chave de desenvolvimento")
+		secretsMasterKey = "dev-secrets-master-key" // Fallback para desenvolvimento
+	}
+
+	return &Config{
+		JWTSecret:        jwtSecret,
+		SecretsMasterKey: secretsMasterKey,
+		Runner: RunnerConfig{
+			RequestTimeout:     getEnvDuration("RUNNER_REQUEST_TIMEOUT", 30*time.Second),
+			Workers:            getEnvInt("RUNNER_WORKERS", 4),
+			CompanyConcurrency: getEnvInt("RUNNER_COMPANY_CONCURRENCY", 2),
+			SchedulerInterval:  getEnvDuration("RUNNER_SCHEDULER_INTERVAL", 15*time.Second),
+		},
+		SMTP: SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     getEnvInt("SMTP_PORT", 587),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     getEnv("SMTP_FROM", "EzTest <no-reply@eztest.local>"),
+		},
+	}, nil
+}
+
+// isDevelopmentEnv indica se APP_ENV declara explicitamente um ambiente de desenvolvimento ou teste
+func isDevelopmentEnv() bool {
+	switch strings.ToLower(os.Getenv("APP_ENV")) {
+	case "development", "dev", "test":
+		return true
+	}
+	return false
+}
+
+// getEnv lê uma variável do ambiente ou retorna o valor padrão
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getEnvInt lê uma variável inteira do ambiente ou retorna o valor padrão
+func getEnvInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Aviso: valor inválido para %s (%q), usando %d", key, value, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvDuration lê uma duração (ex.: "30s") do ambiente ou retorna o valor padrão
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Aviso: valor inválido para %s (%q), usando %s", key, value, fallback)
+		return fallback
+	}
+	return parsed
+}
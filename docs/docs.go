@@ -26,7 +26,7 @@ const docTemplate = `{
     "paths": {
         "/auth/login": {
             "post": {
-                "description": "Autentica um usuário e retorna tokens JWT",
+                "description": "Autentica um usuário e retorna o access token JWT e o refresh token usado para renová-lo",
                 "consumes": [
                     "application/json"
                 ],
@@ -87,7 +87,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Realiza logout do usuário (remove token no frontend)",
+                "description": "Revoga o access token usado na requisição e os refresh tokens da mesma sessão",
                 "consumes": [
                     "application/json"
                 ],
@@ -105,13 +105,32 @@ const docTemplate = `{
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "401": {
+                        "description": "Token inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     }
                 }
             }
         },
-        "/auth/refresh": {
+        "/auth/logout-all": {
             "post": {
-                "description": "Renova o token de acesso usando refresh token",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoga todos os access tokens e refresh tokens do usuário, encerrando as sessões em todos os dispositivos",
                 "consumes": [
                     "application/json"
                 ],
@@ -121,28 +140,24 @@ const docTemplate = `{
                 "tags": [
                     "auth"
                 ],
-                "summary": "Renovar token de acesso",
-                "parameters": [
-                    {
-                        "description": "Refresh token",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.RefreshTokenRequest"
-                        }
-                    }
-                ],
+                "summary": "Sair de todas as sessões",
                 "responses": {
                     "200": {
-                        "description": "Token renovado com sucesso",
+                        "description": "Sessões encerradas com sucesso",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "501": {
-                        "description": "Funcionalidade não implementada",
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -151,9 +166,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
+        "/auth/refresh": {
             "post": {
-                "description": "Cria uma nova conta de usuário no sistema",
+                "description": "Troca o refresh token por um novo par de tokens, com as claims atualizadas do usuário.\nCada refresh token só pode ser usado uma vez; reutilizar um token já usado revoga todos os tokens daquele login",
                 "consumes": [
                     "application/json"
                 ],
@@ -163,21 +178,21 @@ const docTemplate = `{
                 "tags": [
                     "auth"
                 ],
-                "summary": "Registrar novo usuário",
+                "summary": "Renovar token de acesso",
                 "parameters": [
                     {
-                        "description": "Dados do usuário",
+                        "description": "Refresh token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.RegisterRequest"
+                            "$ref": "#/definitions/handlers.RefreshTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Usuário registrado com sucesso",
+                    "200": {
+                        "description": "Token renovado com sucesso",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -190,8 +205,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "409": {
-                        "description": "Usuário já existe",
+                    "401": {
+                        "description": "Refresh token inválido, expirado ou revogado",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -207,14 +222,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/companies": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retorna uma lista paginada de empresas",
+        "/auth/register": {
+            "post": {
+                "description": "Cria uma nova conta de usuário no sistema",
                 "consumes": [
                     "application/json"
                 ],
@@ -222,28 +232,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companies"
+                    "auth"
                 ],
-                "summary": "Listar empresas",
+                "summary": "Registrar novo usuário",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Limite de resultados",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset para paginação",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Dados do usuário",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RegisterRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Lista de empresas",
+                    "201": {
+                        "description": "Usuário registrado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Usuário já existe",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -257,14 +276,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/chat-channels": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova empresa no sistema",
+                "description": "Cadastra um webhook de entrada do Slack (Block Kit) ou do Microsoft Teams (Adaptive Card) que recebe\no resumo das execuções encerradas. A URL do webhook é gravada cifrada e não é devolvida pela API",
                 "consumes": [
                     "application/json"
                 ],
@@ -272,26 +293,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companies"
+                    "chat-channels"
                 ],
-                "summary": "Criar nova empresa",
+                "summary": "Criar canal de chat",
                 "parameters": [
                     {
-                        "description": "Dados da empresa",
+                        "description": "Dados do canal",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateCompanyRequest"
+                            "$ref": "#/definitions/handlers.CreateChatChannelRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Empresa criada com sucesso",
+                        "description": "Canal criado com sucesso",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/entities.ChatChannel"
                         }
                     },
                     "400": {
@@ -301,6 +321,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Erro interno do servidor",
                         "schema": {
@@ -311,14 +338,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/companies/{company_id}/test-suites": {
+        "/chat-channels/company/{companyId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna todas as suítes de teste de uma empresa específica",
+                "description": "Retorna os canais de chat cadastrados pela empresa",
                 "consumes": [
                     "application/json"
                 ],
@@ -326,25 +353,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test-suites"
+                    "chat-channels"
                 ],
-                "summary": "Obter suítes de teste por empresa",
+                "summary": "Listar canais de chat da empresa",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "ID da empresa",
-                        "name": "company_id",
+                        "name": "companyId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Suítes de teste da empresa",
+                        "description": "Canais da empresa",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/entities.TestSuite"
+                                "$ref": "#/definitions/entities.ChatChannel"
                             }
                         }
                     },
@@ -365,14 +392,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/companies/{id}": {
+        "/chat-channels/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados de uma empresa específica pelo ID",
+                "description": "Retorna um canal de chat; a URL do webhook não é devolvida",
                 "consumes": [
                     "application/json"
                 ],
@@ -380,13 +407,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companies"
+                    "chat-channels"
                 ],
-                "summary": "Obter empresa por ID",
+                "summary": "Obter canal de chat por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da empresa",
+                        "description": "ID do canal",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -394,10 +421,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Dados da empresa",
+                        "description": "Canal encontrado",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/entities.ChatChannel"
                         }
                     },
                     "400": {
@@ -408,7 +434,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Empresa não encontrada",
+                        "description": "Canal não encontrado",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -422,7 +448,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza os dados de uma empresa existente",
+                "description": "Atualiza o nome, a URL do webhook, o filtro de falhas ou o estado de um canal de chat",
                 "consumes": [
                     "application/json"
                 ],
@@ -430,13 +456,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companies"
+                    "chat-channels"
                 ],
-                "summary": "Atualizar empresa",
+                "summary": "Atualizar canal de chat",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da empresa",
+                        "description": "ID do canal",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -447,16 +473,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateCompanyRequest"
+                            "$ref": "#/definitions/handlers.UpdateChatChannelRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Empresa atualizada com sucesso",
+                        "description": "Canal atualizado com sucesso",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/entities.ChatChannel"
                         }
                     },
                     "400": {
@@ -466,6 +491,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Canal não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Erro interno do servidor",
                         "schema": {
@@ -481,7 +513,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove uma empresa do sistema",
+                "description": "Remove um canal de chat",
                 "consumes": [
                     "application/json"
                 ],
@@ -489,13 +521,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companies"
+                    "chat-channels"
                 ],
-                "summary": "Deletar empresa",
+                "summary": "Deletar canal de chat",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da empresa",
+                        "description": "ID do canal",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -503,7 +535,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Empresa deletada com sucesso",
+                        "description": "Canal deletado com sucesso",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -516,6 +548,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Canal não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Erro interno do servidor",
                         "schema": {
@@ -526,14 +565,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/test-suites": {
-            "get": {
+        "/chat-channels/{id}/test": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna uma lista paginada de suítes de teste",
+                "description": "Publica uma mensagem de teste no canal, mesmo que esteja desativado",
                 "consumes": [
                     "application/json"
                 ],
@@ -541,16 +580,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test-suites"
+                    "chat-channels"
                 ],
-                "summary": "Listar suítes de teste",
+                "summary": "Enviar mensagem de teste",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da empresa para filtrar",
-                        "name": "company_id",
-                        "in": "query"
+                        "description": "ID do canal",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mensagem de teste enviada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Canal não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     },
+                    "502": {
+                        "description": "O chat recusou a mensagem",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada das empresas das quais o usuário autenticado é membro",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Listar empresas",
+                "parameters": [
                     {
                         "type": "integer",
                         "default": 10,
@@ -568,12 +660,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lista de suítes de teste",
+                        "description": "Lista de empresas",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/entities.TestSuite"
-                            }
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
@@ -591,7 +681,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cria uma nova suíte de teste no sistema",
+                "description": "Cria uma nova empresa no sistema; o usuário autenticado se torna o owner",
                 "consumes": [
                     "application/json"
                 ],
@@ -599,25 +689,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test-suites"
+                    "companies"
                 ],
-                "summary": "Criar nova suíte de teste",
+                "summary": "Criar nova empresa",
                 "parameters": [
                     {
-                        "description": "Dados da suíte de teste",
+                        "description": "Dados da empresa",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.CreateTestSuiteRequest"
+                            "$ref": "#/definitions/handlers.CreateCompanyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Suíte de teste criada com sucesso",
+                        "description": "Empresa criada com sucesso",
                         "schema": {
-                            "$ref": "#/definitions/entities.TestSuite"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -637,14 +728,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/test-suites/{id}": {
+        "/companies/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados de uma suíte de teste específica pelo ID",
+                "description": "Retorna os dados de uma empresa específica pelo ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -652,13 +743,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test-suites"
+                    "companies"
                 ],
-                "summary": "Obter suíte de teste por ID",
+                "summary": "Obter empresa por ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da suíte de teste",
+                        "description": "ID da empresa",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -666,9 +757,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Suíte de teste encontrada",
+                        "description": "Dados da empresa",
                         "schema": {
-                            "$ref": "#/definitions/entities.TestSuite"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -679,7 +771,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Suíte de teste não encontrada",
+                        "description": "Empresa não encontrada ou usuário não é membro dela",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -693,7 +785,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza os dados de uma suíte de teste existente",
+                "description": "Atualiza os dados de uma empresa existente",
                 "consumes": [
                     "application/json"
                 ],
@@ -701,13 +793,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test-suites"
+                    "companies"
                 ],
-                "summary": "Atualizar suíte de teste",
+                "summary": "Atualizar empresa",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da suíte de teste",
+                        "description": "ID da empresa",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -718,15 +810,16 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.UpdateTestSuiteRequest"
+                            "$ref": "#/definitions/handlers.UpdateCompanyRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Suíte de teste atualizada com sucesso",
+                        "description": "Empresa atualizada com sucesso",
                         "schema": {
-                            "$ref": "#/definitions/entities.TestSuite"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
@@ -736,6 +829,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Papel sem permissão para editar a empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Erro interno do servidor",
                         "schema": {
@@ -751,7 +851,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove uma suíte de teste do sistema",
+                "description": "Remove uma empresa do sistema. Apenas owners podem excluir a empresa",
                 "consumes": [
                     "application/json"
                 ],
@@ -759,13 +859,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "test-suites"
+                    "companies"
                 ],
-                "summary": "Deletar suíte de teste",
+                "summary": "Deletar empresa",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da suíte de teste",
+                        "description": "ID da empresa",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -773,7 +873,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Suíte de teste deletada com sucesso",
+                        "description": "Empresa deletada com sucesso",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -786,6 +886,13 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Apenas owners podem excluir a empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Erro interno do servidor",
                         "schema": {
@@ -796,14 +903,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/companies/{id}/environments": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna uma lista paginada de usuários",
+                "description": "Retorna os ambientes da empresa ordenados por nome",
                 "consumes": [
                     "application/json"
                 ],
@@ -811,28 +918,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "environments"
                 ],
-                "summary": "Listar usuários",
+                "summary": "Listar ambientes da empresa",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Limite de resultados",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset para paginação",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lista de usuários",
+                        "description": "Ambientes da empresa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.Environment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -846,16 +955,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/change-password": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Altera a senha do usuário autenticado",
+                "description": "Cria um ambiente da empresa com as variáveis usadas em {{variável}} nas suítes de teste",
                 "consumes": [
                     "application/json"
                 ],
@@ -863,26 +970,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "environments"
                 ],
-                "summary": "Alterar senha do usuário",
+                "summary": "Criar ambiente",
                 "parameters": [
                     {
-                        "description": "Dados para alteração de senha",
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do ambiente",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.ChangePasswordRequest"
+                            "$ref": "#/definitions/handlers.CreateEnvironmentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Senha alterada com sucesso",
+                    "201": {
+                        "description": "Ambiente criado com sucesso",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/entities.Environment"
                         }
                     },
                     "400": {
@@ -892,8 +1005,22 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Usuário não autenticado",
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Nome de ambiente já existe",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -902,14 +1029,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/link-company/{company_id}": {
-            "post": {
+        "/companies/{id}/environments/{environmentId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Vincula uma empresa específica ao usuário atualmente autenticado",
+                "description": "Retorna um ambiente da empresa com suas variáveis",
                 "consumes": [
                     "application/json"
                 ],
@@ -917,58 +1044,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "environments"
                 ],
-                "summary": "Vincular empresa ao usuário logado",
+                "summary": "Obter ambiente por ID",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "ID da empresa",
-                        "name": "company_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do ambiente",
+                        "name": "environmentId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Empresa vinculada com sucesso",
+                        "description": "Ambiente encontrado",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/entities.Environment"
                         }
                     },
                     "400": {
-                        "description": "ID inválido ou usuário já vinculado",
+                        "description": "ID inválido",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "404": {
-                        "description": "Empresa não encontrada",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "500": {
-                        "description": "Erro interno do servidor",
+                        "description": "Ambiente não encontrado",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     }
                 }
-            }
-        },
-        "/users/profile": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados do perfil do usuário autenticado",
+                "description": "Atualiza o nome e/ou substitui as variáveis de um ambiente",
                 "consumes": [
                     "application/json"
                 ],
@@ -976,26 +1100,57 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "environments"
+                ],
+                "summary": "Atualizar ambiente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do ambiente",
+                        "name": "environmentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateEnvironmentRequest"
+                        }
+                    }
                 ],
-                "summary": "Obter perfil do usuário",
                 "responses": {
                     "200": {
-                        "description": "Perfil do usuário",
+                        "description": "Ambiente atualizado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Environment"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Usuário não autenticado",
+                    "404": {
+                        "description": "Ambiente não encontrado",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Usuário não encontrado",
+                    "409": {
+                        "description": "Nome de ambiente já existe",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1010,13 +1165,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Atualiza os dados do perfil do usuário autenticado",
+                "description": "Remove um ambiente da empresa",
                 "consumes": [
                     "application/json"
                 ],
@@ -1024,37 +1179,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "environments"
                 ],
-                "summary": "Atualizar perfil do usuário",
+                "summary": "Deletar ambiente",
                 "parameters": [
                     {
-                        "description": "Dados para atualização",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UpdateProfileRequest"
-                        }
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do ambiente",
+                        "name": "environmentId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Perfil atualizado com sucesso",
+                        "description": "Ambiente deletado com sucesso",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Dados inválidos",
+                        "description": "ID inválido",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Usuário não autenticado",
+                    "404": {
+                        "description": "Ambiente não encontrado",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1068,34 +1228,67 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/companies/{id}/imports/curl": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a conta do usuário autenticado",
+                "description": "Converte um ou mais comandos curl colados como texto (inclusive o \"Copy as cURL\" dos navegadores) em\nsuítes de teste da empresa, com método, URL, cabeçalhos, cookies, autenticação básica e corpo",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "imports"
+                ],
+                "summary": "Importar comandos curl",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comandos curl",
+                        "name": "command",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 ],
-                "summary": "Deletar perfil do usuário",
                 "responses": {
-                    "200": {
-                        "description": "Perfil deletado com sucesso",
+                    "201": {
+                        "description": "Relatório da importação",
+                        "schema": {
+                            "$ref": "#/definitions/services.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Comando inválido",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Usuário não autenticado",
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo muito grande",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1111,14 +1304,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
+        "/companies/{id}/imports/har": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os dados de um usuário específico pelo ID",
+                "description": "Converte as requisições de um arquivo HAR 1.2 (gravação do navegador) em suítes de teste da empresa,\ncom método, URL, cabeçalhos, cookies e corpo; o status gravado na resposta vira o status esperado.\nRecursos estáticos da página (imagens, scripts, estilos, fontes) e requisições repetidas são ignorados",
                 "consumes": [
                     "application/json"
                 ],
@@ -1126,21 +1319,256 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "imports"
                 ],
-                "summary": "Obter usuário por ID",
+                "summary": "Importar gravação HAR",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do usuário",
+                        "description": "ID da empresa",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Arquivo HAR 1.2",
+                        "name": "har",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Dados do usuário",
+                    "201": {
+                        "description": "Relatório da importação",
+                        "schema": {
+                            "$ref": "#/definitions/services.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Arquivo inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo muito grande",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/imports/openapi": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera uma suíte de teste por operação de um documento OpenAPI 3.0/3.1 (JSON ou YAML): método e URL do\ncaminho, corpo a partir dos exemplos (ou gerado pelo schema), status esperado da primeira resposta 2xx\ne schema da resposta. A URL do primeiro servidor é gravada como baseUrl no ambiente informado.\nReimportar o documento atualiza as suítes geradas anteriormente, identificadas pelo operationId,\nmantendo as asserções, extrações e dependências configuradas",
+                "consumes": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Importar documento OpenAPI",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do ambiente que recebe as variáveis (padrão: título do documento)",
+                        "name": "environment",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Documento OpenAPI 3.0/3.1",
+                        "name": "document",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Relatório da importação",
+                        "schema": {
+                            "$ref": "#/definitions/services.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Documento inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo muito grande",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/imports/postman": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Converte uma coleção Postman v2.1 (JSON exportado) em suítes de teste da empresa. Cada requisição vira\numa suíte com o caminho das pastas no nome; as variáveis da coleção são gravadas no ambiente informado\n(criado se não existir, sem sobrescrever variáveis existentes). Scripts, arquivos e autenticações não\nsuportadas são listados em \"skipped\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Importar coleção do Postman",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do ambiente que recebe as variáveis (padrão: nome da coleção)",
+                        "name": "environment",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Coleção Postman v2.1",
+                        "name": "collection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Relatório da importação",
+                        "schema": {
+                            "$ref": "#/definitions/services.ImportReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Coleção inválida",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo muito grande",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/members": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os usuários vinculados à empresa e o papel de cada um (owner, admin, editor ou viewer)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Listar membros da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Membros da empresa",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -1153,44 +1581,4583 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "404": {
+                        "description": "Empresa não encontrada ou usuário não é membro dela",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Vincula um usuário existente à empresa com o papel informado. Apenas owners concedem o papel de owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Adicionar membro à empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Usuário e papel",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AddCompanyMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Membro adicionado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CompanyMember"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Papel sem permissão para gerenciar membros",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "404": {
                         "description": "Usuário não encontrado",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "409": {
+                        "description": "Usuário já é membro da empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/members/{userId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera o papel de um membro da empresa. Apenas owners concedem ou retiram o papel de owner,\ne a empresa não pode ficar sem owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Alterar papel de um membro",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo papel",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateCompanyMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Papel alterado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.CompanyMember"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Papel sem permissão para gerenciar membros",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Membro não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "A empresa ficaria sem owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Desvincula o usuário da empresa. Apenas owners removem outro owner, e a empresa não pode ficar sem owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companies"
+                ],
+                "summary": "Remover membro da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Membro removido com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Papel sem permissão para gerenciar membros",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Membro não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "A empresa ficaria sem owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/schedules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os agendamentos da empresa ordenados por nome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Listar agendamentos da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Agendamentos da empresa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.Schedule"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um agendamento cron que enfileira execuções das suítes selecionadas (ou de todas) da empresa.\nA expressão usa cinco campos (minuto hora dia mês dia-da-semana) ou atalhos como @hourly e @every 10m,\navaliada no fuso horário informado (padrão UTC)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Criar agendamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do agendamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Agendamento criado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa, suíte ou ambiente não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Nome de agendamento já existe",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/schedules/{scheduleId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna um agendamento da empresa com o próximo disparo previsto",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Obter agendamento por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do agendamento",
+                        "name": "scheduleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Agendamento encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Agendamento não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza a expressão, o fuso, as suítes, o ambiente ou o estado de um agendamento;\no próximo disparo é recalculado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Atualizar agendamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do agendamento",
+                        "name": "scheduleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Agendamento atualizado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Agendamento, suíte ou ambiente não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Nome de agendamento já existe",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um agendamento da empresa; as execuções já disparadas são mantidas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Deletar agendamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do agendamento",
+                        "name": "scheduleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Agendamento deletado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Agendamento não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/secrets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os metadados dos segredos da empresa ordenados por nome, sem os valores",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secrets"
+                ],
+                "summary": "Listar segredos da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Segredos da empresa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.Secret"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grava um segredo da empresa cifrado, referenciado nas suítes como {{secret.NOME}}. O valor nunca é devolvido pela API",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secrets"
+                ],
+                "summary": "Criar segredo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nome e valor do segredo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateSecretRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Segredo criado com sucesso (sem o valor)",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Secret"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Nome de segredo já existe",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/secrets/{secretId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os metadados de um segredo da empresa, sem o valor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secrets"
+                ],
+                "summary": "Obter segredo por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do segredo",
+                        "name": "secretId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Segredo encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Secret"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Segredo não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renomeia o segredo e/ou substitui (rotaciona) o seu valor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secrets"
+                ],
+                "summary": "Atualizar segredo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do segredo",
+                        "name": "secretId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateSecretRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Segredo atualizado com sucesso (sem o valor)",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Secret"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Segredo não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Nome de segredo já existe",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um segredo da empresa",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "secrets"
+                ],
+                "summary": "Deletar segredo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do segredo",
+                        "name": "secretId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Segredo deletado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Segredo não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/test-suites/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera um pacote determinístico (YAML ou JSON) com as suítes, asserções, extrações e ambientes da empresa,\npronto para ser versionado em git. Suítes e ambientes são ordenados e identificados pelo nome;\nsegredos não são exportados e schemas armazenados são referenciados pelo nome",
+                "produces": [
+                    "application/yaml",
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Exportar suítes da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "yaml",
+                            "json"
+                        ],
+                        "type": "string",
+                        "default": "yaml",
+                        "description": "Formato do pacote",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pacote das suítes",
+                        "schema": {
+                            "$ref": "#/definitions/services.TestSuiteBundle"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/companies/{id}/test-suites/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sincroniza as suítes e os ambientes da empresa com um pacote exportado (YAML ou JSON): itens são\ncomparados pelo nome, criados ou atualizados conforme o pacote, e os ausentes do pacote são removidos.\nImportar o mesmo pacote novamente não altera nada. Com dry_run=true apenas as diferenças são devolvidas",
+                "consumes": [
+                    "application/yaml",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Importar pacote de suítes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apenas calcular as diferenças, sem gravar",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Pacote das suítes",
+                        "name": "bundle",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/services.TestSuiteBundle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Diferenças aplicadas (ou que seriam aplicadas, em dry run)",
+                        "schema": {
+                            "$ref": "#/definitions/services.BundleDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Pacote inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "413": {
+                        "description": "Arquivo muito grande",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/json-schemas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os JSON Schemas armazenados pela empresa",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json-schemas"
+                ],
+                "summary": "Listar JSON Schemas da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "company_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schemas da empresa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.JSONSchema"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Armazena um JSON Schema (draft 2020-12) da empresa para validar respostas das suítes de teste",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json-schemas"
+                ],
+                "summary": "Criar JSON Schema",
+                "parameters": [
+                    {
+                        "description": "Dados do schema",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateJSONSchemaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Schema criado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.JSONSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos ou schema inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/json-schemas/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna um JSON Schema armazenado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json-schemas"
+                ],
+                "summary": "Obter JSON Schema por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do schema",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/entities.JSONSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Schema não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza nome, descrição ou conteúdo de um JSON Schema armazenado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json-schemas"
+                ],
+                "summary": "Atualizar JSON Schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do schema",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateJSONSchemaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema atualizado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.JSONSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos ou schema inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Schema não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um JSON Schema que não esteja referenciado por suítes de teste",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "json-schemas"
+                ],
+                "summary": "Deletar JSON Schema",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do schema",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Schema deletado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Schema não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Schema em uso por suítes de teste",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-runs": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma execução na fila para a empresa; as suítes selecionadas (ou todas) são executadas em background,\ncom as variáveis {{variável}} substituídas pelas do ambiente informado. Suítes cujas dependências não\nforam selecionadas são puladas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-runs"
+                ],
+                "summary": "Enfileirar execução de suítes de teste",
+                "parameters": [
+                    {
+                        "description": "Empresa e suítes a executar",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTestRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Execução enfileirada",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TestRun"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa, suíte ou ambiente não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-runs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o estado e os totais de uma execução de teste",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-runs"
+                ],
+                "summary": "Obter execução de teste por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da execução",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Execução encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TestRun"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Execução não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-runs/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancela uma execução na fila ou em andamento, interrompendo as requisições HTTP em curso",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-runs"
+                ],
+                "summary": "Cancelar execução de teste",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da execução",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Execução cancelada",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TestRun"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Execução não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Execução já finalizada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-runs/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera o relatório da execução a partir dos resultados gravados, com um caso de teste por suíte:\nas falhas trazem as mensagens das asserções e um trecho do corpo da resposta, e o tempo vem da\nduração da requisição. Os formatos JUnit e TAP podem ser lidos pelos relatórios do Jenkins e do GitLab",
+                "produces": [
+                    "application/xml",
+                    "text/plain",
+                    "application/json"
+                ],
+                "tags": [
+                    "test-runs"
+                ],
+                "summary": "Obter relatório de uma execução",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da execução",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "junit",
+                            "tap",
+                            "json"
+                        ],
+                        "type": "string",
+                        "default": "junit",
+                        "description": "Formato do relatório",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Entregar o relatório como anexo para download",
+                        "name": "download",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Relatório da execução",
+                        "schema": {
+                            "$ref": "#/definitions/services.TestRunReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Parâmetros inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Execução não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-runs/{id}/report.html": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera o relatório da execução em um único arquivo HTML, sem recursos externos, para compartilhar com quem\nnão tem acesso à plataforma: resumo dos totais, seções expansíveis por suíte com a requisição, os cabeçalhos\ne o corpo da resposta e as asserções, e barras com a latência de cada requisição",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "test-runs"
+                ],
+                "summary": "Obter relatório HTML de uma execução",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da execução",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Entregar o relatório como anexo para download em vez de exibi-lo no navegador",
+                        "name": "download",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Relatório HTML da execução",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Execução não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-runs/{id}/results": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o resultado de cada suíte executada na execução",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-runs"
+                ],
+                "summary": "Obter resultados de uma execução",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da execução",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Resultados da execução",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.TestResult"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Execução não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-suites": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada das suítes de teste de uma empresa da qual o usuário é membro",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Listar suítes de teste",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "company_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limite de resultados",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset para paginação",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de suítes de teste",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.TestSuite"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova suíte de teste no sistema",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Criar nova suíte de teste",
+                "parameters": [
+                    {
+                        "description": "Dados da suíte de teste",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateTestSuiteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Suíte de teste criada com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TestSuite"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Dependência ou schema referenciado não encontrado na empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-suites/company/{companyId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna todas as suítes de teste de uma empresa específica",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Obter suítes de teste por empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "companyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suítes de teste da empresa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.TestSuite"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/test-suites/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de uma suíte de teste específica pelo ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Obter suíte de teste por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da suíte de teste",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suíte de teste encontrada",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TestSuite"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Suíte de teste não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma suíte de teste existente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Atualizar suíte de teste",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da suíte de teste",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateTestSuiteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suíte de teste atualizada com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.TestSuite"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Suíte de teste, dependência ou schema referenciado não encontrado na empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove uma suíte de teste do sistema",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "test-suites"
+                ],
+                "summary": "Deletar suíte de teste",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da suíte de teste",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suíte de teste deletada com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Suíte de teste não encontrada ou de outra empresa",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma lista paginada do próprio usuário e dos usuários que compartilham alguma empresa com ele",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Listar usuários",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limite de resultados",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset para paginação",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lista de usuários",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/change-password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera a senha do usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Alterar senha do usuário",
+                "parameters": [
+                    {
+                        "description": "Dados para alteração de senha",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Senha alterada com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/link-company/{company_id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Vincula uma empresa específica ao usuário atualmente autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Vincular empresa ao usuário logado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "company_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Empresa vinculada com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido ou usuário já vinculado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada ou usuário não é membro dela",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados do perfil do usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Obter perfil do usuário",
+                "responses": {
+                    "200": {
+                        "description": "Perfil do usuário",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Usuário não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados do perfil do usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Atualizar perfil do usuário",
+                "parameters": [
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Perfil atualizado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a conta do usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Deletar perfil do usuário",
+                "responses": {
+                    "200": {
+                        "description": "Perfil deletado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista os dispositivos em que o usuário está logado, com o navegador, o endereço IP, o início e o último uso\nde cada sessão. A sessão do token usado na requisição é marcada como current",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Listar sessões do usuário",
+                "responses": {
+                    "200": {
+                        "description": "Sessões ativas do usuário",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Encerra uma sessão do usuário: o dispositivo perde o acesso imediatamente e não consegue renovar os tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Encerrar sessão",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da sessão",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sessão encerrada com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Usuário não autenticado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Sessão não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados de um usuário pelo ID. Só são visíveis o próprio usuário e quem compartilha alguma empresa com ele",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Obter usuário por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dados do usuário",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Usuário não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra uma URL da empresa para receber eventos de execução (test_run.finished, test_suite.failing,\ntest_suite.recovered). Cada entrega é assinada com HMAC-SHA256 sobre \"timestamp.corpo\" no cabeçalho\nX-EzTest-Signature; o segredo de assinatura é devolvido somente nesta resposta",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Criar webhook",
+                "parameters": [
+                    {
+                        "description": "Dados do webhook",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook criado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/services.CreateWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Empresa não encontrada",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/company/{companyId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os webhooks cadastrados pela empresa",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Listar webhooks da empresa",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da empresa",
+                        "name": "companyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhooks da empresa",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.Webhook"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna um webhook; o segredo de assinatura não é devolvido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Obter webhook por ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook encontrado",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza a URL, os eventos assinados ou o estado de um webhook",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Atualizar webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados para atualização",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook atualizado com sucesso",
+                        "schema": {
+                            "$ref": "#/definitions/entities.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Dados inválidos",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um webhook e o seu histórico de entregas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Deletar webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deletado com sucesso",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as entregas mais recentes do webhook com o histórico de tentativas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Listar entregas do webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de entregas (padrão 50, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entregas do webhook",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entities.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enfileira um evento \"ping\" assinado para o webhook, mesmo que esteja desativado;\no resultado pode ser acompanhado nas entregas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Enviar evento de teste",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Evento de teste enfileirado",
+                        "schema": {
+                            "$ref": "#/definitions/entities.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "ID inválido",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook não encontrado",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Erro interno do servidor",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entities.Assertion": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expected": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "target": {
+                    "type": "string",
+                    "example": "$.user.id"
+                },
+                "test_suite_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "type": {
+                    "enum": [
+                        "json_path_equals",
+                        "json_path_contains",
+                        "json_path_exists",
+                        "body_regex",
+                        "header_equals",
+                        "header_present",
+                        "response_time_under",
+                        "body_size_max",
+                        "body_size_min",
+                        "content_type"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.AssertionType"
+                        }
+                    ],
+                    "example": "json_path_equals"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.AssertionResult": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "string",
+                    "example": "41"
+                },
+                "assertion_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "expected": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "expected 42, got 41"
+                },
+                "passed": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "target": {
+                    "type": "string",
+                    "example": "$.user.id"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.AssertionType"
+                        }
+                    ],
+                    "example": "json_path_equals"
+                }
+            }
+        },
+        "entities.AssertionType": {
+            "type": "string",
+            "enum": [
+                "json_path_equals",
+                "json_path_contains",
+                "json_path_exists",
+                "body_regex",
+                "header_equals",
+                "header_present",
+                "response_time_under",
+                "body_size_max",
+                "body_size_min",
+                "content_type"
+            ],
+            "x-enum-varnames": [
+                "AssertionJSONPathEquals",
+                "AssertionJSONPathContains",
+                "AssertionJSONPathExists",
+                "AssertionBodyRegex",
+                "AssertionHeaderEquals",
+                "AssertionHeaderPresent",
+                "AssertionResponseTimeUnder",
+                "AssertionBodySizeMax",
+                "AssertionBodySizeMin",
+                "AssertionContentType"
+            ]
+        },
+        "entities.ChatChannel": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "#qa-alerts"
+                },
+                "only_failures": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "provider": {
+                    "enum": [
+                        "slack",
+                        "teams"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ChatProvider"
+                        }
+                    ],
+                    "example": "slack"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.ChatProvider": {
+            "type": "string",
+            "enum": [
+                "slack",
+                "teams"
+            ],
+            "x-enum-varnames": [
+                "ChatProviderSlack",
+                "ChatProviderTeams"
+            ]
+        },
+        "entities.CompanyMember": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CompanyRole"
+                        }
+                    ],
+                    "example": "editor"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "entities.CompanyRole": {
+            "type": "string",
+            "enum": [
+                "owner",
+                "admin",
+                "editor",
+                "viewer"
+            ],
+            "x-enum-varnames": [
+                "CompanyRoleOwner",
+                "CompanyRoleAdmin",
+                "CompanyRoleEditor",
+                "CompanyRoleViewer"
+            ]
+        },
+        "entities.Environment": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "staging"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entities.ExecutedRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "{\"username\": \"admin\"}"
+                },
+                "form_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "method": {
+                    "type": "string",
+                    "example": "POST"
+                },
+                "query_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://api.example.com/login"
+                }
+            }
+        },
+        "entities.Extraction": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "expression": {
+                    "type": "string",
+                    "example": "$.access_token"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "token"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "source": {
+                    "enum": [
+                        "json_path",
+                        "header",
+                        "regex",
+                        "cookie"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ExtractionSource"
+                        }
+                    ],
+                    "example": "json_path"
+                },
+                "test_suite_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.ExtractionSource": {
+            "type": "string",
+            "enum": [
+                "json_path",
+                "header",
+                "regex",
+                "cookie"
+            ],
+            "x-enum-varnames": [
+                "ExtractionJSONPath",
+                "ExtractionHeader",
+                "ExtractionRegex",
+                "ExtractionCookie"
+            ]
+        },
+        "entities.JSONSchema": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Schema da resposta de GET /users/{id}"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "User response"
+                },
+                "schema": {
+                    "type": "object"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.RequestBodyType": {
+            "type": "string",
+            "enum": [
+                "none",
+                "json",
+                "form",
+                "multipart",
+                "raw"
+            ],
+            "x-enum-varnames": [
+                "RequestBodyNone",
+                "RequestBodyJSON",
+                "RequestBodyForm",
+                "RequestBodyMultipart",
+                "RequestBodyRaw"
+            ]
+        },
+        "entities.Schedule": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "cron_expression": {
+                    "type": "string",
+                    "example": "*/5 * * * *"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "environment_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Monitoramento de produção"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "test_suite_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.SchemaViolation": {
+            "type": "object",
+            "properties": {
+                "instance_path": {
+                    "type": "string",
+                    "example": "/user/id"
+                },
+                "keyword_path": {
+                    "type": "string",
+                    "example": "/properties/user/properties/id/type"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "got string, want integer"
+                }
+            }
+        },
+        "entities.Secret": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "API_KEY"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.TestResult": {
+            "type": "object",
+            "properties": {
+                "assertion_results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.AssertionResult"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "error_message": {
+                    "type": "string",
+                    "example": ""
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "request": {
+                    "$ref": "#/definitions/entities.ExecutedRequest"
+                },
+                "response_body": {
+                    "type": "string",
+                    "example": "{\"success\": true}"
+                },
+                "response_body_truncated": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "response_headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "response_status": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "response_time_ms": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "schema_violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.SchemaViolation"
+                    }
+                },
+                "status": {
+                    "enum": [
+                        "passed",
+                        "failed",
+                        "errored",
+                        "skipped"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TestResultStatus"
+                        }
+                    ],
+                    "example": "passed"
+                },
+                "test_run_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "test_suite_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "test_suite_name": {
+                    "type": "string",
+                    "example": "API Login Test"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.TestResultStatus": {
+            "type": "string",
+            "enum": [
+                "passed",
+                "failed",
+                "errored",
+                "skipped"
+            ],
+            "x-enum-varnames": [
+                "TestResultStatusPassed",
+                "TestResultStatusFailed",
+                "TestResultStatusErrored",
+                "TestResultStatusSkipped"
+            ]
+        },
+        "entities.TestRun": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "environment_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "failed_tests": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "passed_tests": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "schedule_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "skipped_tests": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "enum": [
+                        "queued",
+                        "running",
+                        "passed",
+                        "failed",
+                        "errored",
+                        "cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TestRunStatus"
+                        }
+                    ],
+                    "example": "queued"
+                },
+                "test_suite_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "total_tests": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                }
+            }
+        },
+        "entities.TestRunStatus": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "passed",
+                "failed",
+                "errored",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "TestRunStatusQueued",
+                "TestRunStatusRunning",
+                "TestRunStatusPassed",
+                "TestRunStatusFailed",
+                "TestRunStatusErrored",
+                "TestRunStatusCancelled"
+            ]
+        },
+        "entities.TestSuite": {
+            "type": "object",
+            "properties": {
+                "assertions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Assertion"
+                    }
+                },
+                "body": {
+                    "type": "string",
+                    "example": "{\"username\": \"admin\"}"
+                },
+                "body_type": {
+                    "enum": [
+                        "none",
+                        "json",
+                        "form",
+                        "multipart",
+                        "raw"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.RequestBodyType"
+                        }
+                    ],
+                    "example": "json"
+                },
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "depends_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expected_body": {
+                    "type": "string",
+                    "example": "{\"success\": true}"
+                },
+                "expected_status": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "extractions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Extraction"
+                    }
+                },
+                "form_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "import_key": {
+                    "description": "ImportKey identifica a operação de origem das suítes importadas, para que a reimportação as atualize",
+                    "type": "string",
+                    "example": "openapi:createUser"
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "GET",
+                        "POST",
+                        "PUT",
+                        "DELETE",
+                        "PATCH"
+                    ],
+                    "example": "POST"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "API Login Test"
+                },
+                "query_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "response_schema": {
+                    "description": "Schema da resposta: inline (ResponseSchema) ou armazenado (ResponseSchemaID), nunca ambos",
+                    "type": "object"
+                },
+                "response_schema_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://api.example.com/login"
+                }
+            }
+        },
+        "entities.Webhook": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WebhookEvent"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.example.com/eztest"
+                }
+            }
+        },
+        "entities.WebhookAttempt": {
+            "type": "object",
+            "properties": {
+                "attempted_at": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WebhookAttempt"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.WebhookEvent"
+                        }
+                    ],
+                    "example": "test_run.finished"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "succeeded",
+                        "failed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.WebhookDeliveryStatus"
+                        }
+                    ],
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                }
+            }
+        },
+        "entities.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "entities.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "test_run.finished",
+                "test_suite.failing",
+                "test_suite.recovered",
+                "ping"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventTestRunFinished",
+                "WebhookEventTestSuiteFailing",
+                "WebhookEventTestSuiteRecovered",
+                "WebhookEventPing"
+            ]
+        },
+        "handlers.AddCompanyMemberRequest": {
+            "type": "object",
+            "required": [
+                "role",
+                "user_id"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "admin",
+                        "editor",
+                        "viewer"
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.AssertionRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "expected": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "json_path_equals",
+                        "json_path_contains",
+                        "json_path_exists",
+                        "body_regex",
+                        "header_equals",
+                        "header_present",
+                        "response_time_under",
+                        "body_size_max",
+                        "body_size_min",
+                        "content_type"
+                    ]
+                }
+            }
+        },
+        "handlers.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "handlers.CreateChatChannelRequest": {
+            "type": "object",
+            "required": [
+                "company_id",
+                "name",
+                "provider",
+                "webhook_url"
+            ],
+            "properties": {
+                "company_id": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "#qa-alerts"
+                },
+                "only_failures": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "slack",
+                        "teams"
+                    ],
+                    "example": "slack"
+                },
+                "webhook_url": {
+                    "type": "string",
+                    "example": "https://hooks.slack.com/services/T000/B000/XXXX"
+                }
+            }
+        },
+        "handlers.CreateCompanyRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 10
+                }
+            }
+        },
+        "handlers.CreateEnvironmentRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "variables"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.CreateJSONSchemaRequest": {
+            "type": "object",
+            "required": [
+                "company_id",
+                "name",
+                "schema"
+            ],
+            "properties": {
+                "company_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
+                "schema": {
+                    "type": "object"
+                }
+            }
+        },
+        "handlers.CreateScheduleRequest": {
+            "type": "object",
+            "required": [
+                "cron_expression",
+                "name"
+            ],
+            "properties": {
+                "cron_expression": {
+                    "type": "string",
+                    "example": "*/5 * * * *"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "environment_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Monitoramento de produção"
+                },
+                "test_suite_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                }
+            }
+        },
+        "handlers.CreateSecretRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "value"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateTestRunRequest": {
+            "type": "object",
+            "required": [
+                "company_id"
+            ],
+            "properties": {
+                "company_id": {
+                    "type": "string"
+                },
+                "environment_id": {
+                    "type": "string"
+                },
+                "test_suite_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.CreateTestSuiteRequest": {
+            "type": "object",
+            "required": [
+                "company_id",
+                "expected_status",
+                "form_fields",
+                "headers",
+                "method",
+                "name",
+                "query_params",
+                "url"
+            ],
+            "properties": {
+                "assertions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AssertionRequest"
+                    }
+                },
+                "body": {
+                    "type": "string"
+                },
+                "body_type": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "json",
+                        "form",
+                        "multipart",
+                        "raw"
+                    ]
+                },
+                "company_id": {
+                    "type": "string"
+                },
+                "depends_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expected_body": {
+                    "type": "string"
+                },
+                "expected_status": {
+                    "type": "integer",
+                    "maximum": 599,
+                    "minimum": 100
+                },
+                "extractions": {
+                    "description": "Valores salvos da resposta como {{extracted.nome}} e suítes que precisam passar antes desta",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ExtractionRequest"
+                    }
+                },
+                "form_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "GET",
+                        "POST",
+                        "PUT",
+                        "DELETE",
+                        "PATCH"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
+                "query_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "response_schema": {
+                    "description": "Schema da resposta: inline (JSON Schema) ou ID de um schema armazenado",
+                    "type": "object"
+                },
+                "response_schema_id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "company_id",
+                "url"
+            ],
+            "properties": {
+                "company_id": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.example.com/eztest"
+                }
+            }
+        },
+        "handlers.ExtractionRequest": {
+            "type": "object",
+            "required": [
+                "expression",
+                "name",
+                "source"
+            ],
+            "properties": {
+                "expression": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "json_path",
+                        "header",
+                        "regex",
+                        "cookie"
+                    ]
+                }
+            }
+        },
+        "handlers.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                }
+            }
+        },
+        "handlers.UpdateChatChannelRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "only_failures": {
+                    "type": "boolean"
+                },
+                "webhook_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateCompanyMemberRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "admin",
+                        "editor",
+                        "viewer"
+                    ]
+                }
+            }
+        },
+        "handlers.UpdateCompanyRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 10
+                }
+            }
+        },
+        "handlers.UpdateEnvironmentRequest": {
+            "type": "object",
+            "required": [
+                "variables"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entities.TestSuite": {
+        },
+        "handlers.UpdateJSONSchemaRequest": {
             "type": "object",
             "properties": {
-                "company_id": {
+                "description": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                    "maxLength": 500
                 },
-                "created_at": {
+                "name": {
                     "type": "string",
-                    "example": "2023-01-01T00:00:00Z"
+                    "maxLength": 100,
+                    "minLength": 3
                 },
-                "expected_body": {
+                "schema": {
+                    "type": "object"
+                }
+            }
+        },
+        "handlers.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "email_alerts": {
+                    "description": "Desativa (false) ou reativa (true) os alertas por email de execuções com falha",
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string",
-                    "example": "{\"success\": true}"
+                    "maxLength": 100,
+                    "minLength": 2
                 },
-                "expected_status": {
-                    "type": "integer",
-                    "example": 200
+                "username": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                }
+            }
+        },
+        "handlers.UpdateScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "cron_expression": {
+                    "type": "string"
                 },
-                "headers": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "environment_id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string",
-                    "example": "Content-Type: application/json"
+                    "maxLength": 100,
+                    "minLength": 1
                 },
-                "id": {
+                "test_suite_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateSecretRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateTestSuiteRequest": {
+            "type": "object",
+            "required": [
+                "form_fields",
+                "headers",
+                "query_params"
+            ],
+            "properties": {
+                "assertions": {
+                    "description": "Omitir mantém as asserções atuais; uma lista vazia remove todas",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.AssertionRequest"
+                    }
+                },
+                "body": {
+                    "type": "string"
+                },
+                "body_type": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "json",
+                        "form",
+                        "multipart",
+                        "raw"
+                    ]
+                },
+                "depends_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "expected_body": {
+                    "type": "string"
+                },
+                "expected_status": {
+                    "type": "integer",
+                    "maximum": 599,
+                    "minimum": 100
+                },
+                "extractions": {
+                    "description": "Omitir mantém as extrações e dependências atuais; uma lista vazia remove todas",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handlers.ExtractionRequest"
+                    }
+                },
+                "form_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "headers": {
+                    "description": "Campos omitidos mantêm os valores atuais",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
                 "method": {
                     "type": "string",
@@ -1200,233 +6167,494 @@ const docTemplate = `{
                         "PUT",
                         "DELETE",
                         "PATCH"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
+                "query_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "response_schema": {
+                    "description": "Omitir mantém o schema atual; null remove o schema inline e \"\" remove a referência",
+                    "type": "object"
+                },
+                "response_schema_id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.BundleAssertion": {
+            "type": "object",
+            "properties": {
+                "expected": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "$.user.id"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.AssertionType"
+                        }
                     ],
-                    "example": "POST"
+                    "example": "json_path_equals"
+                }
+            }
+        },
+        "services.BundleChange": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "url",
+                        "assertions"
+                    ]
                 },
                 "name": {
                     "type": "string",
                     "example": "API Login Test"
+                }
+            }
+        },
+        "services.BundleChanges": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2023-01-01T00:00:00Z"
+                "deleted": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "url": {
-                    "type": "string",
-                    "example": "https://api.example.com/login"
+                "unchanged": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "updated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.BundleChange"
+                    }
                 }
             }
         },
-        "handlers.ChangePasswordRequest": {
+        "services.BundleDiff": {
             "type": "object",
-            "required": [
-                "current_password",
-                "new_password"
-            ],
             "properties": {
-                "current_password": {
-                    "type": "string"
+                "dry_run": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "new_password": {
-                    "type": "string",
-                    "minLength": 6
+                "environments": {
+                    "$ref": "#/definitions/services.BundleChanges"
+                },
+                "test_suites": {
+                    "$ref": "#/definitions/services.BundleChanges"
                 }
             }
         },
-        "handlers.CreateCompanyRequest": {
+        "services.BundleEnvironment": {
             "type": "object",
-            "required": [
-                "email",
-                "name"
-            ],
             "properties": {
-                "address": {
+                "name": {
                     "type": "string",
-                    "maxLength": 255
+                    "example": "staging"
                 },
-                "email": {
-                    "type": "string"
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "services.BundleExtraction": {
+            "type": "object",
+            "properties": {
+                "expression": {
+                    "type": "string",
+                    "example": "$.access_token"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
+                    "example": "token"
                 },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 10
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ExtractionSource"
+                        }
+                    ],
+                    "example": "json_path"
                 }
             }
         },
-        "handlers.CreateTestSuiteRequest": {
+        "services.BundleTestSuite": {
             "type": "object",
-            "required": [
-                "company_id",
-                "expected_status",
-                "method",
-                "name",
-                "url"
-            ],
             "properties": {
-                "company_id": {
+                "assertions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.BundleAssertion"
+                    }
+                },
+                "body": {
                     "type": "string"
                 },
+                "body_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.RequestBodyType"
+                        }
+                    ],
+                    "example": "json"
+                },
+                "depends_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "expected_body": {
                     "type": "string"
                 },
                 "expected_status": {
                     "type": "integer",
-                    "maximum": 599,
-                    "minimum": 100
+                    "example": 200
+                },
+                "extractions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.BundleExtraction"
+                    }
+                },
+                "form_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
                 "headers": {
-                    "type": "string"
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "import_key": {
+                    "type": "string",
+                    "example": "openapi:createUser"
                 },
                 "method": {
                     "type": "string",
-                    "enum": [
-                        "GET",
-                        "POST",
-                        "PUT",
-                        "DELETE",
-                        "PATCH"
-                    ]
+                    "example": "POST"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
+                    "example": "API Login Test"
+                },
+                "query_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "response_schema": {
+                    "type": "object"
+                },
+                "response_schema_ref": {
+                    "type": "string",
+                    "example": "User response"
                 },
                 "url": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "{{baseUrl}}/login"
                 }
             }
         },
-        "handlers.LoginRequest": {
+        "services.CreateWebhookResponse": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
-                    "type": "string"
+                "company_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
                 },
-                "username": {
-                    "type": "string"
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WebhookEvent"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "whsec_3f1c..."
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.example.com/eztest"
                 }
             }
         },
-        "handlers.RefreshTokenRequest": {
+        "services.ImportIssue": {
             "type": "object",
-            "required": [
-                "refresh_token"
-            ],
             "properties": {
-                "refresh_token": {
-                    "type": "string"
+                "item": {
+                    "type": "string",
+                    "example": "Users / Create user"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "pre-request script was not imported"
                 }
             }
         },
-        "handlers.RegisterRequest": {
+        "services.ImportReport": {
             "type": "object",
-            "required": [
-                "email",
-                "password",
-                "username"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ImportedTestSuite"
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
+                "environment": {
+                    "$ref": "#/definitions/services.ImportedEnvironment"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ImportIssue"
+                    }
                 },
-                "username": {
+                "source": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 3
+                    "example": "postman"
+                },
+                "updated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.ImportedTestSuite"
+                    }
                 }
             }
         },
-        "handlers.UpdateCompanyRequest": {
+        "services.ImportedEnvironment": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string",
-                    "maxLength": 255
+                "created": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "email": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
+                    "example": "My API"
                 },
-                "phone": {
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 10
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "handlers.UpdateProfileRequest": {
+        "services.ImportedTestSuite": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "method": {
+                    "type": "string",
+                    "example": "POST"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
+                    "example": "Users / Create user"
                 },
-                "username": {
+                "url": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 3
+                    "example": "{{baseUrl}}/users"
                 }
             }
         },
-        "handlers.UpdateTestSuiteRequest": {
+        "services.TestCaseReport": {
             "type": "object",
             "properties": {
-                "expected_body": {
-                    "type": "string"
+                "assertions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.AssertionResult"
+                    }
                 },
-                "expected_status": {
-                    "type": "integer",
-                    "maximum": 599,
-                    "minimum": 100
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "headers": {
-                    "type": "string"
+                "form_fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
                 "method": {
                     "type": "string",
-                    "enum": [
-                        "GET",
-                        "POST",
-                        "PUT",
-                        "DELETE",
-                        "PATCH"
-                    ]
+                    "example": "POST"
                 },
                 "name": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
+                    "example": "API Login Test"
+                },
+                "query_params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "request_body": {
+                    "type": "string",
+                    "example": "{\"username\": \"admin\"}"
+                },
+                "request_headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "response_headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "response_snippet": {
+                    "type": "string",
+                    "example": "{\"error\": \"invalid credentials\"}"
+                },
+                "response_status": {
+                    "type": "integer",
+                    "example": 401
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TestResultStatus"
+                        }
+                    ],
+                    "example": "failed"
+                },
+                "test_suite_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "time_ms": {
+                    "type": "integer",
+                    "example": 120
                 },
                 "url": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "https://api.example.com/login"
+                }
+            }
+        },
+        "services.TestRunReport": {
+            "type": "object",
+            "properties": {
+                "test_cases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.TestCaseReport"
+                    }
+                },
+                "test_run": {
+                    "$ref": "#/definitions/entities.TestRun"
+                },
+                "time_ms": {
+                    "type": "integer",
+                    "example": 840
+                }
+            }
+        },
+        "services.TestSuiteBundle": {
+            "type": "object",
+            "properties": {
+                "environments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.BundleEnvironment"
+                    }
+                },
+                "test_suites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.BundleTestSuite"
+                    }
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         }
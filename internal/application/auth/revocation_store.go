@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// revocationSyncOverlap relê as revogações recentes a cada sincronização, para não perder as gravadas
+// por outras instâncias com o relógio atrasado ou em transações concluídas depois da última leitura
+const revocationSyncOverlap = time.Minute
+
+// RevocationStore mantém em memória as revogações de access tokens ainda não expiradas, evitando
+// uma consulta ao banco a cada requisição autenticada. As revogações feitas nesta instância valem
+// imediatamente; as feitas em outras instâncias são lidas do banco a cada syncInterval.
+type RevocationStore struct {
+	repo          repositories.TokenRevocationRepository
+	syncInterval  time.Duration
+	purgeInterval time.Duration
+
+	mu          sync.RWMutex
+	revocations map[uuid.UUID]map[uuid.UUID]*entities.TokenRevocation
+	lastSync    time.Time
+	// syncMu garante uma única leitura do banco por vez quando o cache está desatualizado
+	syncMu sync.Mutex
+
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+// NewRevocationStore cria uma nova instância do cache de revogações
+func NewRevocationStore(repo repositories.TokenRevocationRepository, syncInterval, purgeInterval time.Duration) *RevocationStore {
+	if syncInterval <= 0 {
+		syncInterval = 5 * time.Second
+	}
+	if purgeInterval <= 0 {
+		purgeInterval = time.Hour
+	}
+	return &RevocationStore{
+		repo:          repo,
+		syncInterval:  syncInterval,
+		purgeInterval: purgeInterval,
+		revocations:   make(map[uuid.UUID]map[uuid.UUID]*entities.TokenRevocation),
+	}
+}
+
+// Revoke grava a revogação e a aplica imediatamente nesta instância
+func (s *RevocationStore) Revoke(ctx context.Context, revocation *entities.TokenRevocation) error {
+	created, err := s.repo.Create(ctx, revocation)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.add(created)
+	s.mu.Unlock()
+	return nil
+}
+
+// IsRevoked indica se o access token do usuário, com o jti, a sessão e a emissão informados, foi revogado
+func (s *RevocationStore) IsRevoked(ctx context.Context, userID uuid.UUID, tokenID string, sessionID uuid.UUID, issuedAt time.Time) (bool, error) {
+	if err := s.syncIfStale(ctx); err != nil {
+		return false, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, revocation := range s.revocations[userID] {
+		if !revocation.IsExpired() && revocation.Covers(userID, tokenID, sessionID, issuedAt) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Start inicia a remoção periódica das revogações expiradas em background
+func (s *RevocationStore) Start(ctx context.Context) {
+	ctx, s.stop = context.WithCancel(ctx)
+
+	s.wg.Add(1)
+	go s.run(ctx)
+	log.Printf("🔐 Token revocation store started, purging every %s", s.purgeInterval)
+}
+
+// Stop interrompe a remoção periódica e aguarda a remoção em andamento terminar
+func (s *RevocationStore) Stop() {
+	if s.stop != nil {
+		s.stop()
+	}
+	s.wg.Wait()
+}
+
+// run remove as revogações expiradas a cada intervalo até o cache ser encerrado
+func (s *RevocationStore) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		s.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge remove do banco e da memória as revogações cujos tokens já expiraram
+func (s *RevocationStore) purge(ctx context.Context) {
+	deleted, err := s.repo.DeleteExpired(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("❌ [ERROR] Failed to purge expired token revocations: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		log.Printf("🧹 Purged %d expired token revocations", deleted)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for userID, revocations := range s.revocations {
+		for id, revocation := range revocations {
+			if revocation.IsExpired() {
+				delete(revocations, id)
+			}
+		}
+		if len(revocations) == 0 {
+			delete(s.revocations, userID)
+		}
+	}
+}
+
+// syncIfStale lê do banco as revogações recentes quando a última leitura passou de syncInterval.
+// A primeira leitura carrega todas as revogações ainda não expiradas.
+func (s *RevocationStore) syncIfStale(ctx context.Context) error {
+	if s.fresh() {
+		return nil
+	}
+
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+	if s.fresh() {
+		return nil
+	}
+
+	s.mu.RLock()
+	since := s.lastSync
+	s.mu.RUnlock()
+	if !since.IsZero() {
+		since = since.Add(-revocationSyncOverlap)
+	}
+
+	startedAt := time.Now()
+	revocations, err := s.repo.GetActiveSince(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, revocation := range revocations {
+		s.add(revocation)
+	}
+	s.lastSync = startedAt
+	return nil
+}
+
+// fresh indica se a última leitura do banco ainda está dentro de syncInterval
+func (s *RevocationStore) fresh() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !s.lastSync.IsZero() && time.Since(s.lastSync) < s.syncInterval
+}
+
+// add registra a revogação em memória; deve ser chamado com mu bloqueado para escrita
+func (s *RevocationStore) add(revocation *entities.TokenRevocation) {
+	revocations, ok := s.revocations[revocation.UserID]
+	if !ok {
+		revocations = make(map[uuid.UUID]*entities.TokenRevocation)
+		s.revocations[revocation.UserID] = revocations
+	}
+	revocations[revocation.ID] = revocation
+}
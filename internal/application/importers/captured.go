@@ -0,0 +1,163 @@
+package importers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// skippedCapturedHeaders são definidos pelo cliente HTTP na execução e não são importados.
+// Accept-Encoding manual desativaria a descompressão automática das respostas.
+var skippedCapturedHeaders = map[string]bool{
+	"host":              true,
+	"content-length":    true,
+	"connection":        true,
+	"keep-alive":        true,
+	"transfer-encoding": true,
+	"upgrade":           true,
+	"accept-encoding":   true,
+}
+
+// capturedRequest é uma requisição gravada (HAR) ou digitada (curl), antes da conversão em suíte
+type capturedRequest struct {
+	Method      string
+	URL         string
+	Headers     map[string]string
+	ContentType string
+	Body        string
+	// FormFields são os campos já separados do formulário, quando a gravação os informa
+	FormFields map[string]string
+	Status     int
+}
+
+// newCapturedRequest cria uma requisição vazia com o método GET
+func newCapturedRequest() *capturedRequest {
+	return &capturedRequest{Method: "GET", Headers: map[string]string{}}
+}
+
+// setHeader adiciona o cabeçalho, ignorando os que o cliente HTTP define na execução
+func (r *capturedRequest) setHeader(name, value string) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.HasPrefix(name, ":") || skippedCapturedHeaders[strings.ToLower(name)] {
+		return
+	}
+	if strings.EqualFold(name, "Content-Type") {
+		r.ContentType = value
+	}
+	for existing := range r.Headers {
+		if strings.EqualFold(existing, name) {
+			delete(r.Headers, existing)
+		}
+	}
+	r.Headers[name] = value
+}
+
+// header retorna o valor do cabeçalho, sem diferenciar maiúsculas de minúsculas
+func (r *capturedRequest) header(name string) string {
+	for existing, value := range r.Headers {
+		if strings.EqualFold(existing, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+// name monta o nome da suíte com o método e o caminho da URL
+func (r *capturedRequest) name() string {
+	path := r.URL
+	if parsed, err := url.Parse(r.URL); err == nil && parsed.Host != "" {
+		path = parsed.Host + parsed.EscapedPath()
+	}
+	return suiteName([]string{r.Method + " " + path})
+}
+
+// toSuite converte a requisição em suíte, separando a query string da URL.
+// O status gravado, quando existe, vira o status esperado.
+func (r *capturedRequest) toSuite(result *Result, name string, companyID uuid.UUID) *entities.TestSuite {
+	method := strings.ToUpper(r.Method)
+	if !supportedMethods[method] {
+		result.addIssue(name, "method %s is not supported", method)
+		return nil
+	}
+
+	parsed, err := url.Parse(r.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		result.addIssue(name, "url %q is not an http url and was not imported", r.URL)
+		return nil
+	}
+	query := map[string]string{}
+	for key, values := range parsed.Query() {
+		if len(values) > 1 {
+			result.addIssue(name, "query parameter %q is repeated; only the first value was imported", key)
+		}
+		query[key] = values[0]
+	}
+	parsed.RawQuery, parsed.Fragment = "", ""
+
+	suite := newSuite(companyID, name, method, parsed.String(), r.Headers)
+	suite.SetQueryParams(query)
+	if r.Status > 0 {
+		suite.ExpectedStatus = r.Status
+	}
+	r.applyBody(result, name, suite)
+	return suite
+}
+
+// applyBody define o corpo da suíte conforme o Content-Type da requisição
+func (r *capturedRequest) applyBody(result *Result, name string, suite *entities.TestSuite) {
+	if r.Body == "" && r.FormFields == nil {
+		return
+	}
+
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(r.ContentType, ";")[0]))
+	var err error
+	switch {
+	case mediaType == "multipart/form-data":
+		if r.FormFields == nil {
+			result.addIssue(name, "multipart body could not be parsed and was not imported")
+			return
+		}
+		err = suite.SetRequestBody(entities.RequestBodyMultipart, "", r.FormFields)
+	case mediaType == "application/x-www-form-urlencoded" || (mediaType == "" && r.FormFields != nil):
+		fields := r.FormFields
+		if fields == nil {
+			values, parseErr := url.ParseQuery(r.Body)
+			if parseErr != nil {
+				err = suite.SetRequestBody(entities.RequestBodyRaw, r.Body, nil)
+				break
+			}
+			fields = map[string]string{}
+			for key, value := range values {
+				fields[key] = value[0]
+			}
+		}
+		err = suite.SetRequestBody(entities.RequestBodyForm, "", fields)
+	case isJSONMediaType(mediaType):
+		if err = suite.SetRequestBody(entities.RequestBodyJSON, r.Body, nil); err != nil {
+			result.addIssue(name, "json body is not valid JSON and was imported as raw text")
+			err = suite.SetRequestBody(entities.RequestBodyRaw, r.Body, nil)
+		}
+	default:
+		err = suite.SetRequestBody(entities.RequestBodyRaw, r.Body, nil)
+	}
+	if err != nil {
+		result.addIssue(name, "body was not imported: %v", err)
+	}
+}
+
+// fieldsFromPairs converte pares nome=valor em campos de formulário
+func fieldsFromPairs(pairs []string) (map[string]string, error) {
+	fields := map[string]string{}
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("form field %q is not in the name=value format", pair)
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
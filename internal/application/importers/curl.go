@@ -0,0 +1,407 @@
+package importers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// curlValueOptions são as opções do curl que recebem um valor e não alteram a requisição importada
+var curlValueOptions = map[string]bool{
+	"-o": true, "--output": true, "-m": true, "--max-time": true, "--connect-timeout": true,
+	"--retry": true, "--retry-delay": true, "--retry-max-time": true, "-w": true, "--write-out": true,
+	"-c": true, "--cookie-jar": true, "-x": true, "--proxy": true, "-U": true, "--proxy-user": true,
+	"--cacert": true, "--capath": true, "-E": true, "--cert": true, "--key": true, "--cert-type": true,
+	"--key-type": true, "--resolve": true, "--connect-to": true, "--limit-rate": true, "-D": true,
+	"--dump-header": true, "--max-redirs": true, "--interface": true, "-r": true, "--range": true,
+	"--trace": true, "--trace-ascii": true, "--stderr": true,
+}
+
+// ParseCurl converte um ou mais comandos curl em suítes de teste da empresa.
+// Os comandos podem estar em várias linhas (com \ no fim da linha) e separados por ; ou &&,
+// como no "Copy all as cURL" dos navegadores.
+func ParseCurl(companyID uuid.UUID, data []byte) (*Result, error) {
+	commands, err := splitShellCommands(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w curl command: %w", domainErrors.ErrInvalid, err)
+	}
+
+	result := newResult("")
+	for i, words := range commands {
+		label := fmt.Sprintf("command %d", i+1)
+		if program := path.Base(words[0]); program != "curl" && program != "curl.exe" {
+			result.addIssue(label, "%q is not a curl command and was ignored", words[0])
+			continue
+		}
+
+		request, err := parseCurlArgs(result, label, words[1:])
+		if err != nil {
+			result.addIssue(label, "command was not imported: %v", err)
+			continue
+		}
+		if suite := request.toSuite(result, request.name(), companyID); suite != nil {
+			result.Suites = append(result.Suites, suite)
+		}
+	}
+	if len(result.Suites) == 0 && len(result.Issues) == 0 {
+		return nil, fmt.Errorf("%w curl command: no commands found", domainErrors.ErrInvalid)
+	}
+
+	result.reportUndefinedVariables()
+	return result, nil
+}
+
+// parseCurlArgs interpreta as opções de um comando curl
+func parseCurlArgs(result *Result, label string, args []string) (*capturedRequest, error) {
+	request := newCapturedRequest()
+	var (
+		method      string
+		data        []string
+		form        []string
+		useGet      bool
+		contentType string
+		// dataFile indica dados lidos de arquivo, que não são importados mas definem o método POST
+		dataFile bool
+	)
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		option, value, attached := splitCurlOption(arg)
+		if option == "" {
+			if request.URL == "" {
+				request.URL = arg
+			} else {
+				result.addIssue(label, "additional url %q was ignored", arg)
+			}
+			continue
+		}
+
+		// next retorna o valor da opção, informado junto (-XPOST) ou no argumento seguinte
+		next := func() (string, error) {
+			if attached {
+				return value, nil
+			}
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("option %s requires a value", option)
+			}
+			i++
+			return args[i], nil
+		}
+
+		switch option {
+		case "-X", "--request":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			method = strings.ToUpper(v)
+		case "-H", "--header":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			name, headerValue, ok := strings.Cut(v, ":")
+			if !ok {
+				result.addIssue(label, "header %q is not in the name: value format and was ignored", v)
+				continue
+			}
+			request.setHeader(name, strings.TrimSpace(headerValue))
+		case "-d", "--data", "--data-raw", "--data-binary", "--data-ascii", "--data-urlencode", "--json":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if option != "--data-raw" && strings.HasPrefix(v, "@") {
+				result.addIssue(label, "data file %q was not imported", v[1:])
+				dataFile = true
+				continue
+			}
+			if option == "--data-urlencode" {
+				v = curlURLEncode(v)
+			}
+			if option == "--json" {
+				contentType = "application/json"
+				request.setHeader("Accept", "application/json")
+			}
+			data = append(data, v)
+		case "-F", "--form", "--form-string":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if key, fieldValue, _ := strings.Cut(v, "="); option != "--form-string" && (strings.HasPrefix(fieldValue, "@") || strings.HasPrefix(fieldValue, "<")) {
+				result.addIssue(label, "file field %q was not imported", key)
+				continue
+			}
+			form = append(form, v)
+		case "-u", "--user":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			request.setHeader("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(v)))
+		case "-b", "--cookie":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if !strings.Contains(v, "=") {
+				result.addIssue(label, "cookie file %q was not imported", v)
+				continue
+			}
+			request.setHeader("Cookie", v)
+		case "-A", "--user-agent":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			request.setHeader("User-Agent", v)
+		case "-e", "--referer":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			request.setHeader("Referer", v)
+		case "--url":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			request.URL = v
+		case "-G", "--get":
+			useGet = true
+		case "-I", "--head":
+			method = "HEAD"
+		default:
+			if curlValueOptions[option] && !attached {
+				i++
+			}
+		}
+	}
+
+	if request.URL == "" {
+		return nil, fmt.Errorf("no url found")
+	}
+	// O curl aceita URLs sem esquema e usa http por padrão
+	if !strings.Contains(request.URL, "://") {
+		request.URL = "http://" + request.URL
+	}
+
+	switch {
+	case useGet && len(data) > 0:
+		// -G envia os dados como query string
+		separator := "?"
+		if strings.Contains(request.URL, "?") {
+			separator = "&"
+		}
+		request.URL += separator + strings.Join(data, "&")
+	case len(form) > 0:
+		fields, err := fieldsFromPairs(form)
+		if err != nil {
+			return nil, err
+		}
+		request.FormFields = fields
+		request.ContentType = "multipart/form-data"
+		if method == "" {
+			method = "POST"
+		}
+	case len(data) > 0:
+		request.Body = strings.Join(data, "&")
+		if request.ContentType == "" {
+			if contentType == "" {
+				contentType = "application/x-www-form-urlencoded"
+			}
+			request.setHeader("Content-Type", contentType)
+		}
+		if method == "" {
+			method = "POST"
+		}
+	}
+
+	if method == "" && dataFile && !useGet {
+		method = "POST"
+	}
+	if method == "" {
+		method = "GET"
+	}
+	request.Method = method
+	return request, nil
+}
+
+// splitCurlOption separa a opção do valor informado junto a ela (-XPOST, -H'...').
+// Argumentos que não são opções retornam a opção vazia.
+func splitCurlOption(arg string) (option, value string, attached bool) {
+	switch {
+	case strings.HasPrefix(arg, "--"):
+		return arg, "", false
+	case strings.HasPrefix(arg, "-") && len(arg) > 1:
+		option = arg[:2]
+		if len(arg) == 2 {
+			return option, "", false
+		}
+		// Opções curtas sem valor podem ser agrupadas (-sSL); as que recebem valor levam o restante
+		switch option {
+		case "-X", "-H", "-d", "-F", "-u", "-b", "-A", "-e", "-o", "-m", "-w", "-c", "-x", "-U", "-E", "-D", "-r":
+			return option, arg[2:], true
+		}
+		for _, flag := range arg[1:] {
+			switch flag {
+			case 'G':
+				return "-G", "", false
+			case 'I':
+				return "-I", "", false
+			}
+		}
+		return option, "", false
+	}
+	return "", "", false
+}
+
+// curlURLEncode codifica o valor como o --data-urlencode do curl (nome=conteúdo ou conteúdo)
+func curlURLEncode(value string) string {
+	if name, content, ok := strings.Cut(value, "="); ok {
+		if name == "" {
+			return url.QueryEscape(content)
+		}
+		return name + "=" + url.QueryEscape(content)
+	}
+	return url.QueryEscape(value)
+}
+
+// splitShellCommands separa o texto em comandos e argumentos seguindo as regras de aspas do shell:
+// aspas simples, aspas duplas com escapes, $'...' e continuação de linha com \.
+// Quebras de linha, ; e && encerram o comando.
+func splitShellCommands(input string) ([][]string, error) {
+	var (
+		commands [][]string
+		words    []string
+		word     strings.Builder
+		inWord   bool
+	)
+	endWord := func() {
+		if inWord {
+			words = append(words, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+	endCommand := func() {
+		endWord()
+		if len(words) > 0 {
+			commands = append(commands, words)
+			words = nil
+		}
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\':
+			if i+1 < len(runes) && (runes[i+1] == '\n' || (runes[i+1] == '\r' && i+2 < len(runes) && runes[i+2] == '\n')) {
+				// Continuação de linha
+				for i+1 < len(runes) && runes[i+1] != '\n' {
+					i++
+				}
+				i++
+				continue
+			}
+			if i+1 < len(runes) {
+				i++
+				word.WriteRune(runes[i])
+				inWord = true
+			}
+		case r == '\'':
+			end := indexRune(runes, i+1, '\'')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated single quote")
+			}
+			word.WriteString(string(runes[i+1 : end]))
+			inWord = true
+			i = end
+		case r == '$' && i+1 < len(runes) && runes[i+1] == '\'':
+			end, err := readANSIQuoted(runes, i+2, &word)
+			if err != nil {
+				return nil, err
+			}
+			inWord = true
+			i = end
+		case r == '"':
+			end, err := readDoubleQuoted(runes, i+1, &word)
+			if err != nil {
+				return nil, err
+			}
+			inWord = true
+			i = end
+		case r == '\n' || r == ';':
+			endCommand()
+		case r == '&' && i+1 < len(runes) && runes[i+1] == '&':
+			endCommand()
+			i++
+		case r == ' ' || r == '\t' || r == '\r':
+			endWord()
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	endCommand()
+	return commands, nil
+}
+
+// readDoubleQuoted lê o conteúdo entre aspas duplas até a aspa final e retorna sua posição
+func readDoubleQuoted(runes []rune, start int, word *strings.Builder) (int, error) {
+	for i := start; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			return i, nil
+		case '\\':
+			if i+1 < len(runes) && strings.ContainsRune("\"\\$`\n", runes[i+1]) {
+				i++
+				if runes[i] != '\n' {
+					word.WriteRune(runes[i])
+				}
+				continue
+			}
+		}
+		word.WriteRune(runes[i])
+	}
+	return 0, fmt.Errorf("unterminated double quote")
+}
+
+// readANSIQuoted lê o conteúdo de $'...' interpretando os escapes e retorna a posição da aspa final
+func readANSIQuoted(runes []rune, start int, word *strings.Builder) (int, error) {
+	escapes := map[rune]rune{'n': '\n', 't': '\t', 'r': '\r', '\\': '\\', '\'': '\'', '"': '"'}
+	for i := start; i < len(runes); i++ {
+		switch runes[i] {
+		case '\'':
+			return i, nil
+		case '\\':
+			if i+1 < len(runes) {
+				if escaped, ok := escapes[runes[i+1]]; ok {
+					word.WriteRune(escaped)
+					i++
+					continue
+				}
+			}
+		}
+		word.WriteRune(runes[i])
+	}
+	return 0, fmt.Errorf("unterminated $' quote")
+}
+
+// indexRune retorna a posição do caractere a partir de start, ou -1
+func indexRune(runes []rune, start int, target rune) int {
+	for i := start; i < len(runes); i++ {
+		if runes[i] == target {
+			return i
+		}
+	}
+	return -1
+}
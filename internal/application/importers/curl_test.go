@@ -0,0 +1,130 @@
+package importers
+
+import (
+	"testing"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+func TestParseCurl(t *testing.T) {
+	tests := []struct {
+		name     string
+		commands string
+		importCase
+	}{
+		{
+			name: "browser copy as curl",
+			commands: `curl 'https://api.example.com/users?page=2' \
+  -H 'accept: application/json' \
+  -H 'authorization: Bearer {{token}}' \
+  -H 'content-type: application/json' \
+  --data-raw $'{"name":"admin\'s"}' \
+  --compressed`,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:   "POST api.example.com/users",
+						Method: "POST",
+						URL:    "https://api.example.com/users",
+						Headers: map[string]string{
+							"accept":        "application/json",
+							"authorization": "Bearer {{token}}",
+							"content-type":  "application/json",
+						},
+						QueryParams:    map[string]string{"page": "2"},
+						BodyType:       entities.RequestBodyJSON,
+						Body:           `{"name":"admin's"}`,
+						ExpectedStatus: 200,
+					},
+				},
+				wantIssues: []Issue{
+					{Item: "POST api.example.com/users", Reason: `variable "token" is not defined in the collection; add it to the environment before running`},
+				},
+			},
+		},
+		{
+			name:     "form data, query data and basic auth",
+			commands: `curl -XPUT https://api.example.com/items/1 -d name=widget --data "price=10" && curl -G "https://api.example.com/search?sort=asc" -d q=go -u admin:secret`,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "PUT api.example.com/items/1",
+						Method:         "PUT",
+						URL:            "https://api.example.com/items/1",
+						Headers:        map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+						BodyType:       entities.RequestBodyForm,
+						FormFields:     map[string]string{"name": "widget", "price": "10"},
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "GET api.example.com/search",
+						Method:         "GET",
+						URL:            "https://api.example.com/search",
+						Headers:        map[string]string{"Authorization": "Basic YWRtaW46c2VjcmV0"},
+						QueryParams:    map[string]string{"sort": "asc", "q": "go"},
+						ExpectedStatus: 200,
+					},
+				},
+			},
+		},
+		{
+			name:     "multipart, json and other programs",
+			commands: "curl -F title=Report -F file=@report.pdf example.com/upload; wget https://example.com\ncurl --json '{\"a\": 1}' https://api.example.com/a -o out.json -sSL",
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "POST example.com/upload",
+						Method:         "POST",
+						URL:            "http://example.com/upload",
+						BodyType:       entities.RequestBodyMultipart,
+						FormFields:     map[string]string{"title": "Report"},
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "POST api.example.com/a",
+						Method:         "POST",
+						URL:            "https://api.example.com/a",
+						Headers:        map[string]string{"Accept": "application/json", "Content-Type": "application/json"},
+						BodyType:       entities.RequestBodyJSON,
+						Body:           `{"a": 1}`,
+						ExpectedStatus: 200,
+					},
+				},
+				wantIssues: []Issue{
+					{Item: "command 1", Reason: `file field "file" was not imported`},
+					{Item: "command 2", Reason: `"wget" is not a curl command and was ignored`},
+				},
+			},
+		},
+		{
+			name:     "commands that cannot be imported",
+			commands: "curl -H\ncurl -X DELETE\ncurl -I https://example.com/health",
+			importCase: importCase{
+				wantIssues: []Issue{
+					{Item: "command 1", Reason: "command was not imported: option -H requires a value"},
+					{Item: "command 2", Reason: "command was not imported: no url found"},
+					{Item: "HEAD example.com/health", Reason: "method HEAD is not supported"},
+				},
+			},
+		},
+		{
+			name:       "unterminated quote",
+			commands:   `curl 'https://example.com`,
+			importCase: importCase{wantErr: "invalid curl command: unterminated single quote"},
+		},
+		{
+			name:       "no commands",
+			commands:   " \n ; ",
+			importCase: importCase{wantErr: "invalid curl command: no commands found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseCurl(uuid.New(), []byte(tt.commands))
+			tt.check(t, result, err)
+		})
+	}
+}
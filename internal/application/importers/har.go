@@ -0,0 +1,174 @@
+package importers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// harStaticResourceTypes são recursos da página gravados pelo navegador que não são chamadas de API
+var harStaticResourceTypes = map[string]bool{
+	"image":      true,
+	"stylesheet": true,
+	"font":       true,
+	"script":     true,
+	"media":      true,
+	"manifest":   true,
+}
+
+// harStaticMimePrefixes identificam recursos estáticos quando a gravação não informa o _resourceType
+var harStaticMimePrefixes = []string{"image/", "font/", "audio/", "video/", "text/css", "text/javascript", "application/javascript"}
+
+// harArchive é o subconjunto do formato HAR 1.2 usado na importação
+type harArchive struct {
+	Log *struct {
+		Version string `json:"version"`
+		Pages   []struct {
+			Title string `json:"title"`
+		} `json:"pages"`
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harEntry struct {
+	// ResourceType é a extensão do Chrome que classifica a requisição (xhr, fetch, document, image...)
+	ResourceType string     `json:"_resourceType"`
+	Request      harRequest `json:"request"`
+	Response     struct {
+		Status  int `json:"status"`
+		Content struct {
+			MimeType string `json:"mimeType"`
+		} `json:"content"`
+	} `json:"response"`
+}
+
+type harRequest struct {
+	Method   string         `json:"method"`
+	URL      string         `json:"url"`
+	Headers  []harNameValue `json:"headers"`
+	Cookies  []harNameValue `json:"cookies"`
+	PostData *struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+		Params   []struct {
+			Name     string `json:"name"`
+			Value    string `json:"value"`
+			FileName string `json:"fileName"`
+		} `json:"params"`
+	} `json:"postData"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ParseHAR converte as requisições de um arquivo HAR 1.2 em suítes de teste da empresa.
+// O status gravado na resposta vira o status esperado; recursos estáticos da página e
+// requisições repetidas são ignorados.
+func ParseHAR(companyID uuid.UUID, data []byte) (*Result, error) {
+	var archive harArchive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return nil, fmt.Errorf("%w har file: %w", domainErrors.ErrInvalid, err)
+	}
+	if archive.Log == nil || len(archive.Log.Entries) == 0 {
+		return nil, fmt.Errorf("%w har file: no entries found", domainErrors.ErrInvalid)
+	}
+
+	var title string
+	if len(archive.Log.Pages) > 0 {
+		title = archive.Log.Pages[0].Title
+	}
+	result := newResult(title)
+
+	static := 0
+	seen := make(map[string]bool)
+	for _, entry := range archive.Log.Entries {
+		if isStaticHAREntry(entry) {
+			static++
+			continue
+		}
+
+		request := harCapturedRequest(result, entry)
+		name := request.name()
+		key := request.Method + " " + request.URL + "\n" + request.Body
+		if seen[key] {
+			result.addIssue(name, "duplicate request was not imported")
+			continue
+		}
+		seen[key] = true
+
+		if request.Status == 0 {
+			result.addIssue(name, "request has no recorded response; expected status set to 200")
+		}
+		if suite := request.toSuite(result, name, companyID); suite != nil {
+			result.Suites = append(result.Suites, suite)
+		}
+	}
+	if static > 0 {
+		result.addIssue("har file", "%d static resource requests (images, scripts, styles, fonts) were not imported", static)
+	}
+
+	result.reportUndefinedVariables()
+	return result, nil
+}
+
+// isStaticHAREntry indica se a entrada é um recurso estático da página
+func isStaticHAREntry(entry harEntry) bool {
+	if entry.ResourceType != "" {
+		return harStaticResourceTypes[entry.ResourceType]
+	}
+	mimeType := strings.ToLower(entry.Response.Content.MimeType)
+	for _, prefix := range harStaticMimePrefixes {
+		if strings.HasPrefix(mimeType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// harCapturedRequest extrai método, URL, cabeçalhos, cookies, corpo e status da entrada
+func harCapturedRequest(result *Result, entry harEntry) *capturedRequest {
+	request := newCapturedRequest()
+	if method := strings.TrimSpace(entry.Request.Method); method != "" {
+		request.Method = strings.ToUpper(method)
+	}
+	request.URL = strings.TrimSpace(entry.Request.URL)
+	request.Status = entry.Response.Status
+	for _, header := range entry.Request.Headers {
+		request.setHeader(header.Name, header.Value)
+	}
+
+	// Gravações HTTP/1 nem sempre repetem os cookies no cabeçalho
+	if request.header("Cookie") == "" && len(entry.Request.Cookies) > 0 {
+		cookies := make([]string, 0, len(entry.Request.Cookies))
+		for _, cookie := range entry.Request.Cookies {
+			cookies = append(cookies, cookie.Name+"="+cookie.Value)
+		}
+		request.setHeader("Cookie", strings.Join(cookies, "; "))
+	}
+
+	postData := entry.Request.PostData
+	if postData == nil {
+		return request
+	}
+	if request.ContentType == "" {
+		request.ContentType = postData.MimeType
+	}
+	request.Body = postData.Text
+	if len(postData.Params) > 0 {
+		request.FormFields = map[string]string{}
+		for _, param := range postData.Params {
+			if param.FileName != "" {
+				result.addIssue(request.name(), "file field %q was not imported", param.Name)
+				continue
+			}
+			request.FormFields[param.Name] = param.Value
+		}
+	}
+	return request
+}
@@ -0,0 +1,171 @@
+package importers
+
+import (
+	"testing"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+const storeHAR = `{
+	"log": {
+		"version": "1.2",
+		"pages": [{"title": "Store"}],
+		"entries": [
+			{
+				"_resourceType": "xhr",
+				"request": {
+					"method": "POST",
+					"url": "https://api.example.com/login?next=/home",
+					"headers": [
+						{"name": ":authority", "value": "api.example.com"},
+						{"name": "Host", "value": "api.example.com"},
+						{"name": "Accept-Encoding", "value": "gzip"},
+						{"name": "Content-Type", "value": "application/json"}
+					],
+					"postData": {"mimeType": "application/json", "text": "{\"user\": \"admin\"}"}
+				},
+				"response": {"status": 200, "content": {"mimeType": "application/json"}}
+			},
+			{
+				"_resourceType": "image",
+				"request": {"method": "GET", "url": "https://example.com/logo.png"},
+				"response": {"status": 200, "content": {"mimeType": "image/png"}}
+			},
+			{
+				"request": {"method": "GET", "url": "https://example.com/site.css"},
+				"response": {"status": 200, "content": {"mimeType": "text/css"}}
+			},
+			{
+				"_resourceType": "fetch",
+				"request": {
+					"method": "POST",
+					"url": "https://api.example.com/login?next=/home",
+					"postData": {"mimeType": "application/json", "text": "{\"user\": \"admin\"}"}
+				},
+				"response": {"status": 200}
+			},
+			{
+				"_resourceType": "fetch",
+				"request": {
+					"method": "get",
+					"url": "https://api.example.com/me",
+					"cookies": [{"name": "session", "value": "abc"}, {"name": "theme", "value": "dark"}]
+				},
+				"response": {"status": 0}
+			},
+			{
+				"_resourceType": "fetch",
+				"request": {
+					"method": "POST",
+					"url": "https://api.example.com/upload",
+					"postData": {
+						"mimeType": "multipart/form-data; boundary=----x",
+						"params": [{"name": "title", "value": "Report"}, {"name": "file", "fileName": "report.pdf"}]
+					}
+				},
+				"response": {"status": 201}
+			},
+			{
+				"_resourceType": "fetch",
+				"request": {
+					"method": "PUT",
+					"url": "https://api.example.com/settings",
+					"postData": {"mimeType": "application/x-www-form-urlencoded", "text": "theme=dark&lang=pt"}
+				},
+				"response": {"status": 204}
+			},
+			{
+				"_resourceType": "preflight",
+				"request": {"method": "OPTIONS", "url": "https://api.example.com/settings"},
+				"response": {"status": 204}
+			},
+			{
+				"_resourceType": "websocket",
+				"request": {"method": "GET", "url": "wss://api.example.com/socket"},
+				"response": {"status": 101}
+			}
+		]
+	}
+}`
+
+func TestParseHAR(t *testing.T) {
+	tests := []struct {
+		name string
+		har  string
+		importCase
+	}{
+		{
+			name: "api requests with recorded status",
+			har:  storeHAR,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "POST api.example.com/login",
+						Method:         "POST",
+						URL:            "https://api.example.com/login",
+						Headers:        map[string]string{"Content-Type": "application/json"},
+						QueryParams:    map[string]string{"next": "/home"},
+						BodyType:       entities.RequestBodyJSON,
+						Body:           `{"user": "admin"}`,
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "GET api.example.com/me",
+						Method:         "GET",
+						URL:            "https://api.example.com/me",
+						Headers:        map[string]string{"Cookie": "session=abc; theme=dark"},
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "POST api.example.com/upload",
+						Method:         "POST",
+						URL:            "https://api.example.com/upload",
+						BodyType:       entities.RequestBodyMultipart,
+						FormFields:     map[string]string{"title": "Report"},
+						ExpectedStatus: 201,
+					},
+					{
+						Name:           "PUT api.example.com/settings",
+						Method:         "PUT",
+						URL:            "https://api.example.com/settings",
+						BodyType:       entities.RequestBodyForm,
+						FormFields:     map[string]string{"theme": "dark", "lang": "pt"},
+						ExpectedStatus: 204,
+					},
+				},
+				wantIssues: []Issue{
+					{Item: "POST api.example.com/login", Reason: "duplicate request was not imported"},
+					{Item: "GET api.example.com/me", Reason: "request has no recorded response; expected status set to 200"},
+					{Item: "POST api.example.com/upload", Reason: `file field "file" was not imported`},
+					{Item: "OPTIONS api.example.com/settings", Reason: "method OPTIONS is not supported"},
+					{Item: "GET api.example.com/socket", Reason: `url "wss://api.example.com/socket" is not an http url and was not imported`},
+					{Item: "har file", Reason: "2 static resource requests (images, scripts, styles, fonts) were not imported"},
+				},
+			},
+		},
+		{
+			name:       "invalid json",
+			har:        `{"log": [`,
+			importCase: importCase{wantErr: "invalid har file: unexpected end of JSON input"},
+		},
+		{
+			name:       "missing log",
+			har:        `{}`,
+			importCase: importCase{wantErr: "invalid har file: no entries found"},
+		},
+		{
+			name:       "no entries",
+			har:        `{"log": {"version": "1.2", "entries": []}}`,
+			importCase: importCase{wantErr: "invalid har file: no entries found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseHAR(uuid.New(), []byte(tt.har))
+			tt.check(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package importers converte coleções de outras ferramentas em suítes de teste.
+// Os conversores não acessam o banco: devolvem as suítes, as variáveis de ambiente
+// encontradas e os itens que não puderam ser convertidos, para que o serviço de
+// importação grave o resultado e o relate ao usuário.
+package importers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// Tamanho máximo do nome de uma suíte, o mesmo aceito pela API
+const maxSuiteName = 100
+
+// Métodos aceitos pela API de suítes de teste
+var supportedMethods = map[string]bool{
+	"GET":    true,
+	"POST":   true,
+	"PUT":    true,
+	"DELETE": true,
+	"PATCH":  true,
+}
+
+// Issue descreve um item da coleção que foi ignorado ou importado parcialmente
+type Issue struct {
+	Item   string `json:"item" example:"Users / Create user"`
+	Reason string `json:"reason" example:"pre-request script was not imported"`
+}
+
+// Result é o resultado da conversão de uma coleção
+type Result struct {
+	// Name é o nome da coleção, usado como nome padrão do ambiente
+	Name      string
+	Suites    []*entities.TestSuite
+	Variables map[string]string
+	Issues    []Issue
+}
+
+// newResult cria um resultado vazio
+func newResult(name string) *Result {
+	return &Result{
+		Name:      name,
+		Variables: map[string]string{},
+	}
+}
+
+// addIssue registra um item ignorado ou importado parcialmente
+func (r *Result) addIssue(item, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, Issue{Item: item, Reason: fmt.Sprintf(format, args...)})
+}
+
+// addVariable registra uma variável de ambiente; nomes que não podem ser usados em {{variável}} são reportados
+func (r *Result) addVariable(item, name, value string) {
+	if !value_objects.IsValidVariableName(name) {
+		r.addIssue(item, "variable %q has an invalid name and was not imported", name)
+		return
+	}
+	r.Variables[name] = value
+}
+
+// reportUndefinedVariables relata as variáveis usadas pelas suítes que não foram definidas na coleção.
+// Referências a segredos e valores extraídos são resolvidas na execução e não são relatadas.
+func (r *Result) reportUndefinedVariables() {
+	undefined := make(map[string][]string)
+	for _, suite := range r.Suites {
+		for _, name := range suiteVariables(suite) {
+			if _, ok := r.Variables[name]; ok {
+				continue
+			}
+			if strings.HasPrefix(name, entities.SecretVariablePrefix) || strings.HasPrefix(name, entities.ExtractedVariablePrefix) {
+				continue
+			}
+			undefined[name] = append(undefined[name], suite.Name)
+		}
+	}
+
+	names := make([]string, 0, len(undefined))
+	for name := range undefined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		r.addIssue(strings.Join(undefined[name], ", "), "variable %q is not defined in the collection; add it to the environment before running", name)
+	}
+}
+
+// suiteVariables retorna as variáveis referenciadas pela requisição da suíte
+func suiteVariables(suite *entities.TestSuite) []string {
+	texts := []string{suite.URL, suite.Body}
+	for _, values := range []map[string]string{suite.Headers, suite.QueryParams, suite.FormFields} {
+		for key, value := range values {
+			texts = append(texts, key, value)
+		}
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, text := range texts {
+		for _, name := range value_objects.TemplateVariables(text) {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// suiteName monta o nome da suíte a partir da hierarquia de pastas, limitado ao tamanho aceito pela API
+func suiteName(path []string) string {
+	var parts []string
+	for _, part := range path {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	name := strings.Join(parts, " / ")
+	if name == "" {
+		name = "Imported request"
+	}
+
+	runes := []rune(name)
+	if len(runes) > maxSuiteName {
+		name = string(runes[:maxSuiteName-1]) + "…"
+	}
+	return name
+}
+
+// newSuite cria a suíte importada com o status esperado padrão
+func newSuite(companyID uuid.UUID, name, method, url string, headers map[string]string) *entities.TestSuite {
+	return entities.NewTestSuite(companyID, name, method, url, headers, 200, "")
+}
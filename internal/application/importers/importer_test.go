@@ -0,0 +1,90 @@
+package importers
+
+import (
+	"reflect"
+	"testing"
+
+	"TestGO/internal/domain/entities"
+)
+
+// importedSuite reúne os campos da suíte preenchidos pelos importadores. Mapas vazios viram nil
+// e o tipo de corpo none fica vazio, para que os casos de teste informem apenas o que foi importado.
+type importedSuite struct {
+	Name           string
+	Method         string
+	URL            string
+	Headers        map[string]string
+	QueryParams    map[string]string
+	BodyType       entities.RequestBodyType
+	Body           string
+	FormFields     map[string]string
+	ExpectedStatus int
+	ImportKey      string
+	ResponseSchema string
+}
+
+// importCase é o resultado esperado da conversão de uma entrada
+type importCase struct {
+	wantErr       string
+	wantSuites    []importedSuite
+	wantVariables map[string]string
+	wantIssues    []Issue
+}
+
+// check compara o resultado da conversão com o esperado
+func (tt importCase) check(t *testing.T, result *Result, err error) {
+	t.Helper()
+	if tt.wantErr != "" {
+		if err == nil || err.Error() != tt.wantErr {
+			t.Fatalf("error = %v, want %q", err, tt.wantErr)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	suites := make([]importedSuite, 0, len(result.Suites))
+	for _, suite := range result.Suites {
+		imported := importedSuite{
+			Name:           suite.Name,
+			Method:         suite.Method,
+			URL:            suite.URL,
+			Headers:        nilIfEmpty(suite.Headers),
+			QueryParams:    nilIfEmpty(suite.QueryParams),
+			BodyType:       suite.BodyType,
+			Body:           suite.Body,
+			FormFields:     nilIfEmpty(suite.FormFields),
+			ExpectedStatus: suite.ExpectedStatus,
+		}
+		if suite.ImportKey != nil {
+			imported.ImportKey = *suite.ImportKey
+		}
+		if suite.ResponseSchema != nil {
+			imported.ResponseSchema = string(suite.ResponseSchema)
+		}
+		if imported.BodyType == entities.RequestBodyNone {
+			imported.BodyType = ""
+		}
+		suites = append(suites, imported)
+	}
+	if tt.wantSuites == nil {
+		tt.wantSuites = []importedSuite{}
+	}
+	if !reflect.DeepEqual(suites, tt.wantSuites) {
+		t.Errorf("suites = %+v\nwant %+v", suites, tt.wantSuites)
+	}
+	if variables := nilIfEmpty(result.Variables); !reflect.DeepEqual(variables, tt.wantVariables) {
+		t.Errorf("variables = %v, want %v", variables, tt.wantVariables)
+	}
+	if !reflect.DeepEqual(result.Issues, tt.wantIssues) {
+		t.Errorf("issues = %+v\nwant %+v", result.Issues, tt.wantIssues)
+	}
+}
+
+func nilIfEmpty(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,877 @@
+package importers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"TestGO/internal/application/runner"
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPIKeyPrefix identifica as chaves de importação das suítes geradas a partir de documentos OpenAPI
+const OpenAPIKeyPrefix = "openapi:"
+
+const (
+	// maxExampleDepth limita a geração de exemplos em schemas recursivos
+	maxExampleDepth = 8
+	// maxRefHops limita as referências encadeadas ($ref para $ref)
+	maxRefHops = 16
+	// componentSchemaPrefix é o prefixo das referências aos schemas reutilizáveis do documento
+	componentSchemaPrefix = "#/components/schemas/"
+)
+
+var (
+	// openAPIMethods lista os métodos de um path item na ordem em que aparecem na especificação
+	openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+	// openAPIPathParamPattern reconhece os parâmetros de caminho no formato {nome}
+	openAPIPathParamPattern = regexp.MustCompile(`\{([^{}/]+)\}`)
+	// openAPIServerVarPattern reconhece as variáveis da URL do servidor no formato {nome}
+	openAPIServerVarPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+	// openAPIOnlyKeywords são palavras-chave do Schema Object do OpenAPI sem equivalente no JSON Schema
+	openAPIOnlyKeywords = map[string]bool{"nullable": true, "discriminator": true, "xml": true, "externalDocs": true, "example": true}
+	// literalSchemaKeywords guardam valores, e não schemas, e são copiadas sem conversão
+	literalSchemaKeywords = map[string]bool{"enum": true, "const": true, "default": true, "examples": true, "required": true}
+	// ignoredParameterHeaders são definidos pelo executor ou pela autenticação e não são importados
+	ignoredParameterHeaders = map[string]bool{"accept": true, "content-type": true, "authorization": true}
+)
+
+// ParseOpenAPI converte um documento OpenAPI 3.0/3.1, em JSON ou YAML, em uma suíte por operação.
+// A URL do primeiro servidor vira a variável baseUrl; cada suíte recebe a chave de importação
+// derivada do operationId, usada para atualizar a suíte quando o documento é reimportado.
+func ParseOpenAPI(companyID uuid.UUID, data []byte) (*Result, error) {
+	document, err := decodeOpenAPI(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w openapi document: %w", domainErrors.ErrInvalid, err)
+	}
+
+	version := asString(document["openapi"])
+	if version == "" && document["swagger"] != nil {
+		return nil, fmt.Errorf("%w openapi document: swagger 2.0 is not supported, convert the document to OpenAPI 3", domainErrors.ErrInvalid)
+	}
+	if !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("%w openapi document: unsupported version %q", domainErrors.ErrInvalid, version)
+	}
+	paths := asMap(document["paths"])
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w openapi document: no paths found", domainErrors.ErrInvalid)
+	}
+
+	info := asMap(document["info"])
+	result := newResult(asString(info["title"]))
+	converter := &openAPIConverter{
+		companyID:   companyID,
+		document:    document,
+		result:      result,
+		components:  asMap(document["components"]),
+		keys:        map[string]string{},
+		exampleRefs: map[string]bool{},
+	}
+
+	if serverURL := converter.serverURL(result.Name, document["servers"]); serverURL != "" {
+		result.addVariable("servers", "baseUrl", serverURL)
+	}
+
+	pathNames := make([]string, 0, len(paths))
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	sort.Strings(pathNames)
+
+	for _, path := range pathNames {
+		pathItem, err := converter.resolve(paths[path])
+		if err != nil {
+			result.addIssue(path, "path was not imported: %v", err)
+			continue
+		}
+		for _, method := range openAPIMethods {
+			if operation := asMap(pathItem[method]); operation != nil {
+				converter.convert(path, method, pathItem, operation)
+			}
+		}
+	}
+
+	result.reportUndefinedVariables()
+	return result, nil
+}
+
+// decodeOpenAPI lê o documento em JSON ou YAML como uma árvore de mapas com chaves de texto
+func decodeOpenAPI(data []byte) (map[string]interface{}, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+
+	var decoded interface{}
+	if data[0] == '{' {
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return nil, err
+		}
+	} else if err := yaml.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	document, ok := normalizeYAML(decoded).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document is not an object")
+	}
+	return document, nil
+}
+
+// normalizeYAML converte mapas com chaves não textuais (como códigos de status) e datas
+// nos tipos produzidos pelo decodificador JSON
+func normalizeYAML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeYAML(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return converted
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
+		}
+		return v
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			return v.Format("2006-01-02")
+		}
+		return v.Format(time.RFC3339)
+	}
+	return value
+}
+
+// openAPIConverter percorre as operações do documento acumulando as suítes no resultado
+type openAPIConverter struct {
+	companyID  uuid.UUID
+	document   map[string]interface{}
+	components map[string]interface{}
+	result     *Result
+	// keys associa cada chave de importação ao nome da primeira suíte que a usou
+	keys map[string]string
+	// exampleRefs guarda as referências em expansão durante a geração de um exemplo
+	exampleRefs map[string]bool
+}
+
+// serverURL retorna a URL do primeiro servidor com as variáveis substituídas pelos valores padrão
+func (c *openAPIConverter) serverURL(item string, servers interface{}) string {
+	list := asSlice(servers)
+	if len(list) == 0 {
+		return ""
+	}
+	server := asMap(list[0])
+	variables := asMap(server["variables"])
+	serverURL := openAPIServerVarPattern.ReplaceAllStringFunc(asString(server["url"]), func(match string) string {
+		return asString(asMap(variables[match[1:len(match)-1]])["default"])
+	})
+	serverURL = strings.TrimRight(strings.TrimSpace(serverURL), "/")
+
+	if serverURL != "" && !strings.Contains(serverURL, "://") {
+		c.result.addIssue(item, "server url %q is relative; set baseUrl to the absolute url in the environment", serverURL)
+	}
+	return serverURL
+}
+
+// convert cria a suíte de uma operação
+func (c *openAPIConverter) convert(path, method string, pathItem, operation map[string]interface{}) {
+	method = strings.ToUpper(method)
+	label := method + " " + path
+	if !supportedMethods[method] {
+		c.result.addIssue(label, "method %s is not supported", method)
+		return
+	}
+
+	key := strings.TrimSpace(asString(operation["operationId"]))
+	if key == "" {
+		key = label
+	}
+	name := c.operationName(label, operation)
+	if previous, ok := c.keys[key]; ok {
+		c.result.addIssue(name, "operationId %q is already used by %q; operation was not imported", key, previous)
+		return
+	}
+	c.keys[key] = name
+
+	if asBool(operation["deprecated"]) {
+		c.result.addIssue(name, "operation is deprecated")
+	}
+
+	baseURL := "{{baseUrl}}"
+	if servers := asSlice(operation["servers"]); len(servers) > 0 {
+		baseURL = c.serverURL(name, servers)
+	} else if servers := asSlice(pathItem["servers"]); len(servers) > 0 {
+		baseURL = c.serverURL(name, servers)
+	}
+
+	headers := map[string]string{}
+	query := map[string]string{}
+	c.applyParameters(name, c.parameters(name, pathItem, operation), headers, query)
+	c.applySecurity(name, operation, headers, query)
+
+	suite := newSuite(c.companyID, name, method, baseURL+openAPIPathParamPattern.ReplaceAllString(path, "{{$1}}"), headers)
+	suite.SetQueryParams(query)
+	suite.SetImportKey(OpenAPIKeyPrefix + key)
+	c.applyRequestBody(name, suite, operation["requestBody"])
+	c.applyResponse(name, suite, operation["responses"])
+
+	c.result.Suites = append(c.result.Suites, suite)
+}
+
+// operationName monta o nome da suíte com a primeira tag e o resumo da operação
+func (c *openAPIConverter) operationName(label string, operation map[string]interface{}) string {
+	title := strings.TrimSpace(asString(operation["summary"]))
+	if title == "" {
+		title = strings.TrimSpace(asString(operation["operationId"]))
+	}
+	if title == "" {
+		title = label
+	}
+
+	var tag string
+	if tags := asSlice(operation["tags"]); len(tags) > 0 {
+		tag = asString(tags[0])
+	}
+	return suiteName([]string{tag, title})
+}
+
+// parameters junta os parâmetros do path item e da operação; os da operação prevalecem
+func (c *openAPIConverter) parameters(name string, pathItem, operation map[string]interface{}) []map[string]interface{} {
+	var parameters []map[string]interface{}
+	index := map[string]int{}
+	for _, list := range []interface{}{pathItem["parameters"], operation["parameters"]} {
+		for _, raw := range asSlice(list) {
+			parameter, err := c.resolve(raw)
+			if err != nil {
+				c.result.addIssue(name, "parameter was not imported: %v", err)
+				continue
+			}
+			id := asString(parameter["in"]) + ":" + asString(parameter["name"])
+			if i, ok := index[id]; ok {
+				parameters[i] = parameter
+				continue
+			}
+			index[id] = len(parameters)
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters
+}
+
+// applyParameters converte os parâmetros em variáveis de caminho, query e cabeçalhos.
+// Parâmetros opcionais sem exemplo são ignorados; os obrigatórios sem exemplo viram {{nome}}.
+func (c *openAPIConverter) applyParameters(name string, parameters []map[string]interface{}, headers, query map[string]string) {
+	for _, parameter := range parameters {
+		paramName := asString(parameter["name"])
+		example, hasExample := c.parameterExample(parameter)
+
+		switch asString(parameter["in"]) {
+		case "path":
+			// O caminho sempre usa {{nome}}; o exemplo vira o valor padrão da variável no ambiente
+			if _, defined := c.result.Variables[paramName]; hasExample && !defined {
+				c.result.addVariable(name, paramName, formatValue(example))
+			}
+		case "query":
+			if value, ok := parameterValue(parameter, example, hasExample); ok {
+				query[paramName] = value
+			}
+		case "header":
+			if ignoredParameterHeaders[strings.ToLower(paramName)] {
+				continue
+			}
+			if value, ok := parameterValue(parameter, example, hasExample); ok {
+				headers[paramName] = value
+			}
+		case "cookie":
+			if asBool(parameter["required"]) {
+				c.result.addIssue(name, "cookie parameter %q is not supported and was not imported", paramName)
+			}
+		}
+	}
+}
+
+// parameterExample retorna o exemplo declarado do parâmetro, sem gerar valores a partir do schema
+func (c *openAPIConverter) parameterExample(parameter map[string]interface{}) (interface{}, bool) {
+	if example, ok := c.declaredExample(parameter); ok {
+		return example, true
+	}
+	schema, err := c.resolve(parameter["schema"])
+	if err != nil || schema == nil {
+		return nil, false
+	}
+	for _, key := range []string{"example", "default"} {
+		if value, ok := schema[key]; ok {
+			return value, true
+		}
+	}
+	if examples := asSlice(schema["examples"]); len(examples) > 0 {
+		return examples[0], true
+	}
+	return nil, false
+}
+
+// parameterValue retorna o valor enviado para um parâmetro de query ou cabeçalho
+func parameterValue(parameter map[string]interface{}, example interface{}, hasExample bool) (string, bool) {
+	if hasExample {
+		return formatValue(example), true
+	}
+	if asBool(parameter["required"]) {
+		return "{{" + asString(parameter["name"]) + "}}", true
+	}
+	return "", false
+}
+
+// applySecurity converte o primeiro requisito de segurança da operação (ou do documento)
+// em cabeçalhos ou parâmetros de query que usam variáveis do ambiente
+func (c *openAPIConverter) applySecurity(name string, operation map[string]interface{}, headers, query map[string]string) {
+	requirements, ok := operation["security"]
+	if !ok {
+		requirements = c.document["security"]
+	}
+	list := asSlice(requirements)
+	if len(list) == 0 {
+		return
+	}
+
+	requirement := asMap(list[0])
+	schemeNames := make([]string, 0, len(requirement))
+	for schemeName := range requirement {
+		schemeNames = append(schemeNames, schemeName)
+	}
+	sort.Strings(schemeNames)
+
+	for _, schemeName := range schemeNames {
+		scheme, err := c.resolve(asMap(c.components["securitySchemes"])[schemeName])
+		if err != nil || scheme == nil {
+			c.result.addIssue(name, "security scheme %q is not defined and was not imported", schemeName)
+			continue
+		}
+
+		switch asString(scheme["type"]) {
+		case "http":
+			switch strings.ToLower(asString(scheme["scheme"])) {
+			case "bearer":
+				headers["Authorization"] = "Bearer {{token}}"
+			case "basic":
+				headers["Authorization"] = "Basic {{basic_auth}}"
+			default:
+				c.result.addIssue(name, "http auth scheme %q is not supported and was not imported", asString(scheme["scheme"]))
+			}
+		case "apiKey":
+			keyName := asString(scheme["name"])
+			switch asString(scheme["in"]) {
+			case "header":
+				headers[keyName] = "{{api_key}}"
+			case "query":
+				query[keyName] = "{{api_key}}"
+			default:
+				c.result.addIssue(name, "api key in %q is not supported and was not imported", asString(scheme["in"]))
+			}
+		case "oauth2", "openIdConnect":
+			headers["Authorization"] = "Bearer {{token}}"
+		default:
+			c.result.addIssue(name, "security scheme type %q is not supported and was not imported", asString(scheme["type"]))
+		}
+	}
+}
+
+// applyRequestBody define o corpo da suíte a partir do exemplo do media type preferido.
+// Sem exemplo declarado, o corpo é gerado a partir do schema.
+func (c *openAPIConverter) applyRequestBody(name string, suite *entities.TestSuite, raw interface{}) {
+	if raw == nil {
+		return
+	}
+	requestBody, err := c.resolve(raw)
+	if err != nil {
+		c.result.addIssue(name, "request body was not imported: %v", err)
+		return
+	}
+
+	mediaType, media := preferredMediaType(asMap(requestBody["content"]))
+	if media == nil {
+		return
+	}
+	example, ok := c.declaredExample(media)
+	if !ok {
+		example = c.schemaExample(media["schema"], 0)
+	}
+
+	switch {
+	case isJSONMediaType(mediaType):
+		encoded, marshalErr := json.MarshalIndent(example, "", "  ")
+		if marshalErr != nil {
+			c.result.addIssue(name, "request body example could not be encoded: %v", marshalErr)
+			return
+		}
+		err = suite.SetRequestBody(entities.RequestBodyJSON, string(encoded), nil)
+	case mediaType == "application/x-www-form-urlencoded":
+		err = suite.SetRequestBody(entities.RequestBodyForm, "", c.exampleFields(name, media, example))
+	case mediaType == "multipart/form-data":
+		err = suite.SetRequestBody(entities.RequestBodyMultipart, "", c.exampleFields(name, media, example))
+	default:
+		text, isText := example.(string)
+		if !isText {
+			c.result.addIssue(name, "request body %s has no text example and was not imported", mediaType)
+			return
+		}
+		err = suite.SetRequestBody(entities.RequestBodyRaw, text, nil)
+		suite.Headers["Content-Type"] = mediaType
+	}
+	if err != nil {
+		c.result.addIssue(name, "request body was not imported: %v", err)
+	}
+}
+
+// exampleFields converte o exemplo de um formulário em campos; arquivos não são importados
+func (c *openAPIConverter) exampleFields(name string, media map[string]interface{}, example interface{}) map[string]string {
+	properties := map[string]interface{}{}
+	if schema, err := c.resolve(media["schema"]); err == nil && schema != nil {
+		properties = asMap(schema["properties"])
+	}
+
+	fields := map[string]string{}
+	for key, value := range asMap(example) {
+		if property, err := c.resolve(properties[key]); err == nil && property != nil && asString(property["format"]) == "binary" {
+			c.result.addIssue(name, "file field %q was not imported", key)
+			continue
+		}
+		fields[key] = formatValue(value)
+	}
+	return fields
+}
+
+// applyResponse define o status esperado pela primeira resposta 2xx e o schema da resposta
+// pelo schema do seu conteúdo JSON
+func (c *openAPIConverter) applyResponse(name string, suite *entities.TestSuite, raw interface{}) {
+	responses := asMap(raw)
+	status, code := 0, ""
+	for key := range responses {
+		value, err := strconv.Atoi(key)
+		if err == nil && value >= 200 && value < 300 && (status == 0 || value < status) {
+			status, code = value, key
+		}
+	}
+	if status == 0 {
+		for key := range responses {
+			if strings.EqualFold(key, "2XX") {
+				status, code = 200, key
+			}
+		}
+	}
+	if status == 0 {
+		c.result.addIssue(name, "operation has no 2xx response; expected status set to 200")
+		return
+	}
+	suite.ExpectedStatus = status
+
+	response, err := c.resolve(responses[code])
+	if err != nil {
+		c.result.addIssue(name, "response schema was not imported: %v", err)
+		return
+	}
+	mediaType, media := preferredMediaType(asMap(response["content"]))
+	if media == nil || !isJSONMediaType(mediaType) || media["schema"] == nil {
+		return
+	}
+
+	schema, err := c.responseSchema(media["schema"])
+	if err == nil {
+		_, err = runner.CompileSchema(schema)
+	}
+	if err != nil {
+		c.result.addIssue(name, "response schema was not imported: %v", err)
+		return
+	}
+	suite.SetResponseSchema(schema)
+}
+
+// responseSchema converte o schema do OpenAPI em um JSON Schema autocontido: os schemas de
+// components referenciados são copiados para $defs e as palavras-chave do OpenAPI 3.0 são traduzidas
+func (c *openAPIConverter) responseSchema(schema interface{}) (json.RawMessage, error) {
+	converter := &schemaConverter{components: asMap(c.components["schemas"]), defs: map[string]interface{}{}}
+	root := converter.convert(schema)
+
+	for len(converter.pending) > 0 {
+		name := converter.pending[0]
+		converter.pending = converter.pending[1:]
+		target, ok := converter.components[name]
+		if !ok {
+			return nil, fmt.Errorf("schema %q is not defined", name)
+		}
+		converter.defs[name] = converter.convert(target)
+	}
+	if converter.err != nil {
+		return nil, converter.err
+	}
+
+	if len(converter.defs) > 0 {
+		object, ok := root.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("schema is not an object")
+		}
+		defs := asMap(object["$defs"])
+		if defs == nil {
+			defs = map[string]interface{}{}
+		}
+		for name, def := range converter.defs {
+			defs[name] = def
+		}
+		object["$defs"] = defs
+	}
+
+	encoded, err := json.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
+
+// schemaConverter copia um schema do OpenAPI traduzindo-o para JSON Schema draft 2020-12
+type schemaConverter struct {
+	components map[string]interface{}
+	defs       map[string]interface{}
+	pending    []string
+	err        error
+}
+
+// convert devolve uma cópia convertida do schema, sem alterar o documento
+func (s *schemaConverter) convert(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = s.convert(item)
+		}
+		return converted
+	case map[string]interface{}:
+		return s.convertObject(v)
+	}
+	return value
+}
+
+func (s *schemaConverter) convertObject(schema map[string]interface{}) interface{} {
+	converted := make(map[string]interface{}, len(schema))
+	for key, value := range schema {
+		switch {
+		case openAPIOnlyKeywords[key]:
+		case literalSchemaKeywords[key]:
+			converted[key] = value
+		case key == "$ref":
+			converted[key] = s.reference(asString(value))
+		case key == "properties" || key == "patternProperties" || key == "$defs" || key == "dependentSchemas":
+			properties := map[string]interface{}{}
+			for name, property := range asMap(value) {
+				properties[name] = s.convert(property)
+			}
+			converted[key] = properties
+		default:
+			converted[key] = s.convert(value)
+		}
+	}
+
+	// OpenAPI 3.0: exclusiveMinimum/exclusiveMaximum booleanos modificam minimum/maximum
+	for exclusive, limit := range map[string]string{"exclusiveMinimum": "minimum", "exclusiveMaximum": "maximum"} {
+		if flag, ok := converted[exclusive].(bool); ok {
+			delete(converted, exclusive)
+			if flag && converted[limit] != nil {
+				converted[exclusive] = converted[limit]
+				delete(converted, limit)
+			}
+		}
+	}
+
+	// OpenAPI 3.0: nullable acrescenta null aos valores aceitos
+	if asBool(schema["nullable"]) {
+		if enum, ok := converted["enum"].([]interface{}); ok {
+			converted["enum"] = append(append([]interface{}{}, enum...), nil)
+		}
+		switch schemaType := converted["type"].(type) {
+		case string:
+			converted["type"] = []interface{}{schemaType, "null"}
+		case nil:
+			if _, ok := converted["$ref"]; ok {
+				return map[string]interface{}{"anyOf": []interface{}{converted, map[string]interface{}{"type": "null"}}}
+			}
+		}
+	}
+	return converted
+}
+
+// reference aponta as referências a components/schemas para $defs e agenda a cópia do schema
+func (s *schemaConverter) reference(ref string) string {
+	if !strings.HasPrefix(ref, componentSchemaPrefix) {
+		if s.err == nil {
+			s.err = fmt.Errorf("reference %q is not supported", ref)
+		}
+		return ref
+	}
+
+	name := unescapePointer(strings.TrimPrefix(ref, componentSchemaPrefix))
+	if _, ok := s.defs[name]; !ok {
+		s.defs[name] = true
+		s.pending = append(s.pending, name)
+	}
+	return "#/$defs/" + escapePointer(name)
+}
+
+// schemaExample gera um valor de exemplo a partir do schema, usando os exemplos, valores padrão
+// e enums declarados quando existirem
+func (c *openAPIConverter) schemaExample(raw interface{}, depth int) interface{} {
+	if depth > maxExampleDepth {
+		return nil
+	}
+	// Schemas recursivos param na primeira repetição da referência
+	if ref := asString(asMap(raw)["$ref"]); ref != "" {
+		if c.exampleRefs[ref] {
+			return nil
+		}
+		c.exampleRefs[ref] = true
+		defer delete(c.exampleRefs, ref)
+	}
+	schema, err := c.resolve(raw)
+	if err != nil || schema == nil {
+		return nil
+	}
+
+	for _, key := range []string{"example", "default", "const"} {
+		if value, ok := schema[key]; ok {
+			return value
+		}
+	}
+	for _, key := range []string{"examples", "enum"} {
+		if values := asSlice(schema[key]); len(values) > 0 {
+			return values[0]
+		}
+	}
+	if allOf := asSlice(schema["allOf"]); len(allOf) > 0 {
+		merged := map[string]interface{}{}
+		for _, part := range allOf {
+			for key, value := range asMap(c.schemaExample(part, depth+1)) {
+				merged[key] = value
+			}
+		}
+		return merged
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if options := asSlice(schema[key]); len(options) > 0 {
+			return c.schemaExample(options[0], depth+1)
+		}
+	}
+
+	switch schemaType(schema) {
+	case "object":
+		example := map[string]interface{}{}
+		for name, property := range asMap(schema["properties"]) {
+			if resolved, err := c.resolve(property); err == nil && asBool(resolved["readOnly"]) {
+				continue
+			}
+			example[name] = c.schemaExample(property, depth+1)
+		}
+		return example
+	case "array":
+		if item := c.schemaExample(schema["items"], depth+1); item != nil {
+			return []interface{}{item}
+		}
+		return []interface{}{}
+	case "string":
+		return stringExample(asString(schema["format"]))
+	case "integer", "number":
+		if minimum, ok := schema["minimum"]; ok {
+			return minimum
+		}
+		return 0
+	case "boolean":
+		return true
+	}
+	return nil
+}
+
+// schemaType retorna o tipo do schema, ignorando null nos tipos múltiplos do OpenAPI 3.1
+func schemaType(schema map[string]interface{}) string {
+	switch value := schema["type"].(type) {
+	case string:
+		return value
+	case []interface{}:
+		for _, item := range value {
+			if text := asString(item); text != "" && text != "null" {
+				return text
+			}
+		}
+	}
+	if schema["properties"] != nil {
+		return "object"
+	}
+	if schema["items"] != nil {
+		return "array"
+	}
+	return ""
+}
+
+// stringExample retorna um texto de exemplo compatível com o formato
+func stringExample(format string) string {
+	switch format {
+	case "date":
+		return "2024-01-01"
+	case "date-time":
+		return "2024-01-01T00:00:00Z"
+	case "email":
+		return "user@example.com"
+	case "uuid":
+		return "550e8400-e29b-41d4-a716-446655440000"
+	case "uri", "url":
+		return "https://example.com"
+	case "ipv4":
+		return "192.0.2.1"
+	}
+	return "string"
+}
+
+// declaredExample retorna o exemplo informado em example ou o primeiro de examples
+func (c *openAPIConverter) declaredExample(object map[string]interface{}) (interface{}, bool) {
+	if example, ok := object["example"]; ok {
+		return example, true
+	}
+	examples := asMap(object["examples"])
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		example, err := c.resolve(examples[name])
+		if err != nil || example == nil {
+			continue
+		}
+		if value, ok := example["value"]; ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// resolve segue as referências locais ($ref) até o objeto referenciado
+func (c *openAPIConverter) resolve(value interface{}) (map[string]interface{}, error) {
+	object := asMap(value)
+	for hops := 0; object != nil; hops++ {
+		ref, ok := object["$ref"].(string)
+		if !ok {
+			return object, nil
+		}
+		if hops == maxRefHops {
+			return nil, fmt.Errorf("reference %q is circular", ref)
+		}
+		if !strings.HasPrefix(ref, "#/") {
+			return nil, fmt.Errorf("external reference %q is not supported", ref)
+		}
+
+		var target interface{} = c.document
+		for _, segment := range strings.Split(ref[2:], "/") {
+			target = asMap(target)[unescapePointer(segment)]
+		}
+		if target == nil {
+			return nil, fmt.Errorf("reference %q is not defined", ref)
+		}
+		object = asMap(target)
+	}
+	return object, nil
+}
+
+// preferredMediaType escolhe o conteúdo JSON, depois formulários e por fim o primeiro media type
+func preferredMediaType(content map[string]interface{}) (string, map[string]interface{}) {
+	mediaTypes := make([]string, 0, len(content))
+	for mediaType := range content {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+
+	rank := func(mediaType string) int {
+		switch {
+		case isJSONMediaType(mediaType):
+			return 0
+		case mediaType == "application/x-www-form-urlencoded":
+			return 1
+		case mediaType == "multipart/form-data":
+			return 2
+		}
+		return 3
+	}
+	sort.SliceStable(mediaTypes, func(i, j int) bool { return rank(mediaTypes[i]) < rank(mediaTypes[j]) })
+
+	if len(mediaTypes) == 0 {
+		return "", nil
+	}
+	media := asMap(content[mediaTypes[0]])
+	if media == nil {
+		media = map[string]interface{}{}
+	}
+	return strings.ToLower(mediaTypes[0]), media
+}
+
+// isJSONMediaType indica se o media type é JSON, incluindo os sufixos +json
+func isJSONMediaType(mediaType string) bool {
+	mediaType = strings.ToLower(strings.TrimSpace(strings.Split(mediaType, ";")[0]))
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// formatValue converte um valor do documento em texto; objetos e listas viram JSON
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+// unescapePointer decodifica um segmento de JSON Pointer (RFC 6901), aceitando também percent-encoding
+func unescapePointer(segment string) string {
+	if unescaped, err := url.PathUnescape(segment); err == nil {
+		segment = unescaped
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+}
+
+// escapePointer codifica um segmento de JSON Pointer (RFC 6901)
+func escapePointer(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+func asMap(value interface{}) map[string]interface{} {
+	object, _ := value.(map[string]interface{})
+	return object
+}
+
+func asSlice(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+func asString(value interface{}) string {
+	text, _ := value.(string)
+	return text
+}
+
+func asBool(value interface{}) bool {
+	flag, _ := value.(bool)
+	return flag
+}
@@ -0,0 +1,276 @@
+package importers
+
+import (
+	"testing"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+const petsOpenAPI = `
+openapi: 3.0.3
+info:
+  title: Pets
+servers:
+  - url: https://{env}.example.com/v1/
+    variables:
+      env:
+        default: api
+security:
+  - bearerAuth: []
+components:
+  securitySchemes:
+    bearerAuth:
+      type: http
+      scheme: bearer
+    apiKey:
+      type: apiKey
+      in: query
+      name: key
+  parameters:
+    Limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+        default: 20
+  schemas:
+    Pet:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: integer
+          readOnly: true
+        name:
+          type: string
+          example: Rex
+        tag:
+          type: string
+          nullable: true
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List pets
+      tags: [pets]
+      parameters:
+        - $ref: '#/components/parameters/Limit'
+        - name: X-Trace
+          in: header
+          required: true
+          schema:
+            type: string
+        - name: Accept
+          in: header
+          example: application/xml
+        - name: offset
+          in: query
+          schema:
+            type: integer
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      operationId: createPet
+      tags: [pets]
+      security:
+        - apiKey: []
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '201':
+          description: created
+        '400':
+          description: invalid
+  /pets/{petId}:
+    parameters:
+      - name: petId
+        in: path
+        required: true
+        example: 7
+    delete:
+      summary: Delete pet
+      deprecated: true
+      security: []
+      responses:
+        default:
+          description: deleted
+    options:
+      responses:
+        '200':
+          description: ok
+`
+
+const formsOpenAPI = `{
+	"openapi": "3.1.0",
+	"info": {"title": "Forms"},
+	"servers": [{"url": "/api"}],
+	"paths": {
+		"/login": {
+			"post": {
+				"operationId": "login",
+				"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+					"type": "object",
+					"properties": {"user": {"type": "string"}, "remember": {"type": "boolean"}}
+				}}}},
+				"responses": {"2XX": {"description": "ok"}}
+			}
+		},
+		"/upload": {
+			"put": {"operationId": "login", "responses": {"200": {"description": "ok"}}},
+			"patch": {
+				"operationId": "upload",
+				"requestBody": {"content": {"multipart/form-data": {
+					"schema": {"type": "object", "properties": {"file": {"type": "string", "format": "binary"}, "title": {"type": "string"}}},
+					"example": {"file": "report.pdf", "title": "Report"}
+				}}},
+				"responses": {"204": {"description": "uploaded"}}
+			}
+		},
+		"/notes": {
+			"post": {
+				"operationId": "note",
+				"requestBody": {"content": {"text/plain": {"example": "hello"}}},
+				"responses": {"201": {"description": "created"}}
+			}
+		}
+	}
+}`
+
+func TestParseOpenAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		importCase
+	}{
+		{
+			name:     "yaml document with parameters, security and schemas",
+			document: petsOpenAPI,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "pets / List pets",
+						Method:         "GET",
+						URL:            "{{baseUrl}}/pets",
+						Headers:        map[string]string{"X-Trace": "{{X-Trace}}", "Authorization": "Bearer {{token}}"},
+						QueryParams:    map[string]string{"limit": "20"},
+						ExpectedStatus: 200,
+						ImportKey:      "openapi:listPets",
+						ResponseSchema: `{"$defs":{"Pet":{"properties":{"id":{"readOnly":true,"type":"integer"},"name":{"type":"string"},"tag":{"type":["string","null"]}},"required":["id"],"type":"object"}},"items":{"$ref":"#/$defs/Pet"},"type":"array"}`,
+					},
+					{
+						Name:           "pets / createPet",
+						Method:         "POST",
+						URL:            "{{baseUrl}}/pets",
+						QueryParams:    map[string]string{"key": "{{api_key}}"},
+						BodyType:       entities.RequestBodyJSON,
+						Body:           "{\n  \"name\": \"Rex\",\n  \"tag\": \"string\"\n}",
+						ExpectedStatus: 201,
+						ImportKey:      "openapi:createPet",
+					},
+					{
+						Name:           "Delete pet",
+						Method:         "DELETE",
+						URL:            "{{baseUrl}}/pets/{{petId}}",
+						ExpectedStatus: 200,
+						ImportKey:      "openapi:DELETE /pets/{petId}",
+					},
+				},
+				wantVariables: map[string]string{"baseUrl": "https://api.example.com/v1", "petId": "7"},
+				wantIssues: []Issue{
+					{Item: "Delete pet", Reason: "operation is deprecated"},
+					{Item: "Delete pet", Reason: "operation has no 2xx response; expected status set to 200"},
+					{Item: "OPTIONS /pets/{petId}", Reason: "method OPTIONS is not supported"},
+					{Item: "pets / List pets", Reason: `variable "X-Trace" is not defined in the collection; add it to the environment before running`},
+					{Item: "pets / createPet", Reason: `variable "api_key" is not defined in the collection; add it to the environment before running`},
+					{Item: "pets / List pets", Reason: `variable "token" is not defined in the collection; add it to the environment before running`},
+				},
+			},
+		},
+		{
+			name:     "json document with form bodies and duplicated operation ids",
+			document: formsOpenAPI,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "login",
+						Method:         "POST",
+						URL:            "{{baseUrl}}/login",
+						BodyType:       entities.RequestBodyForm,
+						FormFields:     map[string]string{"user": "string", "remember": "true"},
+						ExpectedStatus: 200,
+						ImportKey:      "openapi:login",
+					},
+					{
+						Name:           "note",
+						Method:         "POST",
+						URL:            "{{baseUrl}}/notes",
+						Headers:        map[string]string{"Content-Type": "text/plain"},
+						BodyType:       entities.RequestBodyRaw,
+						Body:           "hello",
+						ExpectedStatus: 201,
+						ImportKey:      "openapi:note",
+					},
+					{
+						Name:           "upload",
+						Method:         "PATCH",
+						URL:            "{{baseUrl}}/upload",
+						BodyType:       entities.RequestBodyMultipart,
+						FormFields:     map[string]string{"title": "Report"},
+						ExpectedStatus: 204,
+						ImportKey:      "openapi:upload",
+					},
+				},
+				wantVariables: map[string]string{"baseUrl": "/api"},
+				wantIssues: []Issue{
+					{Item: "Forms", Reason: `server url "/api" is relative; set baseUrl to the absolute url in the environment`},
+					{Item: "login", Reason: `operationId "login" is already used by "login"; operation was not imported`},
+					{Item: "upload", Reason: `file field "file" was not imported`},
+				},
+			},
+		},
+		{
+			name:       "swagger 2.0",
+			document:   "swagger: '2.0'\npaths: {}",
+			importCase: importCase{wantErr: "invalid openapi document: swagger 2.0 is not supported, convert the document to OpenAPI 3"},
+		},
+		{
+			name:       "unsupported version",
+			document:   `{"openapi": "2.9", "paths": {"/": {}}}`,
+			importCase: importCase{wantErr: `invalid openapi document: unsupported version "2.9"`},
+		},
+		{
+			name:       "no paths",
+			document:   `{"openapi": "3.0.0", "paths": {}}`,
+			importCase: importCase{wantErr: "invalid openapi document: no paths found"},
+		},
+		{
+			name:       "empty document",
+			document:   "  \n",
+			importCase: importCase{wantErr: "invalid openapi document: document is empty"},
+		},
+		{
+			name:       "not an object",
+			document:   "- openapi: 3.0.0",
+			importCase: importCase{wantErr: "invalid openapi document: document is not an object"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseOpenAPI(uuid.New(), []byte(tt.document))
+			tt.check(t, result, err)
+		})
+	}
+}
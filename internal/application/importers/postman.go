@@ -0,0 +1,450 @@
+package importers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// postmanStatusPattern reconhece a verificação de status mais comum dos scripts de teste
+	postmanStatusPattern = regexp.MustCompile(`pm\.response\.to\.have\.status\(\s*(\d{3})\s*\)`)
+	// postmanDynamicPattern reconhece as variáveis dinâmicas do Postman, como {{$guid}}
+	postmanDynamicPattern = regexp.MustCompile(`\{\{\s*\$[A-Za-z0-9_]+\s*\}\}`)
+	// postmanPathVariablePattern reconhece variáveis de caminho no formato :nome
+	postmanPathVariablePattern = regexp.MustCompile(`/:([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// postmanCollection é o subconjunto do formato Postman Collection v2.0/v2.1 usado na importação
+type postmanCollection struct {
+	Info struct {
+		Name   string `json:"name"`
+		Schema string `json:"schema"`
+	} `json:"info"`
+	Item     []postmanItem     `json:"item"`
+	Variable []postmanVariable `json:"variable"`
+	Auth     *postmanAuth      `json:"auth"`
+	Event    []postmanEvent    `json:"event"`
+}
+
+// postmanItem é uma pasta (com Item) ou uma requisição (com Request)
+type postmanItem struct {
+	Name    string          `json:"name"`
+	Item    []postmanItem   `json:"item"`
+	Request *postmanRequest `json:"request"`
+	Auth    *postmanAuth    `json:"auth"`
+	Event   []postmanEvent  `json:"event"`
+}
+
+type postmanRequest struct {
+	Method string         `json:"method"`
+	Header []postmanField `json:"header"`
+	Body   *postmanBody   `json:"body"`
+	URL    postmanURL     `json:"url"`
+	Auth   *postmanAuth   `json:"auth"`
+}
+
+// UnmarshalJSON aceita a requisição abreviada, informada apenas pela URL
+func (r *postmanRequest) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err == nil {
+		*r = postmanRequest{Method: "GET", URL: postmanURL{Raw: raw}}
+		return nil
+	}
+	type plain postmanRequest
+	return json.Unmarshal(data, (*plain)(r))
+}
+
+type postmanURL struct {
+	Raw      string         `json:"raw"`
+	Query    []postmanField `json:"query"`
+	Variable []postmanField `json:"variable"`
+	// structured indica que a URL veio como objeto, com a query separada em Query
+	structured bool
+}
+
+// UnmarshalJSON aceita a URL como texto ou como objeto
+func (u *postmanURL) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err == nil {
+		*u = postmanURL{Raw: raw}
+		return nil
+	}
+	type plain postmanURL
+	if err := json.Unmarshal(data, (*plain)(u)); err != nil {
+		return err
+	}
+	u.structured = true
+	return nil
+}
+
+// postmanField é um par chave/valor de cabeçalho, query, formulário ou variável de caminho
+type postmanField struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+	Type     string `json:"type"`
+}
+
+type postmanVariable struct {
+	Key      string          `json:"key"`
+	Value    json.RawMessage `json:"value"`
+	Disabled bool            `json:"disabled"`
+}
+
+type postmanBody struct {
+	Mode       string         `json:"mode"`
+	Raw        string         `json:"raw"`
+	URLEncoded []postmanField `json:"urlencoded"`
+	FormData   []postmanField `json:"formdata"`
+	GraphQL    *struct {
+		Query     string `json:"query"`
+		Variables string `json:"variables"`
+	} `json:"graphql"`
+	Options struct {
+		Raw struct {
+			Language string `json:"language"`
+		} `json:"raw"`
+	} `json:"options"`
+	Disabled bool `json:"disabled"`
+}
+
+type postmanAuth struct {
+	Type   string             `json:"type"`
+	Bearer []postmanAuthParam `json:"bearer"`
+	Basic  []postmanAuthParam `json:"basic"`
+	APIKey []postmanAuthParam `json:"apikey"`
+}
+
+type postmanAuthParam struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type postmanEvent struct {
+	Listen string `json:"listen"`
+	Script struct {
+		Exec json.RawMessage `json:"exec"`
+	} `json:"script"`
+}
+
+// ParsePostmanCollection converte uma coleção Postman v2.0/v2.1 em suítes de teste da empresa.
+// Pastas viram prefixos do nome da suíte e as variáveis da coleção viram variáveis de ambiente.
+func ParsePostmanCollection(companyID uuid.UUID, data []byte) (*Result, error) {
+	var collection postmanCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("%w postman collection: %w", domainErrors.ErrInvalid, err)
+	}
+	if collection.Info.Schema != "" && !strings.Contains(collection.Info.Schema, "/v2.") {
+		return nil, fmt.Errorf("%w postman collection: unsupported schema %q, export the collection as v2.1", domainErrors.ErrInvalid, collection.Info.Schema)
+	}
+	if collection.Item == nil {
+		return nil, fmt.Errorf("%w postman collection: no items found", domainErrors.ErrInvalid)
+	}
+
+	result := newResult(collection.Info.Name)
+	for _, variable := range collection.Variable {
+		if variable.Disabled || variable.Key == "" {
+			continue
+		}
+		result.addVariable("collection variables", variable.Key, postmanValue(variable.Value))
+	}
+
+	converter := &postmanConverter{companyID: companyID, result: result}
+	converter.reportScripts(collection.Info.Name, collection.Event)
+	converter.walk(nil, collection.Item, collection.Auth)
+
+	result.reportUndefinedVariables()
+	return result, nil
+}
+
+// postmanConverter percorre a árvore de itens acumulando as suítes no resultado
+type postmanConverter struct {
+	companyID uuid.UUID
+	result    *Result
+}
+
+// walk converte os itens da pasta, herdando a autenticação da pasta quando o item não define a sua
+func (c *postmanConverter) walk(path []string, items []postmanItem, inherited *postmanAuth) {
+	for _, item := range items {
+		itemPath := append(append([]string{}, path...), item.Name)
+		auth := inherited
+		if item.Auth != nil && item.Auth.Type != "inherit" {
+			auth = item.Auth
+		}
+
+		if item.Request == nil {
+			c.reportScripts(suiteName(itemPath), item.Event)
+			c.walk(itemPath, item.Item, auth)
+			continue
+		}
+		c.convert(itemPath, item, auth)
+	}
+}
+
+// convert cria a suíte de uma requisição
+func (c *postmanConverter) convert(path []string, item postmanItem, auth *postmanAuth) {
+	name := suiteName(path)
+	request := item.Request
+
+	method := strings.ToUpper(strings.TrimSpace(request.Method))
+	if method == "" {
+		method = "GET"
+	}
+	if !supportedMethods[method] {
+		c.result.addIssue(name, "method %s is not supported", method)
+		return
+	}
+
+	rawURL, query := postmanRequestURL(request.URL)
+	if rawURL == "" {
+		c.result.addIssue(name, "request has no url")
+		return
+	}
+
+	headers := map[string]string{}
+	for _, header := range request.Header {
+		if !header.Disabled && header.Key != "" {
+			headers[header.Key] = header.Value
+		}
+	}
+	if request.Auth != nil && request.Auth.Type != "inherit" {
+		auth = request.Auth
+	}
+	c.applyAuth(name, auth, headers, query)
+
+	suite := newSuite(c.companyID, name, method, rawURL, headers)
+	suite.SetQueryParams(query)
+	c.applyBody(name, suite, request.Body)
+
+	for _, event := range item.Event {
+		script := postmanScript(event.Script.Exec)
+		if strings.TrimSpace(script) == "" {
+			continue
+		}
+		switch event.Listen {
+		case "test":
+			if match := postmanStatusPattern.FindStringSubmatch(script); match != nil {
+				suite.ExpectedStatus, _ = strconv.Atoi(match[1])
+				c.result.addIssue(name, "test script was not imported; only the expected status %s was kept", match[1])
+			} else {
+				c.result.addIssue(name, "test script was not imported")
+			}
+		case "prerequest":
+			c.result.addIssue(name, "pre-request script was not imported")
+		}
+	}
+
+	for _, text := range []string{rawURL, suite.Body} {
+		for _, dynamic := range postmanDynamicPattern.FindAllString(text, -1) {
+			c.result.addIssue(name, "dynamic variable %s is not supported and is sent as is", dynamic)
+		}
+	}
+
+	c.result.Suites = append(c.result.Suites, suite)
+}
+
+// postmanRequestURL retorna a URL sem a query string e os parâmetros de query ativos.
+// Variáveis de caminho (:id) são substituídas pelo valor definido ou por {{id}}.
+func postmanRequestURL(requestURL postmanURL) (string, map[string]string) {
+	rawURL := strings.TrimSpace(requestURL.Raw)
+	if index := strings.IndexByte(rawURL, '#'); index != -1 {
+		rawURL = rawURL[:index]
+	}
+
+	query := map[string]string{}
+	rawQuery := ""
+	if index := strings.IndexByte(rawURL, '?'); index != -1 {
+		rawURL, rawQuery = rawURL[:index], rawURL[index+1:]
+	}
+	if requestURL.structured {
+		for _, param := range requestURL.Query {
+			if !param.Disabled && param.Key != "" {
+				query[param.Key] = param.Value
+			}
+		}
+	} else {
+		for _, pair := range strings.Split(rawQuery, "&") {
+			if pair == "" {
+				continue
+			}
+			key, value, _ := strings.Cut(pair, "=")
+			query[key] = value
+		}
+	}
+
+	pathValues := map[string]string{}
+	for _, variable := range requestURL.Variable {
+		pathValues[variable.Key] = variable.Value
+	}
+	rawURL = postmanPathVariablePattern.ReplaceAllStringFunc(rawURL, func(match string) string {
+		key := match[2:]
+		if value := pathValues[key]; value != "" {
+			return "/" + value
+		}
+		return "/{{" + key + "}}"
+	})
+
+	// O Postman aceita URLs sem esquema e usa http por padrão
+	if rawURL != "" && !strings.Contains(rawURL, "://") && !strings.HasPrefix(rawURL, "{{") {
+		rawURL = "http://" + rawURL
+	}
+	return rawURL, query
+}
+
+// applyAuth converte a autenticação em cabeçalhos ou parâmetros de query
+func (c *postmanConverter) applyAuth(name string, auth *postmanAuth, headers, query map[string]string) {
+	if auth == nil {
+		return
+	}
+
+	switch auth.Type {
+	case "", "noauth", "inherit":
+	case "bearer":
+		headers["Authorization"] = "Bearer " + authParam(auth.Bearer, "token")
+	case "basic":
+		username, password := authParam(auth.Basic, "username"), authParam(auth.Basic, "password")
+		if value_objects.HasTemplateVariables(username + password) {
+			// O valor codificado não pode conter {{variável}}; o usuário precisa informá-lo no ambiente
+			headers["Authorization"] = "Basic {{basic_auth}}"
+			c.result.addIssue(name, "basic auth uses variables; set {{basic_auth}} to base64(username:password) in the environment")
+			return
+		}
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	case "apikey":
+		key, value := authParam(auth.APIKey, "key"), authParam(auth.APIKey, "value")
+		if key == "" {
+			c.result.addIssue(name, "api key auth has no key name and was not imported")
+			return
+		}
+		if authParam(auth.APIKey, "in") == "query" {
+			query[key] = value
+		} else {
+			headers[key] = value
+		}
+	default:
+		c.result.addIssue(name, "auth type %q is not supported and was not imported", auth.Type)
+	}
+}
+
+// applyBody converte o corpo da requisição no tipo de corpo equivalente da suíte
+func (c *postmanConverter) applyBody(name string, suite *entities.TestSuite, body *postmanBody) {
+	if body == nil || body.Disabled {
+		return
+	}
+
+	var err error
+	switch body.Mode {
+	case "", "none":
+	case "raw":
+		if body.Raw == "" {
+			return
+		}
+		bodyType := entities.RequestBodyRaw
+		if body.Options.Raw.Language == "json" || (body.Options.Raw.Language == "" && json.Valid([]byte(body.Raw))) {
+			bodyType = entities.RequestBodyJSON
+		}
+		err = suite.SetRequestBody(bodyType, body.Raw, nil)
+		if err != nil && bodyType == entities.RequestBodyJSON {
+			// JSON com comentários ou inválido é mantido como texto
+			c.result.addIssue(name, "json body is not valid JSON and was imported as raw text")
+			err = suite.SetRequestBody(entities.RequestBodyRaw, body.Raw, nil)
+		}
+	case "urlencoded":
+		err = suite.SetRequestBody(entities.RequestBodyForm, "", c.formFields(name, body.URLEncoded))
+	case "formdata":
+		err = suite.SetRequestBody(entities.RequestBodyMultipart, "", c.formFields(name, body.FormData))
+	case "graphql":
+		if body.GraphQL == nil {
+			return
+		}
+		variables := json.RawMessage("{}")
+		if strings.TrimSpace(body.GraphQL.Variables) != "" {
+			variables = json.RawMessage(body.GraphQL.Variables)
+		}
+		encoded, marshalErr := json.Marshal(map[string]interface{}{"query": body.GraphQL.Query, "variables": variables})
+		if marshalErr != nil {
+			c.result.addIssue(name, "graphql variables are not valid JSON and were not imported")
+			encoded, _ = json.Marshal(map[string]interface{}{"query": body.GraphQL.Query})
+		}
+		err = suite.SetRequestBody(entities.RequestBodyJSON, string(encoded), nil)
+	default:
+		c.result.addIssue(name, "body mode %q is not supported and was not imported", body.Mode)
+	}
+	if err != nil {
+		c.result.addIssue(name, "body was not imported: %v", err)
+	}
+}
+
+// formFields retorna os campos ativos do formulário; arquivos não são importados
+func (c *postmanConverter) formFields(name string, fields []postmanField) map[string]string {
+	values := map[string]string{}
+	for _, field := range fields {
+		if field.Disabled || field.Key == "" {
+			continue
+		}
+		if field.Type == "file" {
+			c.result.addIssue(name, "file field %q was not imported", field.Key)
+			continue
+		}
+		values[field.Key] = field.Value
+	}
+	return values
+}
+
+// reportScripts relata os scripts de pastas e da coleção, que não são importados
+func (c *postmanConverter) reportScripts(name string, events []postmanEvent) {
+	for _, event := range events {
+		if strings.TrimSpace(postmanScript(event.Script.Exec)) == "" {
+			continue
+		}
+		switch event.Listen {
+		case "test":
+			c.result.addIssue(name, "test script was not imported")
+		case "prerequest":
+			c.result.addIssue(name, "pre-request script was not imported")
+		}
+	}
+}
+
+// authParam retorna o valor do parâmetro de autenticação
+func authParam(params []postmanAuthParam, key string) string {
+	for _, param := range params {
+		if param.Key == key {
+			return postmanValue(param.Value)
+		}
+	}
+	return ""
+}
+
+// postmanValue converte o valor JSON de uma variável (texto, número ou booleano) em texto
+func postmanValue(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	return string(raw)
+}
+
+// postmanScript junta as linhas do script, informado como texto ou lista de linhas
+func postmanScript(raw json.RawMessage) string {
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err == nil {
+		return strings.Join(lines, "\n")
+	}
+	var text string
+	_ = json.Unmarshal(raw, &text)
+	return text
+}
@@ -0,0 +1,243 @@
+package importers
+
+import (
+	"testing"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+func TestParsePostmanCollection(t *testing.T) {
+	tests := []struct {
+		name       string
+		collection string
+		importCase
+	}{
+		{
+			name: "folders, inherited auth, path variables and expected status",
+			collection: `{
+				"info": {"name": "Store", "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+				"variable": [
+					{"key": "baseUrl", "value": "https://api.example.com"},
+					{"key": "retries", "value": 3},
+					{"key": "legacy", "value": "x", "disabled": true},
+					{"key": "bad name", "value": "y"}
+				],
+				"auth": {"type": "bearer", "bearer": [{"key": "token", "value": "{{token}}"}]},
+				"item": [
+					{"name": "Users", "item": [
+						{
+							"name": "Get user",
+							"request": {
+								"method": "GET",
+								"header": [{"key": "Accept", "value": "application/json"}, {"key": "X-Debug", "value": "1", "disabled": true}],
+								"url": {
+									"raw": "{{baseUrl}}/users/:id?expand=roles",
+									"query": [{"key": "expand", "value": "roles"}, {"key": "debug", "value": "1", "disabled": true}],
+									"variable": [{"key": "id", "value": "42"}]
+								}
+							},
+							"event": [{"listen": "test", "script": {"exec": ["pm.test('ok', function () {", "  pm.response.to.have.status(201);", "});"]}}]
+						},
+						{
+							"name": "Create user",
+							"request": {
+								"method": "post",
+								"url": "{{baseUrl}}/teams/:team/users",
+								"body": {"mode": "raw", "raw": "{\"name\": \"{{$randomFirstName}}\"}", "options": {"raw": {"language": "json"}}}
+							}
+						}
+					]},
+					{"name": "Health", "auth": {"type": "noauth"}, "request": "status.example.com/health?verbose=1"}
+				]
+			}`,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "Users / Get user",
+						Method:         "GET",
+						URL:            "{{baseUrl}}/users/42",
+						Headers:        map[string]string{"Accept": "application/json", "Authorization": "Bearer {{token}}"},
+						QueryParams:    map[string]string{"expand": "roles"},
+						ExpectedStatus: 201,
+					},
+					{
+						Name:           "Users / Create user",
+						Method:         "POST",
+						URL:            "{{baseUrl}}/teams/{{team}}/users",
+						Headers:        map[string]string{"Authorization": "Bearer {{token}}"},
+						BodyType:       entities.RequestBodyJSON,
+						Body:           `{"name": "{{$randomFirstName}}"}`,
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "Health",
+						Method:         "GET",
+						URL:            "http://status.example.com/health",
+						QueryParams:    map[string]string{"verbose": "1"},
+						ExpectedStatus: 200,
+					},
+				},
+				wantVariables: map[string]string{"baseUrl": "https://api.example.com", "retries": "3"},
+				wantIssues: []Issue{
+					{Item: "collection variables", Reason: `variable "bad name" has an invalid name and was not imported`},
+					{Item: "Users / Get user", Reason: "test script was not imported; only the expected status 201 was kept"},
+					{Item: "Users / Create user", Reason: "dynamic variable {{$randomFirstName}} is not supported and is sent as is"},
+					{Item: "Users / Create user", Reason: `variable "team" is not defined in the collection; add it to the environment before running`},
+					{Item: "Users / Get user, Users / Create user", Reason: `variable "token" is not defined in the collection; add it to the environment before running`},
+				},
+			},
+		},
+		{
+			name: "bodies and auth types",
+			collection: `{
+				"info": {"name": "Bodies"},
+				"item": [
+					{
+						"name": "Login",
+						"request": {
+							"method": "POST",
+							"url": "https://api.example.com/login",
+							"auth": {"type": "basic", "basic": [{"key": "username", "value": "admin"}, {"key": "password", "value": "secret"}]},
+							"body": {"mode": "urlencoded", "urlencoded": [{"key": "remember", "value": "true"}, {"key": "old", "value": "1", "disabled": true}]}
+						}
+					},
+					{
+						"name": "Upload",
+						"request": {
+							"method": "PUT",
+							"url": "https://api.example.com/files",
+							"auth": {"type": "apikey", "apikey": [{"key": "key", "value": "api_key"}, {"key": "value", "value": "abc"}, {"key": "in", "value": "query"}]},
+							"body": {"mode": "formdata", "formdata": [{"key": "title", "value": "Report"}, {"key": "file", "type": "file", "src": "report.pdf"}]}
+						}
+					},
+					{
+						"name": "Search",
+						"request": {
+							"method": "POST",
+							"url": "https://api.example.com/graphql",
+							"auth": {"type": "basic", "basic": [{"key": "username", "value": "{{user}}"}, {"key": "password", "value": "x"}]},
+							"body": {"mode": "graphql", "graphql": {"query": "{ users { id } }", "variables": "{\"first\": 10}"}}
+						}
+					},
+					{
+						"name": "Notes",
+						"request": {
+							"method": "PATCH",
+							"url": "https://api.example.com/notes",
+							"auth": {"type": "oauth2"},
+							"body": {"mode": "raw", "raw": "{\"text\": // comment\n}", "options": {"raw": {"language": "json"}}}
+						}
+					}
+				]
+			}`,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{
+						Name:           "Login",
+						Method:         "POST",
+						URL:            "https://api.example.com/login",
+						Headers:        map[string]string{"Authorization": "Basic YWRtaW46c2VjcmV0"},
+						BodyType:       entities.RequestBodyForm,
+						FormFields:     map[string]string{"remember": "true"},
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "Upload",
+						Method:         "PUT",
+						URL:            "https://api.example.com/files",
+						QueryParams:    map[string]string{"api_key": "abc"},
+						BodyType:       entities.RequestBodyMultipart,
+						FormFields:     map[string]string{"title": "Report"},
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "Search",
+						Method:         "POST",
+						URL:            "https://api.example.com/graphql",
+						Headers:        map[string]string{"Authorization": "Basic {{basic_auth}}"},
+						BodyType:       entities.RequestBodyJSON,
+						Body:           `{"query":"{ users { id } }","variables":{"first":10}}`,
+						ExpectedStatus: 200,
+					},
+					{
+						Name:           "Notes",
+						Method:         "PATCH",
+						URL:            "https://api.example.com/notes",
+						BodyType:       entities.RequestBodyRaw,
+						Body:           "{\"text\": // comment\n}",
+						ExpectedStatus: 200,
+					},
+				},
+				wantIssues: []Issue{
+					{Item: "Upload", Reason: `file field "file" was not imported`},
+					{Item: "Search", Reason: "basic auth uses variables; set {{basic_auth}} to base64(username:password) in the environment"},
+					{Item: "Notes", Reason: `auth type "oauth2" is not supported and was not imported`},
+					{Item: "Notes", Reason: "json body is not valid JSON and was imported as raw text"},
+					{Item: "Search", Reason: `variable "basic_auth" is not defined in the collection; add it to the environment before running`},
+				},
+			},
+		},
+		{
+			name: "unsupported requests and scripts",
+			collection: `{
+				"info": {"name": "Misc"},
+				"event": [{"listen": "prerequest", "script": {"exec": "pm.environment.set('ts', Date.now());"}}],
+				"item": [
+					{"name": "Options", "request": {"method": "OPTIONS", "url": "https://api.example.com"}},
+					{"name": "Empty", "request": {"method": "GET", "url": ""}},
+					{
+						"name": "Folder",
+						"event": [{"listen": "test", "script": {"exec": ["pm.expect(true).to.be.true;"]}}],
+						"item": [
+							{
+								"name": "Ping",
+								"request": {"method": "GET", "url": "https://api.example.com/ping"},
+								"event": [
+									{"listen": "prerequest", "script": {"exec": ["console.log('ping');"]}},
+									{"listen": "test", "script": {"exec": ["pm.expect(pm.response.json().ok).to.be.true;"]}}
+								]
+							}
+						]
+					}
+				]
+			}`,
+			importCase: importCase{
+				wantSuites: []importedSuite{
+					{Name: "Folder / Ping", Method: "GET", URL: "https://api.example.com/ping", ExpectedStatus: 200},
+				},
+				wantIssues: []Issue{
+					{Item: "Misc", Reason: "pre-request script was not imported"},
+					{Item: "Options", Reason: "method OPTIONS is not supported"},
+					{Item: "Empty", Reason: "request has no url"},
+					{Item: "Folder", Reason: "test script was not imported"},
+					{Item: "Folder / Ping", Reason: "pre-request script was not imported"},
+					{Item: "Folder / Ping", Reason: "test script was not imported"},
+				},
+			},
+		},
+		{
+			name:       "invalid json",
+			collection: `{"info": `,
+			importCase: importCase{wantErr: "invalid postman collection: unexpected end of JSON input"},
+		},
+		{
+			name:       "unsupported schema",
+			collection: `{"info": {"schema": "https://schema.getpostman.com/json/collection/v1.0.0/collection.json"}, "item": []}`,
+			importCase: importCase{wantErr: `invalid postman collection: unsupported schema "https://schema.getpostman.com/json/collection/v1.0.0/collection.json", export the collection as v2.1`},
+		},
+		{
+			name:       "no items",
+			collection: `{"info": {"name": "Empty"}}`,
+			importCase: importCase{wantErr: "invalid postman collection: no items found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParsePostmanCollection(uuid.New(), []byte(tt.collection))
+			tt.check(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+)
+
+// Quantidade máxima de suítes listadas na mensagem; as demais são apenas contadas
+const maxChatSuites = 10
+
+// ChatNotifier publica o resumo das execuções encerradas nos canais de chat da empresa,
+// no formato Block Kit (Slack) ou Adaptive Card (Microsoft Teams)
+type ChatNotifier struct {
+	channelRepo  repositories.ChatChannelRepository
+	companyRepo  repositories.CompanyRepository
+	summarizer   runSummarizer
+	secretCipher *security.SecretCipher
+	client       *http.Client
+}
+
+// NewChatNotifier cria uma nova instância do notificador de chat
+func NewChatNotifier(
+	channelRepo repositories.ChatChannelRepository,
+	companyRepo repositories.CompanyRepository,
+	testResultRepo repositories.TestResultRepository,
+	testSuiteRepo repositories.TestSuiteRepository,
+	secretCipher *security.SecretCipher,
+	timeout time.Duration,
+) *ChatNotifier {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &ChatNotifier{
+		channelRepo:  channelRepo,
+		companyRepo:  companyRepo,
+		summarizer:   runSummarizer{testResultRepo: testResultRepo, testSuiteRepo: testSuiteRepo},
+		secretCipher: secretCipher,
+		client:       &http.Client{Timeout: timeout},
+	}
+}
+
+// Name identifica o canal nos logs
+func (n *ChatNotifier) Name() string {
+	return "chat"
+}
+
+// NotifyRunFinished publica o resumo da execução nos canais ativos da empresa que a aceitam
+func (n *ChatNotifier) NotifyRunFinished(ctx context.Context, testRun *entities.TestRun) error {
+	channels, err := n.channelRepo.GetEnabledByCompanyID(ctx, testRun.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat channels: %w", err)
+	}
+
+	var accepted []*entities.ChatChannel
+	for _, channel := range channels {
+		if channel.Accepts(testRun) {
+			accepted = append(accepted, channel)
+		}
+	}
+	if len(accepted) == 0 {
+		return nil
+	}
+
+	company, err := n.companyRepo.GetByID(ctx, testRun.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to get company: %w", err)
+	}
+	summary, err := n.summarizer.summarize(ctx, company, testRun)
+	if err != nil {
+		return err
+	}
+
+	var failures []string
+	for _, channel := range accepted {
+		message, err := FormatChatMessage(channel.Provider, summary)
+		if err == nil {
+			err = n.post(ctx, channel, message)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("channel %s: %v", channel.ID, err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to post to chat: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// SendTest publica uma mensagem de teste no canal, mesmo que esteja desativado
+func (n *ChatNotifier) SendTest(ctx context.Context, channel *entities.ChatChannel) error {
+	var message interface{}
+	text := fmt.Sprintf("EzTest test message for channel %q", channel.Name)
+	switch channel.Provider {
+	case entities.ChatProviderSlack:
+		message = slackMessage{Text: text}
+	case entities.ChatProviderTeams:
+		message = teamsMessage(text, []interface{}{adaptiveText(text, "Medium", "Default", true)})
+	default:
+		return fmt.Errorf("unsupported chat provider %q", channel.Provider)
+	}
+	return n.post(ctx, channel, message)
+}
+
+// post envia a mensagem ao webhook de entrada do canal; respostas 2xx são consideradas entregues
+func (n *ChatNotifier) post(ctx context.Context, channel *entities.ChatChannel, message interface{}) error {
+	webhookURL, err := n.secretCipher.Decrypt(channel.EncryptedURL, channel.ID[:])
+	if err != nil {
+		return fmt.Errorf("failed to decrypt chat webhook url: %w", err)
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode chat message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, string(webhookURL), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid chat webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		// A URL do webhook não é incluída no erro para não vazar nos logs
+		return fmt.Errorf("chat webhook request failed: %w", unwrapURLError(err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("chat webhook responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+	return nil
+}
+
+// unwrapURLError remove a URL da requisição da mensagem de erro do cliente HTTP
+func unwrapURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// FormatChatMessage monta o corpo da mensagem no formato do provedor
+func FormatChatMessage(provider entities.ChatProvider, summary RunSummary) (interface{}, error) {
+	switch provider {
+	case entities.ChatProviderSlack:
+		return FormatSlackMessage(summary), nil
+	case entities.ChatProviderTeams:
+		return FormatTeamsMessage(summary), nil
+	}
+	return nil, fmt.Errorf("unsupported chat provider %q", provider)
+}
+
+// chatHeadline retorna o título da mensagem com o resultado da execução
+func chatHeadline(summary RunSummary) string {
+	icon := "❌"
+	if summary.TestRun.Status == entities.TestRunStatusPassed {
+		icon = "✅"
+	}
+	return fmt.Sprintf("%s %s: test run %s", icon, summary.CompanyName, summary.TestRun.Status)
+}
+
+// chatCounts retorna os totais da execução em uma linha
+func chatCounts(testRun *entities.TestRun) string {
+	return fmt.Sprintf("Total: %d · Passed: %d · Failed: %d · Skipped: %d",
+		testRun.TotalTests, testRun.PassedTests, testRun.FailedTests, testRun.SkippedTests)
+}
+
+// failureLines descreve o erro e as asserções reprovadas de uma suíte
+func failureLines(suite FailedSuite) []string {
+	var lines []string
+	if suite.ErrorMessage != "" {
+		lines = append(lines, suite.ErrorMessage)
+	}
+	for _, assertion := range suite.Assertions {
+		line := string(assertion.Type)
+		if assertion.Target != "" {
+			line += " " + assertion.Target
+		}
+		lines = append(lines, line+": "+assertion.Message)
+	}
+	return lines
+}
+
+// slackMessage é o corpo aceito pelos webhooks de entrada do Slack
+type slackMessage struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks,omitempty"`
+}
+
+// slackBlock é um bloco do Block Kit
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Fields   []slackText `json:"fields,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+// slackText é um objeto de texto do Block Kit
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// FormatSlackMessage monta a mensagem da execução em Block Kit
+func FormatSlackMessage(summary RunSummary) interface{} {
+	testRun := summary.TestRun
+	headline := chatHeadline(summary)
+
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: truncate(headline, 150)}},
+		{Type: "section", Fields: []slackText{
+			{Type: "mrkdwn", Text: fmt.Sprintf("*Status*\n%s", testRun.Status)},
+			{Type: "mrkdwn", Text: fmt.Sprintf("*Run*\n`%s`", testRun.ID)},
+			{Type: "mrkdwn", Text: fmt.Sprintf("*Passed*\n%d of %d", testRun.PassedTests, testRun.TotalTests)},
+			{Type: "mrkdwn", Text: fmt.Sprintf("*Failed / Skipped*\n%d / %d", testRun.FailedTests, testRun.SkippedTests)},
+		}},
+	}
+
+	if len(summary.FailedSuites) > 0 {
+		blocks = append(blocks, slackBlock{Type: "divider"})
+	}
+	for i, suite := range summary.FailedSuites {
+		if i == maxChatSuites {
+			blocks = append(blocks, slackBlock{Type: "context", Elements: []slackText{
+				{Type: "mrkdwn", Text: fmt.Sprintf("…and %d more failed suites", len(summary.FailedSuites)-maxChatSuites)},
+			}})
+			break
+		}
+		text := fmt.Sprintf("*%s* (%s)", slackEscape(suite.Name), suite.Status)
+		for _, line := range failureLines(suite) {
+			text += "\n• " + slackEscape(line)
+		}
+		blocks = append(blocks, slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: truncate(text, 3000)}})
+	}
+
+	return slackMessage{
+		Text:   headline + " (" + chatCounts(testRun) + ")",
+		Blocks: blocks,
+	}
+}
+
+// slackEscape escapa os caracteres de controle do mrkdwn
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// teamsMessage envolve o Adaptive Card no formato aceito pelos webhooks de entrada do Teams
+func teamsMessage(summary string, body []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "message",
+		"summary": summary,
+		"attachments": []interface{}{
+			map[string]interface{}{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"contentUrl":  nil,
+				"content": map[string]interface{}{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body":    body,
+				},
+			},
+		},
+	}
+}
+
+// adaptiveText cria um TextBlock do Adaptive Card
+func adaptiveText(text, size, color string, wrap bool) map[string]interface{} {
+	block := map[string]interface{}{
+		"type": "TextBlock",
+		"text": text,
+		"wrap": wrap,
+	}
+	if size != "" {
+		block["size"] = size
+	}
+	if color != "" {
+		block["color"] = color
+	}
+	return block
+}
+
+// FormatTeamsMessage monta a mensagem da execução como Adaptive Card
+func FormatTeamsMessage(summary RunSummary) interface{} {
+	testRun := summary.TestRun
+	headline := chatHeadline(summary)
+
+	color := "Attention"
+	if testRun.Status == entities.TestRunStatusPassed {
+		color = "Good"
+	}
+
+	body := []interface{}{
+		map[string]interface{}{
+			"type":   "TextBlock",
+			"text":   headline,
+			"size":   "Large",
+			"weight": "Bolder",
+			"color":  color,
+			"wrap":   true,
+		},
+		map[string]interface{}{
+			"type": "FactSet",
+			"facts": []map[string]string{
+				{"title": "Run", "value": testRun.ID.String()},
+				{"title": "Status", "value": string(testRun.Status)},
+				{"title": "Passed", "value": fmt.Sprintf("%d of %d", testRun.PassedTests, testRun.TotalTests)},
+				{"title": "Failed", "value": fmt.Sprintf("%d", testRun.FailedTests)},
+				{"title": "Skipped", "value": fmt.Sprintf("%d", testRun.SkippedTests)},
+			},
+		},
+	}
+
+	for i, suite := range summary.FailedSuites {
+		if i == maxChatSuites {
+			body = append(body, adaptiveText(fmt.Sprintf("…and %d more failed suites", len(summary.FailedSuites)-maxChatSuites), "Small", "", true))
+			break
+		}
+		container := map[string]interface{}{
+			"type":      "Container",
+			"separator": true,
+			"items": []interface{}{
+				adaptiveText(fmt.Sprintf("**%s** (%s)", suite.Name, suite.Status), "Medium", "Attention", true),
+			},
+		}
+		for _, line := range failureLines(suite) {
+			container["items"] = append(container["items"].([]interface{}), adaptiveText("- "+truncate(line, 500), "Small", "", true))
+		}
+		body = append(body, container)
+	}
+
+	return teamsMessage(headline, body)
+}
+
+// truncate limita o texto ao tamanho máximo aceito pelo provedor
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-1]) + "…"
+}
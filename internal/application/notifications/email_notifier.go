@@ -0,0 +1,175 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/email"
+)
+
+// Mailer envia mensagens de email
+type Mailer interface {
+	Send(ctx context.Context, msg email.Message) error
+}
+
+// EmailNotifier envia o resumo por email das execuções que falharam para o email da empresa
+// e para os membros que não desativaram os alertas
+type EmailNotifier struct {
+	mailer      Mailer
+	companyRepo repositories.CompanyRepository
+	userRepo    repositories.UserRepository
+	summarizer  runSummarizer
+}
+
+// NewEmailNotifier cria uma nova instância do notificador por email
+func NewEmailNotifier(
+	mailer Mailer,
+	companyRepo repositories.CompanyRepository,
+	userRepo repositories.UserRepository,
+	testResultRepo repositories.TestResultRepository,
+	testSuiteRepo repositories.TestSuiteRepository,
+) *EmailNotifier {
+	return &EmailNotifier{
+		mailer:      mailer,
+		companyRepo: companyRepo,
+		userRepo:    userRepo,
+		summarizer:  runSummarizer{testResultRepo: testResultRepo, testSuiteRepo: testSuiteRepo},
+	}
+}
+
+// Name identifica o canal nos logs
+func (n *EmailNotifier) Name() string {
+	return "email"
+}
+
+// NotifyRunFinished envia o resumo da execução quando ela terminou com falha ou erro
+func (n *EmailNotifier) NotifyRunFinished(ctx context.Context, testRun *entities.TestRun) error {
+	if testRun.Status != entities.TestRunStatusFailed && testRun.Status != entities.TestRunStatusErrored {
+		return nil
+	}
+
+	company, err := n.companyRepo.GetByID(ctx, testRun.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to get company: %w", err)
+	}
+
+	recipients, err := n.recipients(ctx, company)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	summary, err := n.summarizer.summarize(ctx, company, testRun)
+	if err != nil {
+		return err
+	}
+
+	msg, err := RenderRunFailedEmail(summary)
+	if err != nil {
+		return err
+	}
+	msg.To = recipients
+
+	if err := n.mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("failed to send run summary email: %w", err)
+	}
+	return nil
+}
+
+// recipients retorna o email da empresa e os dos membros com alertas ativos, sem repetições
+func (n *EmailNotifier) recipients(ctx context.Context, company *entities.Company) ([]string, error) {
+	members, err := n.userRepo.GetByCompanyID(ctx, company.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company members: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var recipients []string
+	add := func(address string) {
+		address = strings.TrimSpace(address)
+		key := strings.ToLower(address)
+		if address == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		recipients = append(recipients, address)
+	}
+
+	add(company.Email)
+	for _, member := range members {
+		if member.EmailAlerts {
+			add(member.Email)
+		}
+	}
+	return recipients, nil
+}
+
+// RenderRunFailedEmail monta o assunto e os corpos em texto puro e HTML do resumo
+func RenderRunFailedEmail(summary RunSummary) (email.Message, error) {
+	var text, html bytes.Buffer
+	if err := runFailedTextTemplate.Execute(&text, summary); err != nil {
+		return email.Message{}, fmt.Errorf("failed to render email text: %w", err)
+	}
+	if err := runFailedHTMLTemplate.Execute(&html, summary); err != nil {
+		return email.Message{}, fmt.Errorf("failed to render email html: %w", err)
+	}
+
+	return email.Message{
+		Subject: fmt.Sprintf("[EzTest] %s: test run %s (%d of %d failed)",
+			summary.CompanyName, summary.TestRun.Status, summary.TestRun.FailedTests, summary.TestRun.TotalTests),
+		Text: text.String(),
+		HTML: html.String(),
+	}, nil
+}
+
+var runFailedTextTemplate = texttemplate.Must(texttemplate.New("run_failed_text").Parse(
+	`Test run {{.TestRun.ID}} for {{.CompanyName}} finished with status {{.TestRun.Status}}.
+
+Total: {{.TestRun.TotalTests}}  Passed: {{.TestRun.PassedTests}}  Failed: {{.TestRun.FailedTests}}  Skipped: {{.TestRun.SkippedTests}}
+
+Failed suites:
+{{range .FailedSuites}}
+- {{.Name}} ({{.Status}})
+{{- if .ErrorMessage}}
+    Error: {{.ErrorMessage}}
+{{- end}}
+{{- range .Assertions}}
+    * {{.Type}}{{if .Target}} {{.Target}}{{end}}: {{.Message}}
+{{- end}}
+{{else}}
+(no suite results recorded)
+{{end}}
+You are receiving this email because email alerts are enabled for your account.
+`))
+
+var runFailedHTMLTemplate = htmltemplate.Must(htmltemplate.New("run_failed_html").Parse(
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: Arial, sans-serif; color: #222;">
+<h2>Test run {{.TestRun.Status}} for {{.CompanyName}}</h2>
+<p>Run <code>{{.TestRun.ID}}</code></p>
+<table cellpadding="4" style="border-collapse: collapse;">
+<tr><th align="left">Total</th><th align="left">Passed</th><th align="left">Failed</th><th align="left">Skipped</th></tr>
+<tr><td>{{.TestRun.TotalTests}}</td><td>{{.TestRun.PassedTests}}</td><td>{{.TestRun.FailedTests}}</td><td>{{.TestRun.SkippedTests}}</td></tr>
+</table>
+<h3>Failed suites</h3>
+{{if .FailedSuites}}<ul>
+{{range .FailedSuites}}<li><strong>{{.Name}}</strong> ({{.Status}})
+{{if .ErrorMessage}}<p style="color: #b00020;">{{.ErrorMessage}}</p>{{end}}
+{{if .Assertions}}<ul>
+{{range .Assertions}}<li><code>{{.Type}}</code>{{if .Target}} <code>{{.Target}}</code>{{end}}: {{.Message}}</li>
+{{end}}</ul>{{end}}
+</li>
+{{end}}</ul>{{else}}<p>No suite results recorded.</p>{{end}}
+<p style="color: #777; font-size: 12px;">You are receiving this email because email alerts are enabled for your account.</p>
+</body>
+</html>
+`))
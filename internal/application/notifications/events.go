@@ -0,0 +1,39 @@
+package notifications
+
+import (
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// TestRunFinishedData é o conteúdo do evento test_run.finished
+type TestRunFinishedData struct {
+	TestRun *entities.TestRun `json:"test_run"`
+}
+
+// TestSuiteStatusChangedData é o conteúdo dos eventos test_suite.failing e test_suite.recovered
+type TestSuiteStatusChangedData struct {
+	TestRunID      uuid.UUID                 `json:"test_run_id"`
+	TestSuiteID    uuid.UUID                 `json:"test_suite_id"`
+	TestSuiteName  string                    `json:"test_suite_name"`
+	Status         entities.TestResultStatus `json:"status"`
+	PreviousStatus entities.TestResultStatus `json:"previous_status"`
+	ErrorMessage   string                    `json:"error_message,omitempty"`
+}
+
+// StatusChangeEvent indica o evento gerado pela mudança de resultado de uma suíte entre execuções.
+// Retorna falso quando não houve mudança entre aprovada e reprovada.
+func StatusChangeEvent(previous, current entities.TestResultStatus) (entities.WebhookEvent, bool) {
+	if current == entities.TestResultStatusSkipped {
+		return "", false
+	}
+	wasPassing := previous == entities.TestResultStatusPassed
+	isPassing := current == entities.TestResultStatusPassed
+	switch {
+	case wasPassing && !isPassing:
+		return entities.WebhookEventTestSuiteFailing, true
+	case !wasPassing && isPassing:
+		return entities.WebhookEventTestSuiteRecovered, true
+	}
+	return "", false
+}
@@ -0,0 +1,44 @@
+package notifications
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"TestGO/internal/domain/entities"
+)
+
+// Notifier é um canal de notificação acionado quando uma execução é encerrada
+// (webhooks, email, chat)
+type Notifier interface {
+	// Name identifica o canal nos logs
+	Name() string
+	// NotifyRunFinished recebe a execução encerrada; cada canal decide se ela deve ser notificada
+	NotifyRunFinished(ctx context.Context, testRun *entities.TestRun) error
+}
+
+// Pipeline repassa as execuções encerradas a todos os canais configurados.
+// Os canais são acionados em paralelo e a falha de um não impede a entrega aos demais.
+type Pipeline struct {
+	notifiers []Notifier
+}
+
+// NewPipeline cria o pipeline com os canais informados
+func NewPipeline(notifiers ...Notifier) *Pipeline {
+	return &Pipeline{notifiers: notifiers}
+}
+
+// Dispatch notifica a execução encerrada a todos os canais e aguarda a conclusão
+func (p *Pipeline) Dispatch(ctx context.Context, testRun *entities.TestRun) {
+	var wg sync.WaitGroup
+	for _, notifier := range p.notifiers {
+		wg.Add(1)
+		go func(notifier Notifier) {
+			defer wg.Done()
+			if err := notifier.NotifyRunFinished(ctx, testRun); err != nil {
+				log.Printf("❌ [ERROR] Failed to notify %s of test run %s: %v", notifier.Name(), testRun.ID, err)
+			}
+		}(notifier)
+	}
+	wg.Wait()
+}
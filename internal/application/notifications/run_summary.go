@@ -0,0 +1,72 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+)
+
+// RunSummary é o resumo de uma execução encerrada usado pelos canais de email e chat
+type RunSummary struct {
+	CompanyName  string
+	TestRun      *entities.TestRun
+	FailedSuites []FailedSuite
+}
+
+// FailedSuite resume uma suíte que não passou na execução
+type FailedSuite struct {
+	Name         string
+	Status       entities.TestResultStatus
+	ErrorMessage string
+	Assertions   []entities.AssertionResult
+}
+
+// runSummarizer monta o resumo das execuções a partir dos resultados gravados
+type runSummarizer struct {
+	testResultRepo repositories.TestResultRepository
+	testSuiteRepo  repositories.TestSuiteRepository
+}
+
+// summarize lista as suítes reprovadas ou com erro da execução, ordenadas pelo nome
+func (s runSummarizer) summarize(ctx context.Context, company *entities.Company, testRun *entities.TestRun) (RunSummary, error) {
+	results, err := s.testResultRepo.GetByTestRunID(ctx, testRun.CompanyID, testRun.ID)
+	if err != nil {
+		return RunSummary{}, fmt.Errorf("failed to get test results: %w", err)
+	}
+
+	var failed []FailedSuite
+	for _, result := range results {
+		if result.Status != entities.TestResultStatusFailed && result.Status != entities.TestResultStatusErrored {
+			continue
+		}
+
+		name := result.TestSuiteID.String()
+		if testSuite, err := s.testSuiteRepo.GetByID(ctx, testRun.CompanyID, result.TestSuiteID); err == nil {
+			name = testSuite.Name
+		}
+
+		var assertions []entities.AssertionResult
+		for _, assertion := range result.AssertionResults {
+			if !assertion.Passed {
+				assertions = append(assertions, assertion)
+			}
+		}
+
+		failed = append(failed, FailedSuite{
+			Name:         name,
+			Status:       result.Status,
+			ErrorMessage: result.ErrorMessage,
+			Assertions:   assertions,
+		})
+	}
+
+	sort.SliceStable(failed, func(i, j int) bool { return failed[i].Name < failed[j].Name })
+	return RunSummary{
+		CompanyName:  company.Name,
+		TestRun:      testRun,
+		FailedSuites: failed,
+	}, nil
+}
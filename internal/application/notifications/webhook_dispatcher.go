@@ -0,0 +1,298 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+
+	"github.com/google/uuid"
+)
+
+// Cabeçalhos enviados em cada entrega
+const (
+	HeaderEvent     = "X-EzTest-Event"
+	HeaderDelivery  = "X-EzTest-Delivery"
+	HeaderTimestamp = "X-EzTest-Timestamp"
+	HeaderSignature = "X-EzTest-Signature"
+)
+
+// DispatcherConfig agrupa as configurações de entrega dos webhooks
+type DispatcherConfig struct {
+	PollInterval time.Duration
+	Timeout      time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	BatchSize    int
+}
+
+// WebhookPayload é o corpo JSON enviado aos webhooks
+type WebhookPayload struct {
+	ID         uuid.UUID             `json:"id"`
+	Event      entities.WebhookEvent `json:"event"`
+	CompanyID  uuid.UUID             `json:"company_id"`
+	OccurredAt time.Time             `json:"occurred_at"`
+	Data       interface{}           `json:"data"`
+}
+
+// WebhookDispatcher registra as entregas dos eventos e as envia em background,
+// com novas tentativas e backoff exponencial em caso de falha
+type WebhookDispatcher struct {
+	webhookRepo  repositories.WebhookRepository
+	deliveryRepo repositories.WebhookDeliveryRepository
+	secretCipher *security.SecretCipher
+	client       *http.Client
+	config       DispatcherConfig
+
+	wake chan struct{}
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+// NewWebhookDispatcher cria uma nova instância do despachante de webhooks
+func NewWebhookDispatcher(
+	webhookRepo repositories.WebhookRepository,
+	deliveryRepo repositories.WebhookDeliveryRepository,
+	secretCipher *security.SecretCipher,
+	config DispatcherConfig,
+) *WebhookDispatcher {
+	if config.PollInterval <= 0 {
+		config.PollInterval = 5 * time.Second
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 6
+	}
+	if config.BaseBackoff <= 0 {
+		config.BaseBackoff = 30 * time.Second
+	}
+	if config.BatchSize < 1 {
+		config.BatchSize = 20
+	}
+
+	return &WebhookDispatcher{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		secretCipher: secretCipher,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			// Redirecionamentos não são seguidos: a assinatura vale apenas para a URL configurada
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		config: config,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Publish registra a entrega do evento para cada webhook ativo da empresa que o assina
+func (d *WebhookDispatcher) Publish(ctx context.Context, companyID uuid.UUID, event entities.WebhookEvent, data interface{}) error {
+	webhooks, err := d.webhookRepo.GetSubscribed(ctx, companyID, event)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := buildPayload(companyID, event, data)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		if _, err := d.deliveryRepo.Create(ctx, entities.NewWebhookDelivery(webhook, event, payload)); err != nil {
+			return err
+		}
+	}
+	d.Notify()
+	return nil
+}
+
+// Name identifica o canal nos logs
+func (d *WebhookDispatcher) Name() string {
+	return "webhook"
+}
+
+// NotifyRunFinished registra o evento test_run.finished para os webhooks da empresa
+func (d *WebhookDispatcher) NotifyRunFinished(ctx context.Context, testRun *entities.TestRun) error {
+	return d.Publish(ctx, testRun.CompanyID, entities.WebhookEventTestRunFinished, TestRunFinishedData{TestRun: testRun})
+}
+
+// SendTest registra a entrega de um evento de teste ao webhook, mesmo que esteja desativado
+func (d *WebhookDispatcher) SendTest(ctx context.Context, webhook *entities.Webhook) (*entities.WebhookDelivery, error) {
+	payload, err := buildPayload(webhook.CompanyID, entities.WebhookEventPing, map[string]interface{}{
+		"webhook_id": webhook.ID,
+		"message":    "This is a test event",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := d.deliveryRepo.Create(ctx, entities.NewWebhookDelivery(webhook, entities.WebhookEventPing, payload))
+	if err != nil {
+		return nil, err
+	}
+	d.Notify()
+	return delivery, nil
+}
+
+// Notify acorda o despachante para enviar entregas recém-registradas
+func (d *WebhookDispatcher) Notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start inicia o envio das entregas pendentes em background
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	ctx, d.stop = context.WithCancel(ctx)
+
+	d.wg.Add(1)
+	go d.run(ctx)
+	log.Printf("📨 Webhook dispatcher started")
+}
+
+// Stop interrompe o despachante; entregas não concluídas são retomadas no próximo início
+func (d *WebhookDispatcher) Stop() {
+	if d.stop != nil {
+		d.stop()
+	}
+	d.wg.Wait()
+}
+
+// run envia as entregas vencidas até o despachante ser encerrado
+func (d *WebhookDispatcher) run(ctx context.Context) {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			// A reserva cobre todas as entregas do lote, enviadas em sequência
+			lease := time.Duration(d.config.BatchSize) * (d.config.Timeout + time.Second)
+			deliveries, err := d.deliveryRepo.ClaimDue(ctx, time.Now().UTC(), lease, d.config.BatchSize)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("❌ [ERROR] Failed to claim webhook deliveries: %v", err)
+				}
+				break
+			}
+			for _, delivery := range deliveries {
+				if ctx.Err() != nil {
+					return
+				}
+				d.deliver(ctx, delivery)
+			}
+			if len(deliveries) < d.config.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver executa uma tentativa de entrega e registra o resultado
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *entities.WebhookDelivery) {
+	attempt, succeeded := d.attempt(ctx, delivery)
+	if ctx.Err() != nil {
+		// Encerramento da aplicação: a reserva expira e a entrega é retomada depois
+		return
+	}
+
+	delivery.RecordAttempt(attempt, succeeded, d.config.MaxAttempts, d.config.BaseBackoff)
+	if err := d.deliveryRepo.Update(ctx, delivery); err != nil {
+		log.Printf("❌ [ERROR] Failed to record webhook delivery %s: %v", delivery.ID, err)
+		return
+	}
+	if delivery.Status == entities.WebhookDeliveryFailed {
+		log.Printf("⚠️  [WARN] Webhook delivery %s failed after %d attempts", delivery.ID, len(delivery.Attempts))
+	}
+}
+
+// attempt envia o evento assinado ao webhook; respostas 2xx são consideradas entregues
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery *entities.WebhookDelivery) (entities.WebhookAttempt, bool) {
+	attempt := entities.WebhookAttempt{AttemptedAt: time.Now().UTC()}
+	fail := func(err error) (entities.WebhookAttempt, bool) {
+		attempt.Error = err.Error()
+		attempt.DurationMS = int(time.Since(attempt.AttemptedAt).Milliseconds())
+		return attempt, false
+	}
+
+	webhook, err := d.webhookRepo.GetByID(ctx, delivery.CompanyID, delivery.WebhookID)
+	if err != nil {
+		return fail(err)
+	}
+	if !webhook.Enabled && delivery.Event != entities.WebhookEventPing {
+		return fail(fmt.Errorf("webhook disabled"))
+	}
+
+	secret, err := d.secretCipher.Decrypt(webhook.EncryptedSecret, webhook.ID[:])
+	if err != nil {
+		return fail(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fail(fmt.Errorf("failed to build request: %w", err))
+	}
+	timestamp := attempt.AttemptedAt.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "EzTest-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, string(delivery.Event))
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, security.SignWebhookPayload(secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+	defer resp.Body.Close()
+	// Descartar o corpo para reaproveitar a conexão
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	attempt.ResponseStatus = resp.StatusCode
+	attempt.DurationMS = int(time.Since(attempt.AttemptedAt).Milliseconds())
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Error = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+		return attempt, false
+	}
+	return attempt, true
+}
+
+// buildPayload monta o corpo JSON do evento
+func buildPayload(companyID uuid.UUID, event entities.WebhookEvent, data interface{}) (json.RawMessage, error) {
+	payload, err := json.Marshal(WebhookPayload{
+		ID:         uuid.New(),
+		Event:      event,
+		CompanyID:  companyID,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,259 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+
+	"github.com/google/uuid"
+)
+
+// fakeWebhookRepo guarda os webhooks em memória; só a busca é usada pelo despachante
+type fakeWebhookRepo struct {
+	repositories.WebhookRepository
+	webhooks map[uuid.UUID]*entities.Webhook
+}
+
+func (r *fakeWebhookRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Webhook, error) {
+	webhook, ok := r.webhooks[id]
+	if !ok || webhook.CompanyID != companyID {
+		return nil, fmt.Errorf("webhook %w", domainErrors.ErrNotFound)
+	}
+	return webhook, nil
+}
+
+// fakeDeliveryRepo registra as entregas atualizadas pelo despachante
+type fakeDeliveryRepo struct {
+	repositories.WebhookDeliveryRepository
+	updated []*entities.WebhookDelivery
+}
+
+func (r *fakeDeliveryRepo) Update(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	r.updated = append(r.updated, delivery)
+	return nil
+}
+
+// receivedWebhook é uma requisição recebida pelo servidor de teste, com a assinatura já conferida
+type receivedWebhook struct {
+	event          string
+	delivery       string
+	validSignature bool
+}
+
+// webhookReceiver responde com o status informado e confere a assinatura como um receptor faria
+type webhookReceiver struct {
+	secret string
+	status int
+
+	mu       sync.Mutex
+	received []receivedWebhook
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	valid := err == nil && security.VerifyWebhookSignature([]byte(r.secret), timestamp, body, req.Header.Get(HeaderSignature))
+
+	r.mu.Lock()
+	r.received = append(r.received, receivedWebhook{
+		event:          req.Header.Get(HeaderEvent),
+		delivery:       req.Header.Get(HeaderDelivery),
+		validSignature: valid && json.Valid(body),
+	})
+	r.mu.Unlock()
+
+	if !valid {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.status == http.StatusFound {
+		http.Redirect(w, req, "/elsewhere", http.StatusFound)
+		return
+	}
+	w.WriteHeader(r.status)
+}
+
+func TestWebhookDispatcherDeliver(t *testing.T) {
+	const secret = "whsec_test"
+	cipher, err := security.NewSecretCipher("test-master-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	companyID := uuid.New()
+
+	tests := []struct {
+		name         string
+		status       int
+		enabled      bool
+		event        entities.WebhookEvent
+		otherCompany bool
+		// signingSecret é o segredo gravado no webhook; vazio usa o mesmo do receptor
+		signingSecret string
+		maxAttempts   int
+		wantStatus    entities.WebhookDeliveryStatus
+		wantError     string
+		wantRequest   bool
+	}{
+		{
+			name:        "signed delivery accepted",
+			status:      http.StatusNoContent,
+			enabled:     true,
+			event:       entities.WebhookEventTestRunFinished,
+			wantStatus:  entities.WebhookDeliverySucceeded,
+			wantRequest: true,
+		},
+		{
+			name:        "server error is retried",
+			status:      http.StatusInternalServerError,
+			enabled:     true,
+			event:       entities.WebhookEventTestRunFinished,
+			wantStatus:  entities.WebhookDeliveryPending,
+			wantError:   "unexpected status 500",
+			wantRequest: true,
+		},
+		{
+			name:        "last attempt fails the delivery",
+			status:      http.StatusInternalServerError,
+			enabled:     true,
+			event:       entities.WebhookEventTestRunFinished,
+			maxAttempts: 1,
+			wantStatus:  entities.WebhookDeliveryFailed,
+			wantError:   "unexpected status 500",
+			wantRequest: true,
+		},
+		{
+			name:        "redirects are not followed",
+			status:      http.StatusFound,
+			enabled:     true,
+			event:       entities.WebhookEventTestRunFinished,
+			wantStatus:  entities.WebhookDeliveryPending,
+			wantError:   "unexpected status 302",
+			wantRequest: true,
+		},
+		{
+			name:          "signature with another secret is rejected",
+			status:        http.StatusOK,
+			enabled:       true,
+			event:         entities.WebhookEventTestRunFinished,
+			signingSecret: "whsec_other",
+			wantStatus:    entities.WebhookDeliveryPending,
+			wantError:     "unexpected status 401",
+			wantRequest:   true,
+		},
+		{
+			name:       "disabled webhook is not called",
+			status:     http.StatusOK,
+			event:      entities.WebhookEventTestRunFinished,
+			wantStatus: entities.WebhookDeliveryPending,
+			wantError:  "webhook disabled",
+		},
+		{
+			name:        "ping reaches disabled webhook",
+			status:      http.StatusOK,
+			event:       entities.WebhookEventPing,
+			wantStatus:  entities.WebhookDeliverySucceeded,
+			wantRequest: true,
+		},
+		{
+			name:         "webhook of another company is not called",
+			status:       http.StatusOK,
+			enabled:      true,
+			event:        entities.WebhookEventTestRunFinished,
+			otherCompany: true,
+			wantStatus:   entities.WebhookDeliveryPending,
+			wantError:    "webhook not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := &webhookReceiver{secret: secret, status: tt.status}
+			server := httptest.NewServer(receiver)
+			defer server.Close()
+
+			webhook := entities.NewWebhook(companyID, server.URL+"/hooks", []entities.WebhookEvent{entities.WebhookEventTestRunFinished}, tt.enabled)
+			signingSecret := tt.signingSecret
+			if signingSecret == "" {
+				signingSecret = secret
+			}
+			encrypted, err := cipher.Encrypt([]byte(signingSecret), webhook.ID[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			webhook.SetEncryptedSecret(encrypted)
+
+			deliveryRepo := &fakeDeliveryRepo{}
+			dispatcher := NewWebhookDispatcher(
+				&fakeWebhookRepo{webhooks: map[uuid.UUID]*entities.Webhook{webhook.ID: webhook}},
+				deliveryRepo,
+				cipher,
+				DispatcherConfig{Timeout: time.Second, MaxAttempts: tt.maxAttempts, BaseBackoff: time.Minute},
+			)
+
+			payload, err := buildPayload(companyID, tt.event, map[string]string{"status": "passed"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			delivery := entities.NewWebhookDelivery(webhook, tt.event, payload)
+			if tt.otherCompany {
+				delivery.CompanyID = uuid.New()
+			}
+
+			dispatcher.deliver(context.Background(), delivery)
+
+			if len(deliveryRepo.updated) != 1 {
+				t.Fatalf("updated deliveries = %d, want 1", len(deliveryRepo.updated))
+			}
+			if delivery.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", delivery.Status, tt.wantStatus)
+			}
+			if len(delivery.Attempts) != 1 || delivery.Attempts[0].Error != tt.wantError {
+				t.Fatalf("attempts = %+v, want one with error %q", delivery.Attempts, tt.wantError)
+			}
+			switch tt.wantStatus {
+			case entities.WebhookDeliverySucceeded:
+				if delivery.DeliveredAt == nil || delivery.NextAttemptAt != nil {
+					t.Errorf("delivered at = %v, next attempt at = %v", delivery.DeliveredAt, delivery.NextAttemptAt)
+				}
+			case entities.WebhookDeliveryPending:
+				want := delivery.Attempts[0].AttemptedAt.Add(time.Minute)
+				if delivery.NextAttemptAt == nil || !delivery.NextAttemptAt.Equal(want) {
+					t.Errorf("next attempt at = %v, want %v", delivery.NextAttemptAt, want)
+				}
+			case entities.WebhookDeliveryFailed:
+				if delivery.NextAttemptAt != nil {
+					t.Errorf("next attempt at = %v, want none", delivery.NextAttemptAt)
+				}
+			}
+
+			if !tt.wantRequest {
+				if len(receiver.received) != 0 {
+					t.Fatalf("received %d requests, want none", len(receiver.received))
+				}
+				return
+			}
+			if len(receiver.received) != 1 {
+				t.Fatalf("received %d requests, want 1", len(receiver.received))
+			}
+			received := receiver.received[0]
+			if received.event != string(tt.event) || received.delivery != delivery.ID.String() {
+				t.Errorf("received event %q delivery %q, want %q %q", received.event, received.delivery, tt.event, delivery.ID)
+			}
+			if wantValid := tt.signingSecret == ""; received.validSignature != wantValid {
+				t.Errorf("valid signature = %v, want %v", received.validSignature, wantValid)
+			}
+		})
+	}
+}
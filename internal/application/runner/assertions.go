@@ -0,0 +1,249 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/value_objects"
+)
+
+// response agrupa os dados da resposta usados na avaliação das asserções
+type response struct {
+	headers http.Header
+	body    []byte
+	latency time.Duration
+	// O corpo passou de maxResponseBodySize e body contém apenas o início
+	truncated bool
+
+	// Documento JSON decodificado sob demanda
+	document   interface{}
+	decoded    bool
+	decodedErr error
+}
+
+// json decodifica o corpo uma única vez para todas as asserções JSONPath
+func (r *response) json() (interface{}, error) {
+	if !r.decoded {
+		r.decoded = true
+		r.decodedErr = json.Unmarshal(r.body, &r.document)
+	}
+	return r.document, r.decodedErr
+}
+
+// evaluateAssertions avalia as asserções da suíte na ordem definida
+func evaluateAssertions(assertions []*entities.Assertion, resp *response) []entities.AssertionResult {
+	results := make([]entities.AssertionResult, 0, len(assertions))
+	for _, assertion := range assertions {
+		result := entities.AssertionResult{
+			AssertionID: assertion.ID,
+			Type:        assertion.Type,
+			Target:      assertion.Target,
+			Expected:    assertion.Expected,
+		}
+		result.Actual, result.Passed, result.Message = evaluateAssertion(assertion, resp)
+		results = append(results, result)
+	}
+	return results
+}
+
+// evaluateAssertion retorna o valor observado, se a asserção passou e a mensagem de falha
+func evaluateAssertion(assertion *entities.Assertion, resp *response) (actual string, passed bool, message string) {
+	if resp.truncated && readsBody(assertion.Type) {
+		return "", false, truncatedBodyMessage
+	}
+
+	switch assertion.Type {
+	case entities.AssertionJSONPathEquals, entities.AssertionJSONPathContains, entities.AssertionJSONPathExists:
+		return evaluateJSONPath(assertion, resp)
+
+	case entities.AssertionBodyRegex:
+		pattern, err := regexp.Compile(assertion.Expected)
+		if err != nil {
+			return "", false, fmt.Sprintf("invalid regular expression: %v", err)
+		}
+		if !pattern.Match(resp.body) {
+			return "", false, fmt.Sprintf("response body does not match %q", assertion.Expected)
+		}
+		return "", true, ""
+
+	case entities.AssertionHeaderEquals:
+		values := resp.headers.Values(assertion.Target)
+		if len(values) == 0 {
+			return "", false, fmt.Sprintf("header %q not present", assertion.Target)
+		}
+		actual = strings.Join(values, ", ")
+		if actual != strings.TrimSpace(assertion.Expected) {
+			return actual, false, fmt.Sprintf("expected header %q to be %q, got %q", assertion.Target, assertion.Expected, actual)
+		}
+		return actual, true, ""
+
+	case entities.AssertionHeaderPresent:
+		if len(resp.headers.Values(assertion.Target)) == 0 {
+			return "", false, fmt.Sprintf("header %q not present", assertion.Target)
+		}
+		return resp.headers.Get(assertion.Target), true, ""
+
+	case entities.AssertionResponseTimeUnder:
+		limit, err := assertion.Limit()
+		if err != nil {
+			return "", false, err.Error()
+		}
+		elapsed := resp.latency.Milliseconds()
+		actual = fmt.Sprintf("%d", elapsed)
+		if elapsed >= limit {
+			return actual, false, fmt.Sprintf("expected response time under %dms, got %dms", limit, elapsed)
+		}
+		return actual, true, ""
+
+	case entities.AssertionBodySizeMax, entities.AssertionBodySizeMin:
+		limit, err := assertion.Limit()
+		if err != nil {
+			return "", false, err.Error()
+		}
+		size := int64(len(resp.body))
+		actual = fmt.Sprintf("%d", size)
+		if resp.truncated {
+			// Só se sabe que o corpo tem mais que size bytes; limites acima disso ficam indeterminados
+			actual = fmt.Sprintf(">%d", size)
+			if (assertion.Type == entities.AssertionBodySizeMax && limit >= size) ||
+				(assertion.Type == entities.AssertionBodySizeMin && limit > size) {
+				return actual, false, truncatedBodyMessage
+			}
+		}
+		if assertion.Type == entities.AssertionBodySizeMax && size > limit {
+			return actual, false, fmt.Sprintf("expected body size at most %d bytes, got %d", limit, size)
+		}
+		if assertion.Type == entities.AssertionBodySizeMin && size < limit {
+			return actual, false, fmt.Sprintf("expected body size at least %d bytes, got %d", limit, size)
+		}
+		return actual, true, ""
+
+	case entities.AssertionContentType:
+		actual = resp.headers.Get("Content-Type")
+		if !contentTypeMatches(assertion.Expected, actual) {
+			return actual, false, fmt.Sprintf("expected content type %q, got %q", assertion.Expected, actual)
+		}
+		return actual, true, ""
+	}
+
+	return "", false, fmt.Sprintf("unknown assertion type %q", assertion.Type)
+}
+
+// evaluateJSONPath avalia as asserções baseadas em JSONPath
+func evaluateJSONPath(assertion *entities.Assertion, resp *response) (actual string, passed bool, message string) {
+	path, err := value_objects.NewJSONPath(assertion.Target)
+	if err != nil {
+		return "", false, err.Error()
+	}
+
+	document, err := resp.json()
+	if err != nil {
+		return "", false, "response body is not valid JSON"
+	}
+
+	matches := path.Evaluate(document)
+	if len(matches) == 0 {
+		return "", false, fmt.Sprintf("path %s not found", path)
+	}
+
+	// Expressões com curinga são comparadas como a lista de valores encontrados
+	var value interface{} = matches[0]
+	if path.HasWildcard() {
+		value = matches
+	}
+	actual = encodeValue(value)
+
+	switch assertion.Type {
+	case entities.AssertionJSONPathExists:
+		return actual, true, ""
+	case entities.AssertionJSONPathEquals:
+		if !reflect.DeepEqual(value, expectedValue(assertion.Expected)) {
+			return actual, false, fmt.Sprintf("expected %s to equal %s, got %s", path, assertion.Expected, actual)
+		}
+		return actual, true, ""
+	default:
+		if !containsValue(value, assertion.Expected) {
+			return actual, false, fmt.Sprintf("expected %s to contain %s, got %s", path, assertion.Expected, actual)
+		}
+		return actual, true, ""
+	}
+}
+
+// expectedValue interpreta o valor esperado como JSON; se não for JSON válido, como texto
+func expectedValue(expected string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(expected), &value); err != nil {
+		return expected
+	}
+	return value
+}
+
+// containsValue verifica substring em textos, elemento em listas e chave em objetos
+func containsValue(value interface{}, expected string) bool {
+	switch actual := value.(type) {
+	case string:
+		text, ok := expectedValue(expected).(string)
+		if !ok {
+			text = expected
+		}
+		return strings.Contains(actual, text)
+	case []interface{}:
+		want := expectedValue(expected)
+		for _, item := range actual {
+			if reflect.DeepEqual(item, want) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		key, ok := expectedValue(expected).(string)
+		if !ok {
+			key = expected
+		}
+		_, found := actual[key]
+		return found
+	}
+	return false
+}
+
+// readsBody indica se a asserção depende do conteúdo do corpo da resposta
+func readsBody(assertionType entities.AssertionType) bool {
+	switch assertionType {
+	case entities.AssertionJSONPathEquals, entities.AssertionJSONPathContains, entities.AssertionJSONPathExists, entities.AssertionBodyRegex:
+		return true
+	}
+	return false
+}
+
+// contentTypeMatches compara o media type e, se informados no esperado, os parâmetros
+func contentTypeMatches(expected, actual string) bool {
+	expectedType, expectedParams, err := mime.ParseMediaType(expected)
+	if err != nil {
+		return false
+	}
+	actualType, actualParams, err := mime.ParseMediaType(actual)
+	if err != nil || !strings.EqualFold(expectedType, actualType) {
+		return false
+	}
+	for key, value := range expectedParams {
+		if !strings.EqualFold(actualParams[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeValue representa o valor encontrado em JSON para exibição no resultado
+func encodeValue(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
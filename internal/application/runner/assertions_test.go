@@ -0,0 +1,222 @@
+package runner
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"TestGO/internal/domain/entities"
+)
+
+func TestEvaluateAssertion(t *testing.T) {
+	body := `{"user": {"id": 42, "name": "admin", "roles": ["owner", "viewer"]}, "items": [{"id": 1}, {"id": 2}]}`
+	headers := http.Header{
+		"Content-Type": {"application/json; charset=utf-8"},
+		"X-Request-Id": {"abc"},
+	}
+
+	tests := []struct {
+		name        string
+		assertion   entities.Assertion
+		body        string
+		truncated   bool
+		wantPassed  bool
+		wantActual  string
+		wantMessage string
+	}{
+		{
+			name:       "json path equals number",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathEquals, Target: "$.user.id", Expected: "42"},
+			wantPassed: true,
+			wantActual: "42",
+		},
+		{
+			name:       "json path equals plain text",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathEquals, Target: "$.user.name", Expected: "admin"},
+			wantPassed: true,
+			wantActual: `"admin"`,
+		},
+		{
+			name:        "json path equals mismatch",
+			assertion:   entities.Assertion{Type: entities.AssertionJSONPathEquals, Target: "$.user.id", Expected: "41"},
+			wantActual:  "42",
+			wantMessage: "expected $.user.id to equal 41, got 42",
+		},
+		{
+			name:       "json path equals wildcard list",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathEquals, Target: "$.items[*].id", Expected: "[1, 2]"},
+			wantPassed: true,
+			wantActual: "[1,2]",
+		},
+		{
+			name:       "json path contains substring",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathContains, Target: "$.user.name", Expected: "dmi"},
+			wantPassed: true,
+			wantActual: `"admin"`,
+		},
+		{
+			name:       "json path contains list element",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathContains, Target: "$.user.roles", Expected: "viewer"},
+			wantPassed: true,
+			wantActual: `["owner","viewer"]`,
+		},
+		{
+			name:       "json path contains object key",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathContains, Target: "$.user", Expected: "roles"},
+			wantPassed: true,
+			wantActual: `{"id":42,"name":"admin","roles":["owner","viewer"]}`,
+		},
+		{
+			name:        "json path contains missing element",
+			assertion:   entities.Assertion{Type: entities.AssertionJSONPathContains, Target: "$.user.roles", Expected: "editor"},
+			wantActual:  `["owner","viewer"]`,
+			wantMessage: `expected $.user.roles to contain editor, got ["owner","viewer"]`,
+		},
+		{
+			name:       "json path exists",
+			assertion:  entities.Assertion{Type: entities.AssertionJSONPathExists, Target: "$.items[-1].id"},
+			wantPassed: true,
+			wantActual: "2",
+		},
+		{
+			name:        "json path not found",
+			assertion:   entities.Assertion{Type: entities.AssertionJSONPathExists, Target: "$.user.email"},
+			wantMessage: "path $.user.email not found",
+		},
+		{
+			name:        "json path on invalid json",
+			assertion:   entities.Assertion{Type: entities.AssertionJSONPathExists, Target: "$.id"},
+			body:        "not json",
+			wantMessage: "response body is not valid JSON",
+		},
+		{
+			name:        "json path on truncated body",
+			assertion:   entities.Assertion{Type: entities.AssertionJSONPathExists, Target: "$.user"},
+			truncated:   true,
+			wantMessage: truncatedBodyMessage,
+		},
+		{
+			name:       "body regex",
+			assertion:  entities.Assertion{Type: entities.AssertionBodyRegex, Expected: `"id":\s*42`},
+			wantPassed: true,
+		},
+		{
+			name:        "body regex mismatch",
+			assertion:   entities.Assertion{Type: entities.AssertionBodyRegex, Expected: "error"},
+			wantMessage: `response body does not match "error"`,
+		},
+		{
+			name:        "body regex invalid",
+			assertion:   entities.Assertion{Type: entities.AssertionBodyRegex, Expected: "("},
+			wantMessage: "invalid regular expression: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name:       "header equals",
+			assertion:  entities.Assertion{Type: entities.AssertionHeaderEquals, Target: "x-request-id", Expected: "abc"},
+			wantPassed: true,
+			wantActual: "abc",
+		},
+		{
+			name:        "header equals mismatch",
+			assertion:   entities.Assertion{Type: entities.AssertionHeaderEquals, Target: "X-Request-Id", Expected: "xyz"},
+			wantActual:  "abc",
+			wantMessage: `expected header "X-Request-Id" to be "xyz", got "abc"`,
+		},
+		{
+			name:       "header present",
+			assertion:  entities.Assertion{Type: entities.AssertionHeaderPresent, Target: "X-Request-Id"},
+			wantPassed: true,
+			wantActual: "abc",
+		},
+		{
+			name:        "header missing",
+			assertion:   entities.Assertion{Type: entities.AssertionHeaderPresent, Target: "Location"},
+			wantMessage: `header "Location" not present`,
+		},
+		{
+			name:       "response time under",
+			assertion:  entities.Assertion{Type: entities.AssertionResponseTimeUnder, Expected: "500"},
+			wantPassed: true,
+			wantActual: "120",
+		},
+		{
+			name:        "response time over",
+			assertion:   entities.Assertion{Type: entities.AssertionResponseTimeUnder, Expected: "100"},
+			wantActual:  "120",
+			wantMessage: "expected response time under 100ms, got 120ms",
+		},
+		{
+			name:        "response time invalid limit",
+			assertion:   entities.Assertion{Type: entities.AssertionResponseTimeUnder, Expected: "fast"},
+			wantMessage: "expected must be a non-negative integer",
+		},
+		{
+			name:       "body size max",
+			assertion:  entities.Assertion{Type: entities.AssertionBodySizeMax, Expected: "10"},
+			body:       "0123456789",
+			wantPassed: true,
+			wantActual: "10",
+		},
+		{
+			name:        "body size min",
+			assertion:   entities.Assertion{Type: entities.AssertionBodySizeMin, Expected: "11"},
+			body:        "0123456789",
+			wantActual:  "10",
+			wantMessage: "expected body size at least 11 bytes, got 10",
+		},
+		{
+			name:        "body size max on truncated body",
+			assertion:   entities.Assertion{Type: entities.AssertionBodySizeMax, Expected: "20"},
+			body:        "0123456789",
+			truncated:   true,
+			wantActual:  ">10",
+			wantMessage: truncatedBodyMessage,
+		},
+		{
+			name:       "body size min below truncated body",
+			assertion:  entities.Assertion{Type: entities.AssertionBodySizeMin, Expected: "5"},
+			body:       "0123456789",
+			truncated:  true,
+			wantPassed: true,
+			wantActual: ">10",
+		},
+		{
+			name:       "content type ignores parameters not expected",
+			assertion:  entities.Assertion{Type: entities.AssertionContentType, Expected: "Application/JSON"},
+			wantPassed: true,
+			wantActual: "application/json; charset=utf-8",
+		},
+		{
+			name:        "content type parameter mismatch",
+			assertion:   entities.Assertion{Type: entities.AssertionContentType, Expected: "application/json; charset=latin1"},
+			wantActual:  "application/json; charset=utf-8",
+			wantMessage: `expected content type "application/json; charset=latin1", got "application/json; charset=utf-8"`,
+		},
+		{
+			name:        "unknown type",
+			assertion:   entities.Assertion{Type: "status_code"},
+			wantMessage: `unknown assertion type "status_code"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responseBody := body
+			if tt.body != "" {
+				responseBody = tt.body
+			}
+			resp := &response{
+				headers:   headers,
+				body:      []byte(responseBody),
+				latency:   120 * time.Millisecond,
+				truncated: tt.truncated,
+			}
+
+			actual, passed, message := evaluateAssertion(&tt.assertion, resp)
+			if passed != tt.wantPassed || actual != tt.wantActual || message != tt.wantMessage {
+				t.Errorf("evaluateAssertion() = (%q, %v, %q), want (%q, %v, %q)",
+					actual, passed, message, tt.wantActual, tt.wantPassed, tt.wantMessage)
+			}
+		})
+	}
+}
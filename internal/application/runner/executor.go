@@ -0,0 +1,234 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/entities"
+)
+
+// maxResponseBodySize limita a quantidade de bytes lidos da resposta
+const maxResponseBodySize = 1 << 20
+
+// truncatedBodyMessage é a falha das verificações que dependem do corpo completo da resposta
+var truncatedBodyMessage = fmt.Sprintf("response body exceeds %d bytes and was truncated", maxResponseBodySize)
+
+// Executor executa as requisições HTTP definidas nas suítes de teste
+type Executor struct {
+	client  *http.Client
+	timeout time.Duration
+}
+
+// NewExecutor cria uma nova instância do executor com o timeout informado
+func NewExecutor(timeout time.Duration) *Executor {
+	return &Executor{
+		client: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				// Não seguir redirecionamentos: o status esperado pode ser 3xx
+				return http.ErrUseLastResponse
+			},
+		},
+		timeout: timeout,
+	}
+}
+
+// Execute envia a requisição da suíte e compara a resposta com o esperado
+func (e *Executor) Execute(ctx context.Context, suite *entities.TestSuite) *Result {
+	if e.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+
+	req, err := buildRequest(ctx, suite)
+	if err != nil {
+		return &Result{
+			Status: StatusErrored,
+			Error:  fmt.Sprintf("failed to build request: %v", err),
+		}
+	}
+
+	start := time.Now()
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return &Result{
+			Status:  StatusErrored,
+			Latency: time.Since(start),
+			Error:   requestError(ctx, err),
+		}
+	}
+	defer resp.Body.Close()
+
+	// Um byte além do limite indica que o corpo foi cortado
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize+1))
+	latency := time.Since(start)
+	if err != nil {
+		return &Result{
+			Status:          StatusErrored,
+			ResponseStatus:  resp.StatusCode,
+			ResponseHeaders: resp.Header,
+			Latency:         latency,
+			Error:           fmt.Sprintf("failed to read response body: %v", requestError(ctx, err)),
+		}
+	}
+
+	truncated := len(body) > maxResponseBodySize
+	if truncated {
+		body = body[:maxResponseBodySize]
+	}
+
+	result := &Result{
+		ResponseStatus:  resp.StatusCode,
+		ResponseHeaders: resp.Header,
+		ResponseBody:    string(body),
+		BodyTruncated:   truncated,
+		Latency:         latency,
+	}
+
+	if suite.ExpectedStatus > 0 && resp.StatusCode != suite.ExpectedStatus {
+		result.Failures = append(result.Failures,
+			fmt.Sprintf("expected status %d, got %d", suite.ExpectedStatus, resp.StatusCode))
+	}
+
+	// Corpo cortado não é comparado nem validado: o resultado poderia ser falso
+	switch {
+	case suite.ExpectedBody == "":
+	case truncated:
+		result.Failures = append(result.Failures, "expected body not compared: "+truncatedBodyMessage)
+	case !bodyMatches(suite.ExpectedBody, body):
+		result.Failures = append(result.Failures, "response body does not match expected body")
+	}
+
+	switch {
+	case len(suite.ResponseSchema) == 0:
+	case truncated:
+		result.Failures = append(result.Failures, "response schema not validated: "+truncatedBodyMessage)
+	default:
+		result.SchemaViolations = validateSchema(suite.ResponseSchema, body)
+		if len(result.SchemaViolations) > 0 {
+			result.Failures = append(result.Failures,
+				fmt.Sprintf("response body does not match schema (%d violations)", len(result.SchemaViolations)))
+		}
+	}
+
+	result.AssertionResults = evaluateAssertions(suite.Assertions, &response{
+		headers:   resp.Header,
+		body:      body,
+		truncated: truncated,
+		latency:   latency,
+	})
+	for _, assertion := range result.AssertionResults {
+		if !assertion.Passed {
+			result.Failures = append(result.Failures,
+				fmt.Sprintf("assertion %s failed: %s", assertion.Type, assertion.Message))
+		}
+	}
+
+	if len(result.Failures) > 0 {
+		result.Status = StatusFailed
+		result.Error = strings.Join(result.Failures, "; ")
+	} else {
+		result.Status = StatusPassed
+	}
+
+	return result
+}
+
+// buildRequest monta a requisição da suíte: URL com parâmetros de query, headers e corpo
+func buildRequest(ctx context.Context, suite *entities.TestSuite) (*http.Request, error) {
+	target, err := url.Parse(suite.URL)
+	if err != nil {
+		return nil, err
+	}
+	if len(suite.QueryParams) > 0 {
+		query := target.Query()
+		for key, value := range suite.QueryParams {
+			query.Set(key, value)
+		}
+		target.RawQuery = query.Encode()
+	}
+
+	body, contentType, err := encodeBody(suite)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(suite.Method), target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range suite.Headers {
+		req.Header.Set(key, value)
+	}
+
+	// O boundary do multipart é gerado aqui, então o Content-Type sempre é sobrescrito;
+	// nos demais tipos o header informado na suíte tem precedência
+	if contentType != "" && (suite.BodyType == entities.RequestBodyMultipart || req.Header.Get("Content-Type") == "") {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return req, nil
+}
+
+// encodeBody serializa o corpo conforme o tipo e retorna o Content-Type padrão correspondente
+func encodeBody(suite *entities.TestSuite) (io.Reader, string, error) {
+	switch suite.BodyType {
+	case entities.RequestBodyJSON:
+		return strings.NewReader(suite.Body), "application/json", nil
+	case entities.RequestBodyRaw:
+		return strings.NewReader(suite.Body), "text/plain; charset=utf-8", nil
+	case entities.RequestBodyForm:
+		form := url.Values{}
+		for key, value := range suite.FormFields {
+			form.Set(key, value)
+		}
+		return strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", nil
+	case entities.RequestBodyMultipart:
+		var buf bytes.Buffer
+		writer := multipart.NewWriter(&buf)
+		for key, value := range suite.FormFields {
+			if err := writer.WriteField(key, value); err != nil {
+				return nil, "", err
+			}
+		}
+		if err := writer.Close(); err != nil {
+			return nil, "", err
+		}
+		return &buf, writer.FormDataContentType(), nil
+	default:
+		return nil, "", nil
+	}
+}
+
+// bodyMatches compara o corpo esperado com o recebido.
+// Quando ambos são JSON válidos a comparação é estrutural, ignorando formatação.
+func bodyMatches(expected string, actual []byte) bool {
+	var expectedJSON, actualJSON interface{}
+	if json.Unmarshal([]byte(expected), &expectedJSON) == nil && json.Unmarshal(actual, &actualJSON) == nil {
+		return reflect.DeepEqual(expectedJSON, actualJSON)
+	}
+	return bytes.Equal(bytes.TrimSpace([]byte(expected)), bytes.TrimSpace(actual))
+}
+
+// requestError descreve o erro da requisição, destacando timeouts e cancelamentos
+func requestError(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return "request timed out"
+	case errors.Is(ctx.Err(), context.Canceled):
+		return "request cancelled"
+	default:
+		return err.Error()
+	}
+}
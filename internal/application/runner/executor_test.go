@@ -0,0 +1,258 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"TestGO/internal/domain/entities"
+)
+
+// newTestServer responde cada caminho com o handler informado
+func newTestServer(t *testing.T, routes map[string]http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	for pattern, handler := range routes {
+		mux.HandleFunc(pattern, handler)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExecutorExecute(t *testing.T) {
+	server := newTestServer(t, map[string]http.HandlerFunc{
+		"/users": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			io.WriteString(w, `{"id": 42, "name": "admin"}`)
+		},
+		"/error": func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		},
+		"/redirect": func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/users", http.StatusFound)
+		},
+		"/slow": func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		},
+		"/large": func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, strings.Repeat("a", maxResponseBodySize+10))
+		},
+	})
+
+	tests := []struct {
+		name          string
+		suite         entities.TestSuite
+		wantStatus    Status
+		wantFailures  []string
+		wantError     string
+		wantTruncated bool
+	}{
+		{
+			name:       "status and body match",
+			suite:      entities.TestSuite{Method: "get", URL: server.URL + "/users", ExpectedStatus: 200, ExpectedBody: `{"name":"admin","id":42}`},
+			wantStatus: StatusPassed,
+		},
+		{
+			name:         "unexpected status",
+			suite:        entities.TestSuite{Method: "GET", URL: server.URL + "/error", ExpectedStatus: 200},
+			wantStatus:   StatusFailed,
+			wantFailures: []string{"expected status 200, got 500"},
+		},
+		{
+			name:         "body mismatch",
+			suite:        entities.TestSuite{Method: "GET", URL: server.URL + "/users", ExpectedStatus: 200, ExpectedBody: `{"id": 1}`},
+			wantStatus:   StatusFailed,
+			wantFailures: []string{"response body does not match expected body"},
+		},
+		{
+			name:       "redirects are not followed",
+			suite:      entities.TestSuite{Method: "GET", URL: server.URL + "/redirect", ExpectedStatus: 302},
+			wantStatus: StatusPassed,
+		},
+		{
+			name: "schema violations",
+			suite: entities.TestSuite{
+				Method:         "GET",
+				URL:            server.URL + "/users",
+				ExpectedStatus: 200,
+				ResponseSchema: json.RawMessage(`{"type": "object", "properties": {"id": {"type": "string"}}}`),
+			},
+			wantStatus:   StatusFailed,
+			wantFailures: []string{"response body does not match schema (1 violations)"},
+		},
+		{
+			name: "failed assertion",
+			suite: entities.TestSuite{
+				Method:         "GET",
+				URL:            server.URL + "/users",
+				ExpectedStatus: 200,
+				Assertions: []*entities.Assertion{
+					{Type: entities.AssertionJSONPathEquals, Target: "$.id", Expected: "42"},
+					{Type: entities.AssertionHeaderEquals, Target: "Content-Type", Expected: "text/plain"},
+				},
+			},
+			wantStatus:   StatusFailed,
+			wantFailures: []string{`assertion header_equals failed: expected header "Content-Type" to be "text/plain", got "application/json"`},
+		},
+		{
+			name:          "truncated body is not compared",
+			suite:         entities.TestSuite{Method: "GET", URL: server.URL + "/large", ExpectedStatus: 200, ExpectedBody: "a"},
+			wantStatus:    StatusFailed,
+			wantFailures:  []string{"expected body not compared: " + truncatedBodyMessage},
+			wantTruncated: true,
+		},
+		{
+			name:       "timeout",
+			suite:      entities.TestSuite{Method: "GET", URL: server.URL + "/slow", ExpectedStatus: 200},
+			wantStatus: StatusErrored,
+			wantError:  "request timed out",
+		},
+		{
+			name:       "invalid url",
+			suite:      entities.TestSuite{Method: "GET", URL: "://invalid", ExpectedStatus: 200},
+			wantStatus: StatusErrored,
+			wantError:  `failed to build request: parse "://invalid": missing protocol scheme`,
+		},
+	}
+
+	executor := NewExecutor(200 * time.Millisecond)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := executor.Execute(context.Background(), &tt.suite)
+			if result.Status != tt.wantStatus {
+				t.Fatalf("status = %s, want %s (error %q)", result.Status, tt.wantStatus, result.Error)
+			}
+			if strings.Join(result.Failures, "\n") != strings.Join(tt.wantFailures, "\n") {
+				t.Errorf("failures = %q, want %q", result.Failures, tt.wantFailures)
+			}
+			wantError := tt.wantError
+			if len(tt.wantFailures) > 0 {
+				wantError = strings.Join(tt.wantFailures, "; ")
+			}
+			if result.Error != wantError {
+				t.Errorf("error = %q, want %q", result.Error, wantError)
+			}
+			if result.BodyTruncated != tt.wantTruncated {
+				t.Errorf("body truncated = %v, want %v", result.BodyTruncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestExecutorSendsRequest(t *testing.T) {
+	type received struct {
+		method      string
+		query       string
+		header      string
+		contentType string
+		body        string
+		form        map[string]string
+	}
+
+	tests := []struct {
+		name  string
+		suite entities.TestSuite
+		want  received
+	}{
+		{
+			name: "json body with query params and headers",
+			suite: entities.TestSuite{
+				Method:      "post",
+				Headers:     map[string]string{"X-Request-ID": "abc"},
+				QueryParams: map[string]string{"page": "2"},
+				BodyType:    entities.RequestBodyJSON,
+				Body:        `{"username": "admin"}`,
+			},
+			want: received{method: "POST", query: "page=2", header: "abc", contentType: "application/json", body: `{"username": "admin"}`},
+		},
+		{
+			name: "header overrides default content type",
+			suite: entities.TestSuite{
+				Method:   "PUT",
+				Headers:  map[string]string{"Content-Type": "application/vnd.api+json"},
+				BodyType: entities.RequestBodyJSON,
+				Body:     `{}`,
+			},
+			want: received{method: "PUT", contentType: "application/vnd.api+json", body: `{}`},
+		},
+		{
+			name:  "raw body",
+			suite: entities.TestSuite{Method: "PATCH", BodyType: entities.RequestBodyRaw, Body: "hello"},
+			want:  received{method: "PATCH", contentType: "text/plain; charset=utf-8", body: "hello"},
+		},
+		{
+			name:  "form fields",
+			suite: entities.TestSuite{Method: "POST", BodyType: entities.RequestBodyForm, FormFields: map[string]string{"user": "admin"}},
+			want:  received{method: "POST", contentType: "application/x-www-form-urlencoded", form: map[string]string{"user": "admin"}},
+		},
+		{
+			name: "multipart fields keep generated boundary",
+			suite: entities.TestSuite{
+				Method:     "POST",
+				Headers:    map[string]string{"Content-Type": "text/plain"},
+				BodyType:   entities.RequestBodyMultipart,
+				FormFields: map[string]string{"file": "content"},
+			},
+			want: received{method: "POST", contentType: "multipart/form-data", form: map[string]string{"file": "content"}},
+		},
+		{
+			name:  "no body",
+			suite: entities.TestSuite{Method: "DELETE", BodyType: entities.RequestBodyNone},
+			want:  received{method: "DELETE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got received
+			server := newTestServer(t, map[string]http.HandlerFunc{
+				"/": func(w http.ResponseWriter, r *http.Request) {
+					got.method = r.Method
+					got.query = r.URL.RawQuery
+					got.header = r.Header.Get("X-Request-ID")
+					got.contentType = r.Header.Get("Content-Type")
+					if tt.want.form != nil {
+						if err := r.ParseMultipartForm(1 << 20); err != nil && err != http.ErrNotMultipart {
+							t.Errorf("parse form: %v", err)
+						}
+						got.form = map[string]string{}
+						for key := range r.Form {
+							got.form[key] = r.Form.Get(key)
+						}
+						return
+					}
+					body, _ := io.ReadAll(r.Body)
+					got.body = string(body)
+				},
+			})
+
+			tt.suite.URL = server.URL + "/"
+			tt.suite.ExpectedStatus = http.StatusOK
+			result := NewExecutor(time.Second).Execute(context.Background(), &tt.suite)
+			if result.Status != StatusPassed {
+				t.Fatalf("status = %s, want passed (error %q)", result.Status, result.Error)
+			}
+
+			if got.method != tt.want.method || got.query != tt.want.query || got.header != tt.want.header || got.body != tt.want.body {
+				t.Errorf("request = %+v, want %+v", got, tt.want)
+			}
+			if !strings.HasPrefix(got.contentType, tt.want.contentType) || (tt.want.contentType == "" && got.contentType != "") {
+				t.Errorf("content type = %q, want %q", got.contentType, tt.want.contentType)
+			}
+			for key, value := range tt.want.form {
+				if got.form[key] != value {
+					t.Errorf("form field %s = %q, want %q", key, got.form[key], value)
+				}
+			}
+		})
+	}
+}
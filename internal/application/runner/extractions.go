@@ -0,0 +1,90 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/value_objects"
+)
+
+// extractValues aplica as regras de extração da suíte à resposta obtida.
+// Retorna os valores por nome de variável (extracted.NOME) e as regras que não encontraram valor.
+func extractValues(extractions []*entities.Extraction, result *Result) (map[string]string, []string) {
+	values := make(map[string]string, len(extractions))
+	var failures []string
+
+	resp := &response{headers: result.ResponseHeaders, body: []byte(result.ResponseBody), truncated: result.BodyTruncated}
+	for _, extraction := range extractions {
+		value, err := extractValue(extraction, resp)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("extraction %s failed: %v", extraction.Name, err))
+			continue
+		}
+		values[extraction.VariableName()] = value
+	}
+	return values, failures
+}
+
+// extractValue obtém o valor de uma regra de extração conforme a sua origem
+func extractValue(extraction *entities.Extraction, resp *response) (string, error) {
+	if resp.truncated && (extraction.Source == entities.ExtractionJSONPath || extraction.Source == entities.ExtractionRegex) {
+		return "", errors.New(truncatedBodyMessage)
+	}
+
+	switch extraction.Source {
+	case entities.ExtractionJSONPath:
+		path, err := value_objects.NewJSONPath(extraction.Expression)
+		if err != nil {
+			return "", err
+		}
+		document, err := resp.json()
+		if err != nil {
+			return "", fmt.Errorf("response body is not valid JSON")
+		}
+		matches := path.Evaluate(document)
+		if len(matches) == 0 {
+			return "", fmt.Errorf("path %s not found", path)
+		}
+		// Textos são usados sem aspas; demais valores em JSON
+		if text, ok := matches[0].(string); ok {
+			return text, nil
+		}
+		return encodeValue(matches[0]), nil
+
+	case entities.ExtractionHeader:
+		values := resp.headers.Values(extraction.Expression)
+		if len(values) == 0 {
+			return "", fmt.Errorf("header %q not present", extraction.Expression)
+		}
+		return values[0], nil
+
+	case entities.ExtractionRegex:
+		pattern, err := regexp.Compile(extraction.Expression)
+		if err != nil {
+			return "", fmt.Errorf("invalid regular expression: %w", err)
+		}
+		match := pattern.FindSubmatch(resp.body)
+		if match == nil {
+			return "", fmt.Errorf("response body does not match %q", extraction.Expression)
+		}
+		// Primeiro grupo de captura, se houver; senão o trecho encontrado
+		if len(match) > 1 {
+			return string(match[1]), nil
+		}
+		return string(match[0]), nil
+
+	case entities.ExtractionCookie:
+		cookies := (&http.Response{Header: resp.headers}).Cookies()
+		for _, cookie := range cookies {
+			if cookie.Name == extraction.Expression {
+				return cookie.Value, nil
+			}
+		}
+		return "", fmt.Errorf("cookie %q not set", extraction.Expression)
+	}
+
+	return "", fmt.Errorf("unknown extraction source %q", extraction.Source)
+}
@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// orderByDependencies ordena as suítes para que cada uma execute depois das suas dependências,
+// preservando a ordem original entre suítes independentes. Suítes presas em um ciclo de
+// dependências são devolvidas separadamente, pois não podem ser executadas.
+func orderByDependencies(testSuites []*entities.TestSuite) (ordered, cyclic []*entities.TestSuite) {
+	inRun := make(map[uuid.UUID]bool, len(testSuites))
+	for _, testSuite := range testSuites {
+		inRun[testSuite.ID] = true
+	}
+
+	done := make(map[uuid.UUID]bool, len(testSuites))
+	pending := testSuites
+	for len(pending) > 0 {
+		var next []*entities.TestSuite
+		for _, testSuite := range pending {
+			if dependenciesDone(testSuite, inRun, done) {
+				ordered = append(ordered, testSuite)
+				done[testSuite.ID] = true
+			} else {
+				next = append(next, testSuite)
+			}
+		}
+		if len(next) == len(pending) {
+			// Nenhuma suíte pôde avançar: as restantes dependem umas das outras
+			return ordered, next
+		}
+		pending = next
+	}
+	return ordered, nil
+}
+
+// dependenciesDone indica se todas as dependências da suíte presentes na execução já foram ordenadas
+func dependenciesDone(testSuite *entities.TestSuite, inRun, done map[uuid.UUID]bool) bool {
+	for _, id := range testSuite.DependsOn {
+		if inRun[id] && !done[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// unmetDependency retorna a mensagem de pulo se alguma dependência da suíte não passou na execução
+// ou não faz parte dela. names traz as suítes da execução; as presas em um ciclo ainda não têm resultado
+// e são tratadas pela detecção de ciclos.
+func unmetDependency(testSuite *entities.TestSuite, outcomes map[uuid.UUID]Status, names map[uuid.UUID]string) (string, bool) {
+	for _, id := range testSuite.DependsOn {
+		name, inRun := names[id]
+		if !inRun {
+			return fmt.Sprintf("skipped: dependency %s not in run", id), true
+		}
+		status, ran := outcomes[id]
+		if !ran || status == StatusPassed {
+			continue
+		}
+		return fmt.Sprintf("skipped: dependency %q %s", name, status), true
+	}
+	return "", false
+}
@@ -0,0 +1,138 @@
+package runner
+
+import (
+	"fmt"
+	"testing"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// newPlanSuite cria uma suíte com o nome e as dependências informados
+func newPlanSuite(name string, dependsOn ...uuid.UUID) *entities.TestSuite {
+	return &entities.TestSuite{ID: uuid.New(), Name: name, DependsOn: dependsOn}
+}
+
+func suiteNames(testSuites []*entities.TestSuite) []string {
+	names := make([]string, 0, len(testSuites))
+	for _, testSuite := range testSuites {
+		names = append(names, testSuite.Name)
+	}
+	return names
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	login := newPlanSuite("login")
+	profile := newPlanSuite("profile", login.ID)
+	orders := newPlanSuite("orders", profile.ID, login.ID)
+	health := newPlanSuite("health")
+	outside := newPlanSuite("outside")
+	external := newPlanSuite("external", outside.ID)
+
+	first := newPlanSuite("first")
+	second := newPlanSuite("second", first.ID)
+	first.DependsOn = []uuid.UUID{second.ID}
+	blocked := newPlanSuite("blocked", second.ID)
+
+	tests := []struct {
+		name        string
+		testSuites  []*entities.TestSuite
+		wantOrdered []string
+		wantCyclic  []string
+	}{
+		{
+			name:        "dependencies run first",
+			testSuites:  []*entities.TestSuite{orders, profile, health, login},
+			wantOrdered: []string{"health", "login", "profile", "orders"},
+		},
+		{
+			name:        "independent suites keep their order",
+			testSuites:  []*entities.TestSuite{health, login},
+			wantOrdered: []string{"health", "login"},
+		},
+		{
+			name:        "dependency outside the run does not block ordering",
+			testSuites:  []*entities.TestSuite{external, health},
+			wantOrdered: []string{"external", "health"},
+		},
+		{
+			name:        "cycle and its dependents are returned apart",
+			testSuites:  []*entities.TestSuite{blocked, first, health, second},
+			wantOrdered: []string{"health"},
+			wantCyclic:  []string{"blocked", "first", "second"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, cyclic := orderByDependencies(tt.testSuites)
+			if got := fmt.Sprint(suiteNames(ordered)); got != fmt.Sprint(tt.wantOrdered) {
+				t.Errorf("ordered = %s, want %v", got, tt.wantOrdered)
+			}
+			if got := fmt.Sprint(suiteNames(cyclic)); got != fmt.Sprint(tt.wantCyclic) {
+				t.Errorf("cyclic = %s, want %v", got, tt.wantCyclic)
+			}
+		})
+	}
+}
+
+func TestUnmetDependency(t *testing.T) {
+	login := newPlanSuite("login")
+	first := newPlanSuite("first")
+	missing := uuid.New()
+	names := map[uuid.UUID]string{login.ID: login.Name, first.ID: first.Name}
+
+	tests := []struct {
+		name       string
+		dependsOn  []uuid.UUID
+		outcomes   map[uuid.UUID]Status
+		wantSkip   bool
+		wantReason string
+	}{
+		{
+			name: "no dependencies",
+		},
+		{
+			name:      "dependency passed",
+			dependsOn: []uuid.UUID{login.ID},
+			outcomes:  map[uuid.UUID]Status{login.ID: StatusPassed},
+		},
+		{
+			name:       "dependency failed",
+			dependsOn:  []uuid.UUID{login.ID},
+			outcomes:   map[uuid.UUID]Status{login.ID: StatusFailed},
+			wantSkip:   true,
+			wantReason: `skipped: dependency "login" failed`,
+		},
+		{
+			name:       "dependency skipped",
+			dependsOn:  []uuid.UUID{login.ID},
+			outcomes:   map[uuid.UUID]Status{login.ID: StatusSkipped},
+			wantSkip:   true,
+			wantReason: `skipped: dependency "login" skipped`,
+		},
+		{
+			name:       "dependency not in run",
+			dependsOn:  []uuid.UUID{login.ID, missing},
+			outcomes:   map[uuid.UUID]Status{login.ID: StatusPassed},
+			wantSkip:   true,
+			wantReason: fmt.Sprintf("skipped: dependency %s not in run", missing),
+		},
+		{
+			name:      "dependency in run without result is left to cycle detection",
+			dependsOn: []uuid.UUID{first.ID},
+			outcomes:  map[uuid.UUID]Status{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := newPlanSuite("dependent", tt.dependsOn...)
+			reason, skip := unmetDependency(testSuite, tt.outcomes, names)
+			if skip != tt.wantSkip || reason != tt.wantReason {
+				t.Errorf("unmetDependency() = (%q, %v), want (%q, %v)", reason, skip, tt.wantReason, tt.wantSkip)
+			}
+		})
+	}
+}
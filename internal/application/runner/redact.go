@@ -0,0 +1,77 @@
+package runner
+
+import (
+	"sort"
+	"strings"
+)
+
+// redactedValue substitui os valores de segredos em tudo que é gravado ou registrado em log
+const redactedValue = "[REDACTED]"
+
+// redactor remove valores de segredos de textos produzidos pela execução
+type redactor struct {
+	replacer *strings.Replacer
+}
+
+// newRedactor cria um redactor para os valores informados; valores vazios são ignorados
+func newRedactor(values []string) *redactor {
+	// Valores mais longos primeiro, para que um segredo contido em outro não deixe sobras
+	sorted := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			sorted = append(sorted, value)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	pairs := make([]string, 0, len(sorted)*2)
+	for _, value := range sorted {
+		pairs = append(pairs, value, redactedValue)
+	}
+	return &redactor{replacer: strings.NewReplacer(pairs...)}
+}
+
+// String retorna o texto sem os valores de segredos
+func (r *redactor) String(text string) string {
+	return r.replacer.Replace(text)
+}
+
+// Result remove os valores de segredos de todos os campos textuais do resultado
+func (r *redactor) Result(result *Result) {
+	result.ResponseBody = r.String(result.ResponseBody)
+	result.Error = r.String(result.Error)
+	for i, failure := range result.Failures {
+		result.Failures[i] = r.String(failure)
+	}
+	for key, values := range result.ResponseHeaders {
+		for i, value := range values {
+			result.ResponseHeaders[key][i] = r.String(value)
+		}
+	}
+	for i := range result.AssertionResults {
+		assertion := &result.AssertionResults[i]
+		assertion.Target = r.String(assertion.Target)
+		assertion.Expected = r.String(assertion.Expected)
+		assertion.Actual = r.String(assertion.Actual)
+		assertion.Message = r.String(assertion.Message)
+	}
+	for i := range result.SchemaViolations {
+		violation := &result.SchemaViolations[i]
+		violation.InstancePath = r.String(violation.InstancePath)
+		violation.Message = r.String(violation.Message)
+	}
+	if request := result.Request; request != nil {
+		request.URL = r.String(request.URL)
+		request.Body = r.String(request.Body)
+		r.values(request.Headers)
+		r.values(request.QueryParams)
+		r.values(request.FormFields)
+	}
+}
+
+// values remove os valores de segredos de um mapa de valores textuais
+func (r *redactor) values(values map[string]string) {
+	for key, value := range values {
+		values[key] = r.String(value)
+	}
+}
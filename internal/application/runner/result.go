@@ -0,0 +1,59 @@
+package runner
+
+import (
+	"maps"
+	"net/http"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/entities"
+)
+
+// Status representa o resultado da execução de uma suíte de teste
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusErrored Status = "errored"
+	StatusSkipped Status = "skipped"
+)
+
+// Result representa o resultado estruturado da execução de uma suíte de teste
+type Result struct {
+	Status           Status                     `json:"status"`
+	ResponseStatus   int                        `json:"response_status"`
+	ResponseHeaders  http.Header                `json:"response_headers,omitempty"`
+	ResponseBody     string                     `json:"response_body"`
+	BodyTruncated    bool                       `json:"body_truncated,omitempty"`
+	Latency          time.Duration              `json:"latency"`
+	Failures         []string                   `json:"failures,omitempty"`
+	AssertionResults []entities.AssertionResult `json:"assertion_results,omitempty"`
+	SchemaViolations []entities.SchemaViolation `json:"schema_violations,omitempty"`
+	Error            string                     `json:"error,omitempty"`
+	// Requisição enviada, com as variáveis substituídas; vazia quando a suíte não chegou a ser enviada
+	Request *entities.ExecutedRequest `json:"request,omitempty"`
+}
+
+// LatencyMS retorna a latência da requisição em milissegundos
+func (r *Result) LatencyMS() int {
+	return int(r.Latency.Milliseconds())
+}
+
+// Passed indica se a suíte passou em todas as verificações
+func (r *Result) Passed() bool {
+	return r.Status == StatusPassed
+}
+
+// executedRequest registra a requisição da suíte já renderizada; os mapas são copiados para que
+// a remoção de segredos não altere a suíte
+func executedRequest(suite *entities.TestSuite) *entities.ExecutedRequest {
+	return &entities.ExecutedRequest{
+		Method:      strings.ToUpper(suite.Method),
+		URL:         suite.URL,
+		Headers:     maps.Clone(suite.Headers),
+		QueryParams: maps.Clone(suite.QueryParams),
+		Body:        suite.Body,
+		FormFields:  maps.Clone(suite.FormFields),
+	}
+}
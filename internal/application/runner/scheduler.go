@@ -0,0 +1,93 @@
+package runner
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"TestGO/internal/domain/repositories"
+)
+
+// scheduleBatchSize limita os agendamentos disparados em cada transação
+const scheduleBatchSize = 50
+
+// Scheduler verifica periodicamente os agendamentos vencidos e enfileira as suas execuções.
+// A exclusão entre instâncias da API é garantida pelo bloqueio das linhas no repositório.
+type Scheduler struct {
+	scheduleRepo repositories.ScheduleRepository
+	workerPool   *WorkerPool
+	interval     time.Duration
+
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+// NewScheduler cria uma nova instância do agendador
+func NewScheduler(scheduleRepo repositories.ScheduleRepository, workerPool *WorkerPool, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+	return &Scheduler{
+		scheduleRepo: scheduleRepo,
+		workerPool:   workerPool,
+		interval:     interval,
+	}
+}
+
+// Start inicia a verificação dos agendamentos em background
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.stop = context.WithCancel(ctx)
+
+	s.wg.Add(1)
+	go s.run(ctx)
+	log.Printf("⏰ Scheduler started, checking every %s", s.interval)
+}
+
+// Stop interrompe o agendador e aguarda o disparo em andamento terminar
+func (s *Scheduler) Stop() {
+	if s.stop != nil {
+		s.stop()
+	}
+	s.wg.Wait()
+}
+
+// run dispara os agendamentos vencidos a cada intervalo até o agendador ser encerrado
+func (s *Scheduler) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.enqueueDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// enqueueDue enfileira as execuções vencidas, em lotes, e acorda os workers
+func (s *Scheduler) enqueueDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		testRuns, err := s.scheduleRepo.EnqueueDue(ctx, time.Now().UTC(), scheduleBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("❌ [ERROR] Failed to enqueue scheduled test runs: %v", err)
+			}
+			return
+		}
+
+		for _, testRun := range testRuns {
+			log.Printf("⏰ Schedule %s enqueued test run %s", *testRun.ScheduleID, testRun.ID)
+			s.workerPool.Notify()
+		}
+
+		if len(testRuns) < scheduleBatchSize {
+			return
+		}
+	}
+}
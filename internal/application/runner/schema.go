@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// schemaResource é o identificador interno do schema compilado
+const schemaResource = "mem:///response-schema.json"
+
+// CompileSchema compila um JSON Schema (draft 2020-12 por padrão).
+// Referências externas ($ref para arquivos ou URLs) não são carregadas.
+func CompileSchema(raw []byte) (*jsonschema.Schema, error) {
+	document, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w schema: not valid JSON: %w", domainErrors.ErrInvalid, err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := compiler.AddResource(schemaResource, document); err != nil {
+		return nil, fmt.Errorf("%w schema: %w", domainErrors.ErrInvalid, err)
+	}
+
+	schema, err := compiler.Compile(schemaResource)
+	if err != nil {
+		return nil, fmt.Errorf("%w schema: %w", domainErrors.ErrInvalid, err)
+	}
+	return schema, nil
+}
+
+// validateSchema valida o corpo contra o schema e retorna cada violação encontrada
+func validateSchema(raw []byte, body []byte) []entities.SchemaViolation {
+	schema, err := CompileSchema(raw)
+	if err != nil {
+		return []entities.SchemaViolation{{Message: err.Error()}}
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return []entities.SchemaViolation{{Message: "response body is not valid JSON"}}
+	}
+
+	err = schema.Validate(instance)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []entities.SchemaViolation{{Message: err.Error()}}
+	}
+
+	var violations []entities.SchemaViolation
+	collectViolations(validationErr, &violations)
+	if len(violations) == 0 {
+		violations = append(violations, entities.SchemaViolation{Message: validationErr.Error()})
+	}
+	return violations
+}
+
+// schemaPrinter formata as mensagens de violação em inglês, como os demais erros da API
+var schemaPrinter = message.NewPrinter(language.English)
+
+// collectViolations percorre a árvore de erros e registra apenas as causas finais,
+// descartando os nós que só agrupam outras causas (allOf, $ref, properties...)
+func collectViolations(validationErr *jsonschema.ValidationError, violations *[]entities.SchemaViolation) {
+	if len(validationErr.Causes) > 0 {
+		for _, cause := range validationErr.Causes {
+			collectViolations(cause, violations)
+		}
+		return
+	}
+
+	schemaPath := strings.TrimPrefix(validationErr.SchemaURL, schemaResource)
+	schemaPath = strings.TrimPrefix(schemaPath, "#")
+	*violations = append(*violations, entities.SchemaViolation{
+		InstancePath: jsonPointer(validationErr.InstanceLocation),
+		KeywordPath:  schemaPath + jsonPointer(validationErr.ErrorKind.KeywordPath()),
+		Message:      validationErr.ErrorKind.LocalizedString(schemaPrinter),
+	})
+}
+
+// jsonPointer monta um JSON Pointer (RFC 6901) a partir dos segmentos
+func jsonPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return sb.String()
+}
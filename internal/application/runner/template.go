@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/value_objects"
+)
+
+// renderSuite retorna uma cópia da suíte com as referências {{variável}} substituídas
+// na URL, headers, query params, corpo, campos de formulário e asserções.
+// A suíte original não é alterada.
+func renderSuite(testSuite *entities.TestSuite, variables map[string]string) (*entities.TestSuite, error) {
+	template := value_objects.NewTemplate(variables)
+
+	rendered := *testSuite
+	rendered.URL = template.Render(testSuite.URL)
+	rendered.Headers = template.RenderMap(testSuite.Headers)
+	rendered.QueryParams = template.RenderMap(testSuite.QueryParams)
+	rendered.Body = template.Render(testSuite.Body)
+	rendered.FormFields = template.RenderMap(testSuite.FormFields)
+	rendered.ExpectedBody = template.Render(testSuite.ExpectedBody)
+
+	rendered.Assertions = make([]*entities.Assertion, len(testSuite.Assertions))
+	for i, assertion := range testSuite.Assertions {
+		copied := *assertion
+		copied.Target = template.Render(assertion.Target)
+		copied.Expected = template.Render(assertion.Expected)
+		rendered.Assertions[i] = &copied
+	}
+
+	if err := template.Err(); err != nil {
+		return nil, err
+	}
+	return &rendered, nil
+}
@@ -0,0 +1,551 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"TestGO/internal/application/notifications"
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+
+	"github.com/google/uuid"
+)
+
+// PoolConfig agrupa as configurações do pool de workers
+type PoolConfig struct {
+	Workers            int
+	CompanyConcurrency int
+	PollInterval       time.Duration
+	StaleAfter         time.Duration
+	// HeartbeatInterval é o intervalo de renovação do heartbeat das execuções desta instância;
+	// deve ser bem menor que StaleAfter, depois do qual outra instância devolve a execução à fila
+	HeartbeatInterval time.Duration
+}
+
+// WorkerPool consome execuções da fila persistida em test_runs e as executa em background
+type WorkerPool struct {
+	testRunRepo     repositories.TestRunRepository
+	testResultRepo  repositories.TestResultRepository
+	testSuiteRepo   repositories.TestSuiteRepository
+	jsonSchemaRepo  repositories.JSONSchemaRepository
+	environmentRepo repositories.EnvironmentRepository
+	secretRepo      repositories.SecretRepository
+	secretCipher    *security.SecretCipher
+	executor        *Executor
+	webhooks        *notifications.WebhookDispatcher
+	notifiers       *notifications.Pipeline
+	config          PoolConfig
+
+	wake   chan struct{}
+	stop   context.CancelFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	active map[uuid.UUID]context.CancelFunc
+}
+
+// NewWorkerPool cria uma nova instância do pool de workers
+func NewWorkerPool(
+	testRunRepo repositories.TestRunRepository,
+	testResultRepo repositories.TestResultRepository,
+	testSuiteRepo repositories.TestSuiteRepository,
+	jsonSchemaRepo repositories.JSONSchemaRepository,
+	environmentRepo repositories.EnvironmentRepository,
+	secretRepo repositories.SecretRepository,
+	secretCipher *security.SecretCipher,
+	executor *Executor,
+	webhooks *notifications.WebhookDispatcher,
+	notifiers *notifications.Pipeline,
+	config PoolConfig,
+) *WorkerPool {
+	if config.Workers < 1 {
+		config.Workers = 1
+	}
+	if config.CompanyConcurrency < 1 {
+		config.CompanyConcurrency = 1
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 2 * time.Second
+	}
+	if config.StaleAfter <= 0 {
+		config.StaleAfter = 5 * time.Minute
+	}
+	if config.HeartbeatInterval <= 0 || config.HeartbeatInterval >= config.StaleAfter {
+		config.HeartbeatInterval = config.StaleAfter / 3
+	}
+
+	return &WorkerPool{
+		testRunRepo:     testRunRepo,
+		testResultRepo:  testResultRepo,
+		testSuiteRepo:   testSuiteRepo,
+		jsonSchemaRepo:  jsonSchemaRepo,
+		environmentRepo: environmentRepo,
+		secretRepo:      secretRepo,
+		secretCipher:    secretCipher,
+		executor:        executor,
+		webhooks:        webhooks,
+		notifiers:       notifiers,
+		config:          config,
+		wake:            make(chan struct{}, config.Workers),
+		active:          make(map[uuid.UUID]context.CancelFunc),
+	}
+}
+
+// Start recoloca na fila execuções abandonadas, inicia os workers e a renovação do heartbeat
+func (p *WorkerPool) Start(ctx context.Context) {
+	ctx, p.stop = context.WithCancel(ctx)
+
+	p.requeueStale(ctx)
+
+	for i := 0; i < p.config.Workers; i++ {
+		p.wg.Add(1)
+		go p.work(ctx)
+	}
+	p.wg.Add(1)
+	go p.heartbeat(ctx)
+	log.Printf("⚙️  Test run worker pool started with %d workers", p.config.Workers)
+}
+
+// Stop interrompe os workers e aguarda as execuções em andamento serem devolvidas à fila
+func (p *WorkerPool) Stop() {
+	if p.stop != nil {
+		p.stop()
+	}
+	p.wg.Wait()
+}
+
+// Notify acorda um worker ocioso para processar uma execução recém-enfileirada
+func (p *WorkerPool) Notify() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Cancel interrompe uma execução em andamento nesta instância, incluindo as requisições HTTP em curso
+func (p *WorkerPool) Cancel(testRunID uuid.UUID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cancel, ok := p.active[testRunID]
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// heartbeat renova periodicamente o heartbeat das execuções desta instância e devolve à fila
+// as execuções cujo heartbeat expirou, abandonadas por instâncias que pararam sem devolvê-las
+func (p *WorkerPool) heartbeat(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			ids := make([]uuid.UUID, 0, len(p.active))
+			for id := range p.active {
+				ids = append(ids, id)
+			}
+			p.mu.Unlock()
+
+			if err := p.testRunRepo.Heartbeat(ctx, ids); err != nil && ctx.Err() == nil {
+				log.Printf("❌ [ERROR] Failed to record heartbeat of %d test runs: %v", len(ids), err)
+			}
+			p.requeueStale(ctx)
+		}
+	}
+}
+
+// requeueStale devolve à fila as execuções em andamento com o heartbeat expirado
+func (p *WorkerPool) requeueStale(ctx context.Context) {
+	requeued, err := p.testRunRepo.RequeueStale(ctx, time.Now().Add(-p.config.StaleAfter))
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("❌ [ERROR] Failed to requeue stale test runs: %v", err)
+		}
+	} else if requeued > 0 {
+		log.Printf("🔄 Requeued %d stale test runs", requeued)
+	}
+}
+
+// work retira execuções da fila até o pool ser encerrado
+func (p *WorkerPool) work(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Processar enquanto houver execuções disponíveis
+		for ctx.Err() == nil {
+			testRun, err := p.testRunRepo.ClaimNext(ctx, p.config.CompanyConcurrency)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("❌ [ERROR] Failed to claim test run: %v", err)
+				}
+				break
+			}
+			if testRun == nil {
+				break
+			}
+			p.process(ctx, testRun)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// process executa as suítes de uma execução e registra os resultados
+func (p *WorkerPool) process(ctx context.Context, testRun *entities.TestRun) {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	p.mu.Lock()
+	p.active[testRun.ID] = cancel
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.active, testRun.ID)
+		p.mu.Unlock()
+	}()
+
+	// Cancelamentos feitos por outra instância são percebidos pelo estado no banco
+	go p.watchCancellation(runCtx, cancel, testRun.CompanyID, testRun.ID)
+
+	testSuites, err := p.loadTestSuites(runCtx, testRun)
+	if err != nil {
+		log.Printf("❌ [ERROR] Failed to load test suites for run %s: %v", testRun.ID, err)
+		p.finish(ctx, testRun, func() error { return testRun.MarkErrored() })
+		return
+	}
+
+	variables, secretValues, err := p.loadVariables(runCtx, testRun)
+	if err != nil {
+		log.Printf("❌ [ERROR] Failed to load variables for run %s: %v", testRun.ID, err)
+		p.finish(ctx, testRun, func() error { return testRun.MarkErrored() })
+		return
+	}
+	redact := newRedactor(secretValues)
+
+	// Dependências executam antes das suítes que as usam; ciclos não podem ser executados
+	ordered, cyclic := orderByDependencies(testSuites)
+	names := make(map[uuid.UUID]string, len(testSuites))
+	for _, testSuite := range testSuites {
+		names[testSuite.ID] = testSuite.Name
+	}
+
+	total, passed, failed, skipped := 0, 0, 0, 0
+	outcomes := make(map[uuid.UUID]Status, len(testSuites))
+	schemas := make(map[uuid.UUID]*entities.JSONSchema)
+	for _, testSuite := range append(ordered, cyclic...) {
+		if runCtx.Err() != nil {
+			break
+		}
+
+		var result *Result
+		if reason, skip := unmetDependency(testSuite, outcomes, names); skip {
+			result = &Result{Status: StatusSkipped, Error: reason}
+		} else if containsSuite(cyclic, testSuite.ID) {
+			result = &Result{Status: StatusErrored, Error: "dependency cycle detected"}
+		} else {
+			result = p.execute(runCtx, testRun.CompanyID, testSuite, variables, schemas)
+		}
+		if runCtx.Err() != nil {
+			// Resultado interrompido pelo cancelamento não é registrado
+			break
+		}
+		// Nenhum valor de segredo é gravado no resultado
+		redact.Result(result)
+		outcomes[testSuite.ID] = result.Status
+
+		total++
+		switch {
+		case result.Passed():
+			passed++
+		case result.Status == StatusSkipped:
+			skipped++
+		default:
+			failed++
+		}
+
+		testResult := entities.NewTestResult(
+			testRun.ID,
+			testSuite.ID,
+			entities.TestResultStatus(result.Status),
+			result.ResponseStatus,
+			result.ResponseBody,
+			result.LatencyMS(),
+			result.Error,
+		)
+		testResult.TestSuiteName = testSuite.Name
+		testResult.Request = result.Request
+		testResult.ResponseHeaders = result.ResponseHeaders
+		testResult.ResponseBodyTruncated = result.BodyTruncated
+		testResult.AssertionResults = result.AssertionResults
+		testResult.SchemaViolations = result.SchemaViolations
+		previous := p.previousResult(ctx, testRun.CompanyID, testSuite.ID)
+		if _, err := p.testResultRepo.Create(ctx, testResult); err != nil {
+			log.Printf("❌ [ERROR] Failed to save result for run %s: %s", testRun.ID, redact.String(err.Error()))
+			p.finish(ctx, testRun, func() error { return testRun.MarkErrored() })
+			return
+		}
+		if previous != nil {
+			p.notifyStatusChange(ctx, testRun, testSuite, previous, testResult)
+		}
+
+		testRun.RecordProgress(total, passed, failed, skipped)
+		if _, err := p.testRunRepo.UpdateIfStatus(ctx, testRun, entities.TestRunStatusRunning); err != nil {
+			log.Printf("❌ [ERROR] Failed to record progress for run %s: %v", testRun.ID, err)
+		}
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		// Encerramento da aplicação: devolver à fila para ser retomada
+		p.requeue(testRun)
+	case runCtx.Err() != nil:
+		// Cancelada pelo usuário ou devolvida à fila por outra instância: o estado já foi gravado
+		log.Printf("🛑 Test run %s stopped", testRun.ID)
+	default:
+		p.finish(ctx, testRun, func() error { return testRun.Finish(total, passed, failed, skipped) })
+	}
+}
+
+// finish aplica a transição final, sem sobrescrever um cancelamento concorrente
+func (p *WorkerPool) finish(ctx context.Context, testRun *entities.TestRun, transition func() error) {
+	if err := transition(); err != nil {
+		log.Printf("❌ [ERROR] Failed to finish test run %s: %v", testRun.ID, err)
+		return
+	}
+	updated, err := p.testRunRepo.UpdateIfStatus(ctx, testRun, entities.TestRunStatusRunning)
+	if err != nil {
+		log.Printf("❌ [ERROR] Failed to update test run %s: %v", testRun.ID, err)
+		return
+	}
+	if updated {
+		p.notifiers.Dispatch(ctx, testRun)
+	}
+}
+
+// previousResult retorna o último resultado da suíte em execuções anteriores, se houver
+func (p *WorkerPool) previousResult(ctx context.Context, companyID, testSuiteID uuid.UUID) *entities.TestResult {
+	previous, err := p.testResultRepo.GetLatestByTestSuiteID(ctx, companyID, testSuiteID)
+	if err != nil {
+		log.Printf("⚠️  [WARN] Failed to get previous result of test suite %s: %v", testSuiteID, err)
+		return nil
+	}
+	return previous
+}
+
+// notifyStatusChange publica a mudança de resultado da suíte entre aprovada e reprovada
+func (p *WorkerPool) notifyStatusChange(ctx context.Context, testRun *entities.TestRun, testSuite *entities.TestSuite, previous, current *entities.TestResult) {
+	event, changed := notifications.StatusChangeEvent(previous.Status, current.Status)
+	if !changed {
+		return
+	}
+	p.publish(ctx, testRun.CompanyID, event, notifications.TestSuiteStatusChangedData{
+		TestRunID:      testRun.ID,
+		TestSuiteID:    testSuite.ID,
+		TestSuiteName:  testSuite.Name,
+		Status:         current.Status,
+		PreviousStatus: previous.Status,
+		ErrorMessage:   current.ErrorMessage,
+	})
+}
+
+// publish registra o evento para os webhooks da empresa; falhas não interrompem a execução
+func (p *WorkerPool) publish(ctx context.Context, companyID uuid.UUID, event entities.WebhookEvent, data interface{}) {
+	if err := p.webhooks.Publish(ctx, companyID, event, data); err != nil {
+		log.Printf("❌ [ERROR] Failed to publish %s event: %v", event, err)
+	}
+}
+
+// requeue devolve a execução à fila descartando os resultados parciais
+func (p *WorkerPool) requeue(testRun *entities.TestRun) {
+	// O contexto do pool já foi encerrado; usar um contexto próprio e curto
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := p.testResultRepo.DeleteByTestRunID(ctx, testRun.CompanyID, testRun.ID); err != nil {
+		log.Printf("❌ [ERROR] Failed to discard partial results for run %s: %v", testRun.ID, err)
+		return
+	}
+	if err := testRun.Requeue(); err != nil {
+		log.Printf("❌ [ERROR] Failed to requeue test run %s: %v", testRun.ID, err)
+		return
+	}
+	if _, err := p.testRunRepo.UpdateIfStatus(ctx, testRun, entities.TestRunStatusRunning); err != nil {
+		log.Printf("❌ [ERROR] Failed to requeue test run %s: %v", testRun.ID, err)
+	}
+}
+
+// watchCancellation consulta periodicamente o estado da execução e cancela o contexto se ela foi
+// cancelada ou devolvida à fila por outra instância, após o heartbeat expirar
+func (p *WorkerPool) watchCancellation(ctx context.Context, cancel context.CancelFunc, companyID, testRunID uuid.UUID) {
+	ticker := time.NewTicker(p.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current, err := p.testRunRepo.GetByID(ctx, companyID, testRunID)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("⚠️  [WARN] Failed to check status of run %s: %v", testRunID, err)
+				}
+				continue
+			}
+			if current.Status == entities.TestRunStatusCancelled {
+				cancel()
+				return
+			}
+			if current.Status == entities.TestRunStatusQueued {
+				log.Printf("⚠️  [WARN] Test run %s was requeued by another instance", testRunID)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// loadTestSuites carrega as suítes selecionadas ou todas as da empresa.
+// As dependências das suítes selecionadas são incluídas na execução mesmo sem terem sido escolhidas.
+func (p *WorkerPool) loadTestSuites(ctx context.Context, testRun *entities.TestRun) ([]*entities.TestSuite, error) {
+	if len(testRun.TestSuiteIDs) == 0 {
+		return p.testSuiteRepo.GetByCompanyID(ctx, testRun.CompanyID)
+	}
+
+	testSuites := make([]*entities.TestSuite, 0, len(testRun.TestSuiteIDs))
+	loaded := make(map[uuid.UUID]bool, len(testRun.TestSuiteIDs))
+	pending := append([]uuid.UUID(nil), testRun.TestSuiteIDs...)
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+		if loaded[id] {
+			continue
+		}
+		loaded[id] = true
+
+		testSuite, err := p.testSuiteRepo.GetByID(ctx, testRun.CompanyID, id)
+		if err != nil {
+			// Suíte removida depois do enfileiramento
+			log.Printf("⚠️  [WARN] Skipping test suite %s of run %s: %v", id, testRun.ID, err)
+			continue
+		}
+		testSuites = append(testSuites, testSuite)
+		pending = append(pending, testSuite.DependsOn...)
+	}
+	return testSuites, nil
+}
+
+// containsSuite indica se a suíte está na lista
+func containsSuite(testSuites []*entities.TestSuite, id uuid.UUID) bool {
+	for _, testSuite := range testSuites {
+		if testSuite.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// execute prepara a suíte (schema armazenado e variáveis do ambiente) e a executa.
+// Falhas na preparação resultam em um resultado com erro, sem requisição HTTP.
+func (p *WorkerPool) execute(ctx context.Context, companyID uuid.UUID, testSuite *entities.TestSuite, variables map[string]string, schemas map[uuid.UUID]*entities.JSONSchema) *Result {
+	if err := p.resolveSchema(ctx, companyID, testSuite, schemas); err != nil {
+		return &Result{Status: StatusErrored, Error: err.Error()}
+	}
+
+	rendered, err := renderSuite(testSuite, variables)
+	if err != nil {
+		return &Result{Status: StatusErrored, Error: err.Error()}
+	}
+
+	result := p.executor.Execute(ctx, rendered)
+	result.Request = executedRequest(rendered)
+	if result.Status == StatusErrored || len(testSuite.Extractions) == 0 {
+		return result
+	}
+
+	// Valores extraídos ficam disponíveis como extracted.NOME para as suítes seguintes
+	values, failures := extractValues(testSuite.Extractions, result)
+	for name, value := range values {
+		variables[name] = value
+	}
+	if len(failures) > 0 {
+		result.Status = StatusFailed
+		result.Failures = append(result.Failures, failures...)
+		result.Error = strings.Join(result.Failures, "; ")
+	}
+	return result
+}
+
+// loadVariables retorna as variáveis do ambiente escolhido para a execução, se houver,
+// e os segredos decifrados da empresa como secret.NOME, junto com os valores a ocultar
+func (p *WorkerPool) loadVariables(ctx context.Context, testRun *entities.TestRun) (map[string]string, []string, error) {
+	variables := make(map[string]string)
+
+	if testRun.EnvironmentID != nil {
+		environment, err := p.environmentRepo.GetByID(ctx, testRun.CompanyID, *testRun.EnvironmentID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load environment: %w", err)
+		}
+		for name, value := range environment.Variables {
+			variables[name] = value
+		}
+	}
+
+	secrets, err := p.secretRepo.GetByCompanyID(ctx, testRun.CompanyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load secrets: %w", err)
+	}
+
+	secretValues := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		value, err := p.secretCipher.Decrypt(secret.EncryptedValue, secret.ID[:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decrypt secret %s: %w", secret.Name, err)
+		}
+		variables[secret.VariableName()] = string(value)
+		secretValues = append(secretValues, string(value))
+	}
+
+	return variables, secretValues, nil
+}
+
+// resolveSchema carrega o schema armazenado, da empresa da execução, referenciado pela suíte para que o executor o aplique.
+// Os schemas já carregados na execução são reaproveitados.
+func (p *WorkerPool) resolveSchema(ctx context.Context, companyID uuid.UUID, testSuite *entities.TestSuite, schemas map[uuid.UUID]*entities.JSONSchema) error {
+	if testSuite.ResponseSchemaID == nil {
+		return nil
+	}
+
+	schema, ok := schemas[*testSuite.ResponseSchemaID]
+	if !ok {
+		var err error
+		schema, err = p.jsonSchemaRepo.GetByID(ctx, companyID, *testSuite.ResponseSchemaID)
+		if err != nil {
+			return fmt.Errorf("failed to load response schema: %w", err)
+		}
+		schemas[schema.ID] = schema
+	}
+
+	testSuite.ResponseSchema = schema.Schema
+	return nil
+}
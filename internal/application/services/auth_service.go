@@ -2,10 +2,13 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"TestGO/internal/application/auth"
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 	"TestGO/internal/infrastructure/security"
 	"TestGO/internal/interfaces/services"
@@ -14,21 +17,30 @@ import (
 )
 
 type authService struct {
-	userRepo        repositories.UserRepository
-	passwordService *security.PasswordService
-	jwtService      *security.JWTService
+	userRepo         repositories.UserRepository
+	refreshTokenRepo repositories.RefreshTokenRepository
+	sessionRepo      repositories.SessionRepository
+	revocations      *auth.RevocationStore
+	passwordService  *security.PasswordService
+	jwtService       *security.JWTService
 }
 
 // NewAuthService cria uma nova instância do serviço de autenticação
 func NewAuthService(
 	userRepo repositories.UserRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	sessionRepo repositories.SessionRepository,
+	revocations *auth.RevocationStore,
 	passwordService *security.PasswordService,
 	jwtService *security.JWTService,
 ) services.AuthService {
 	return &authService{
-		userRepo:        userRepo,
-		passwordService: passwordService,
-		jwtService:      jwtService,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+		revocations:      revocations,
+		passwordService:  passwordService,
+		jwtService:       jwtService,
 	}
 }
 
@@ -36,22 +48,84 @@ func (s *authService) Login(ctx context.Context, req *services.LoginRequest) (*s
 	// Buscar usuário por username
 	user, err := s.userRepo.GetByUsername(ctx, req.Username)
 	if err != nil {
-		return nil, fmt.Errorf("invalid credentials")
+		return nil, fmt.Errorf("%w: invalid credentials", domainErrors.ErrUnauthenticated)
 	}
 
 	// Verificar senha
 	if !s.passwordService.CheckPassword(user.Password, req.Password) {
-		return nil, fmt.Errorf("invalid credentials")
+		return nil, fmt.Errorf("%w: invalid credentials", domainErrors.ErrUnauthenticated)
 	}
 
-	// Gerar token
-	token, err := s.jwtService.GenerateAccessToken(user.ID, user.Username, user.Email, user.CompanyID)
+	// Cada login inicia uma nova sessão, com a sua família de refresh tokens
+	return s.issueTokens(ctx, user, uuid.New(), req.UserAgent, req.IPAddress)
+}
+
+// RefreshToken troca um refresh token válido por um novo par de tokens da mesma família.
+// O token usado deixa de valer; se ele for apresentado de novo, a sessão inteira é encerrada.
+func (s *authService) RefreshToken(ctx context.Context, req *services.RefreshTokenRequest) (*services.LoginResponse, error) {
+	userID, err := s.jwtService.ValidateRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid refresh token: %w", domainErrors.ErrUnauthenticated, err)
+	}
+
+	stored, err := s.refreshTokenRepo.GetByTokenHash(ctx, security.HashToken(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: invalid refresh token: %w", domainErrors.ErrUnauthenticated, err)
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	if stored.UserID != userID || stored.IsRevoked() || stored.IsExpired() {
+		return nil, fmt.Errorf("%w: invalid refresh token", domainErrors.ErrUnauthenticated)
+	}
+
+	// A marcação é atômica: entre requisições concorrentes com o mesmo token, apenas uma renova
+	marked, err := s.refreshTokenRepo.MarkUsed(ctx, stored.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if !marked {
+		// O token pode ter sido copiado: os access tokens já emitidos na sessão também são revogados
+		if err := s.endSession(ctx, userID, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: invalid refresh token: reuse detected", domainErrors.ErrUnauthenticated)
+	}
+
+	// As claims do novo access token vêm do cadastro atual do usuário
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if revokeErr := s.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); revokeErr != nil {
+			return nil, fmt.Errorf("failed to revoke refresh token family: %w", revokeErr)
+		}
+		return nil, fmt.Errorf("%w: invalid refresh token: user not found", domainErrors.ErrUnauthenticated)
+	}
+
+	return s.issueTokens(ctx, user, stored.FamilyID, req.UserAgent, req.IPAddress)
+}
+
+// issueTokens gera um par de tokens para o usuário, grava o hash do refresh token na família
+// e registra o uso da sessão, criando-a no login
+func (s *authService) issueTokens(ctx context.Context, user *entities.User, familyID uuid.UUID, userAgent, ipAddress string) (*services.LoginResponse, error) {
+	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Username, user.Email, user.CompanyID, familyID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
+	expiresAt := time.Now().Add(s.jwtService.RefreshExpiry())
+	refreshToken := entities.NewRefreshToken(user.ID, familyID, security.HashToken(tokens.RefreshToken), expiresAt)
+	if _, err := s.refreshTokenRepo.Create(ctx, refreshToken); err != nil {
+		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	session := entities.NewSession(familyID, user.ID, userAgent, ipAddress, expiresAt)
+	if err := s.sessionRepo.Save(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save session: %w", err)
+	}
+
 	return &services.LoginResponse{
-		Token: token,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		User: services.UserResponse{
 			ID:        user.ID,
 			Username:  user.Username,
@@ -61,7 +135,8 @@ func (s *authService) Login(ctx context.Context, req *services.LoginRequest) (*s
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		},
-		ExpiresAt: time.Now().Add(24 * time.Hour).Unix(),
+		ExpiresIn: tokens.ExpiresIn,
+		ExpiresAt: time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second).Unix(),
 	}, nil
 }
 
@@ -72,7 +147,7 @@ func (s *authService) Register(ctx context.Context, req *services.RegisterReques
 		return nil, fmt.Errorf("failed to check username existence: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("username already exists")
+		return nil, fmt.Errorf("username %w", domainErrors.ErrAlreadyExists)
 	}
 
 	// Verificar se email já existe
@@ -81,7 +156,7 @@ func (s *authService) Register(ctx context.Context, req *services.RegisterReques
 		return nil, fmt.Errorf("failed to check email existence: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("email already exists")
+		return nil, fmt.Errorf("email %w", domainErrors.ErrAlreadyExists)
 	}
 
 	// Hash da senha
@@ -111,19 +186,116 @@ func (s *authService) Register(ctx context.Context, req *services.RegisterReques
 	}, nil
 }
 
-func (s *authService) ValidateJWT(tokenString string) (*uuid.UUID, error) {
+func (s *authService) ValidateJWT(ctx context.Context, tokenString string) (*services.TokenIdentity, error) {
 	claims, err := s.jwtService.ValidateToken(tokenString)
 	if err != nil {
-		return nil, fmt.Errorf("invalid token: %w", err)
+		return nil, fmt.Errorf("%w: invalid token: %w", domainErrors.ErrUnauthenticated, err)
 	}
 
 	// Verificar se o token não expirou
 	if time.Now().Unix() > claims.ExpiresAt {
-		return nil, fmt.Errorf("token expired")
+		return nil, fmt.Errorf("%w: token expired", domainErrors.ErrUnauthenticated)
+	}
+
+	// Verificar se o token não foi revogado por logout
+	revoked, err := s.revocations.IsRevoked(ctx, claims.UserID, claims.TokenID, claims.SessionID, time.UnixMilli(claims.IssuedAtMillis))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("%w: token revoked", domainErrors.ErrUnauthenticated)
+	}
+
+	return &services.TokenIdentity{
+		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
+	}, nil
+}
+
+// Logout revoga o access token informado e encerra a sessão em que ele foi emitido,
+// revogando a família de refresh tokens e os demais access tokens da sessão
+func (s *authService) Logout(ctx context.Context, accessToken string) error {
+	claims, err := s.jwtService.ValidateToken(accessToken)
+	if err != nil {
+		return fmt.Errorf("%w: invalid token: %w", domainErrors.ErrUnauthenticated, err)
+	}
+
+	if claims.SessionID == uuid.Nil {
+		revocation := entities.NewTokenRevocation(claims.UserID, claims.TokenID, time.Unix(claims.ExpiresAt, 0))
+		if err := s.revocations.Revoke(ctx, revocation); err != nil {
+			return fmt.Errorf("failed to revoke access token: %w", err)
+		}
+		return nil
+	}
+	return s.endSession(ctx, claims.UserID, claims.SessionID)
+}
+
+// LogoutAll encerra todas as sessões do usuário: revoga os access tokens já emitidos e todos os refresh tokens
+func (s *authService) LogoutAll(ctx context.Context, userID uuid.UUID) error {
+	// Os access tokens emitidos até agora expiram, no máximo, após a validade do access token
+	revocation := entities.NewUserTokenRevocation(userID, time.Now().Add(s.jwtService.AccessExpiry()))
+	if err := s.revocations.Revoke(ctx, revocation); err != nil {
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+
+	if err := s.refreshTokenRepo.RevokeByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	if err := s.sessionRepo.RevokeByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// ListSessions lista as sessões ativas do usuário, da usada mais recentemente para a mais antiga
+func (s *authService) ListSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]*services.SessionResponse, error) {
+	sessions, err := s.sessionRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	responses := make([]*services.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = &services.SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == currentSessionID,
+		}
+	}
+	return responses, nil
+}
+
+// RevokeSession encerra uma sessão do usuário, revogando os seus refresh tokens e access tokens
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if err := s.sessionRepo.Revoke(ctx, userID, sessionID); err != nil {
+		return err
 	}
+	return s.revokeSessionTokens(ctx, userID, sessionID)
+}
 
-	return &claims.UserID, nil
+// endSession encerra a sessão, se ela ainda estiver registrada, e revoga os seus tokens
+func (s *authService) endSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if err := s.sessionRepo.Revoke(ctx, userID, sessionID); err != nil && !errors.Is(err, domainErrors.ErrNotFound) {
+		return err
+	}
+	return s.revokeSessionTokens(ctx, userID, sessionID)
 }
 
-// Removendo métodos que não fazem parte da interface Clean Architecture
-// RefreshToken e Logout serão implementados em casos de uso específicos se necessário
+// revokeSessionTokens revoga a família de refresh tokens e os access tokens emitidos na sessão
+func (s *authService) revokeSessionTokens(ctx context.Context, userID, sessionID uuid.UUID) error {
+	if err := s.refreshTokenRepo.RevokeFamily(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	// Os access tokens da sessão expiram, no máximo, após a validade do access token
+	revocation := entities.NewSessionTokenRevocation(userID, sessionID, time.Now().Add(s.jwtService.AccessExpiry()))
+	if err := s.revocations.Revoke(ctx, revocation); err != nil {
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+	return nil
+}
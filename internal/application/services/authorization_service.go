@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type authorizationService struct {
+	companyMemberRepo repositories.CompanyMemberRepository
+}
+
+// NewAuthorizationService cria uma nova instância do serviço de autorização por papel na empresa
+func NewAuthorizationService(companyMemberRepo repositories.CompanyMemberRepository) services.Authorizer {
+	return &authorizationService{
+		companyMemberRepo: companyMemberRepo,
+	}
+}
+
+func (s *authorizationService) Authorize(ctx context.Context, companyID uuid.UUID, permission entities.Permission) (*entities.CompanyMember, error) {
+	// Sem usuário no contexto não há a quem conceder a permissão
+	userID, ok := services.ActorFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domainErrors.ErrForbidden)
+	}
+
+	member, err := s.companyMemberRepo.Get(ctx, companyID, userID)
+	if err != nil {
+		// Para quem não é membro, a empresa e os seus recursos não existem
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			return nil, fmt.Errorf("company %w: user is not a member of it", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to check company membership: %w", err)
+	}
+
+	if !member.Can(permission) {
+		return nil, fmt.Errorf("%w: role %s cannot %s", domainErrors.ErrForbidden, member.Role, permission)
+	}
+
+	return member, nil
+}
+
+func (s *authorizationService) ResourceCompanyID(ctx context.Context, resource entities.ResourceType, id uuid.UUID) (uuid.UUID, error) {
+	userID, ok := services.ActorFromContext(ctx)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("%w: no authenticated user", domainErrors.ErrForbidden)
+	}
+
+	// A busca já considera a associação do usuário: recursos de outras empresas não são encontrados
+	return s.companyMemberRepo.GetResourceCompanyID(ctx, userID, resource, id)
+}
@@ -0,0 +1,578 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"TestGO/internal/application/runner"
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+// Métodos aceitos nas suítes do pacote, os mesmos da API de suítes de teste
+var bundleMethods = map[string]bool{"GET": true, "POST": true, "PUT": true, "DELETE": true, "PATCH": true}
+
+type bundleService struct {
+	transactor      repositories.Transactor
+	testSuiteRepo   repositories.TestSuiteRepository
+	environmentRepo repositories.EnvironmentRepository
+	jsonSchemaRepo  repositories.JSONSchemaRepository
+	authorizer      services.Authorizer
+}
+
+// NewBundleService cria uma nova instância do serviço de exportação e importação de pacotes
+func NewBundleService(
+	transactor repositories.Transactor,
+	testSuiteRepo repositories.TestSuiteRepository,
+	environmentRepo repositories.EnvironmentRepository,
+	jsonSchemaRepo repositories.JSONSchemaRepository,
+	authorizer services.Authorizer,
+) services.BundleService {
+	return &bundleService{
+		transactor:      transactor,
+		testSuiteRepo:   testSuiteRepo,
+		environmentRepo: environmentRepo,
+		jsonSchemaRepo:  jsonSchemaRepo,
+		authorizer:      authorizer,
+	}
+}
+
+// companyState reúne as suítes, os ambientes e os schemas armazenados da empresa,
+// ordenados pelo nome para que a exportação seja determinística
+type companyState struct {
+	suites       []*entities.TestSuite
+	environments []*entities.Environment
+	suiteNames   map[uuid.UUID]string
+	schemaNames  map[uuid.UUID]string
+	schemaIDs    map[string]uuid.UUID
+}
+
+func (s *bundleService) Export(ctx context.Context, companyID uuid.UUID) (*services.TestSuiteBundle, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSuitesRead); err != nil {
+		return nil, err
+	}
+
+	state, err := s.load(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &services.TestSuiteBundle{
+		Version:      services.BundleVersion,
+		TestSuites:   make([]services.BundleTestSuite, 0, len(state.suites)),
+		Environments: make([]services.BundleEnvironment, 0, len(state.environments)),
+	}
+	for _, testSuite := range state.suites {
+		bundle.TestSuites = append(bundle.TestSuites, toBundleTestSuite(testSuite, state.suiteNames, state.schemaNames))
+	}
+	for _, environment := range state.environments {
+		bundle.Environments = append(bundle.Environments, services.BundleEnvironment{
+			Name:      environment.Name,
+			Variables: environment.Variables,
+		})
+	}
+	return bundle, nil
+}
+
+func (s *bundleService) Import(ctx context.Context, req *services.ImportBundleRequest) (*services.BundleDiff, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+	if req.Bundle == nil || req.Bundle.Version != services.BundleVersion {
+		return nil, fmt.Errorf("%w bundle: unsupported version, expected %d", domainErrors.ErrInvalid, services.BundleVersion)
+	}
+
+	state, err := s.load(ctx, req.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Todo o pacote é validado antes de qualquer gravação
+	suitePlan, err := planTestSuites(req.CompanyID, req.Bundle.TestSuites, state)
+	if err != nil {
+		return nil, err
+	}
+	environmentPlan, err := planEnvironments(req.CompanyID, req.Bundle.Environments, state)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &services.BundleDiff{
+		DryRun:       req.DryRun,
+		TestSuites:   suitePlan.changes,
+		Environments: environmentPlan.changes,
+	}
+	if req.DryRun {
+		return diff, nil
+	}
+
+	// Todas as gravações acontecem em uma única transação: se qualquer uma falhar, nada é alterado
+	if err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.apply(ctx, req.CompanyID, suitePlan, environmentPlan)
+	}); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// apply grava as remoções, atualizações e criações planejadas para a empresa
+func (s *bundleService) apply(ctx context.Context, companyID uuid.UUID, suites *suitePlan, environments *environmentPlan) error {
+	// Remoções primeiro, para liberar as chaves de importação usadas por suítes renomeadas
+	for _, testSuite := range suites.deleted {
+		if err := s.testSuiteRepo.Delete(ctx, testSuite.CompanyID, testSuite.ID); err != nil {
+			return fmt.Errorf("failed to delete test suite %q: %w", testSuite.Name, err)
+		}
+	}
+	for _, testSuite := range suites.updated {
+		if err := s.testSuiteRepo.Update(ctx, testSuite); err != nil {
+			return fmt.Errorf("failed to update test suite %q: %w", testSuite.Name, err)
+		}
+	}
+	for _, testSuite := range suites.created {
+		if _, err := s.testSuiteRepo.Create(ctx, testSuite); err != nil {
+			return fmt.Errorf("failed to create test suite %q: %w", testSuite.Name, err)
+		}
+	}
+
+	for _, environment := range environments.deleted {
+		if err := s.environmentRepo.Delete(ctx, companyID, environment.ID); err != nil {
+			return fmt.Errorf("failed to delete environment %q: %w", environment.Name, err)
+		}
+	}
+	for _, environment := range environments.updated {
+		if err := s.environmentRepo.Update(ctx, environment); err != nil {
+			return fmt.Errorf("failed to update environment %q: %w", environment.Name, err)
+		}
+	}
+	for _, environment := range environments.created {
+		if _, err := s.environmentRepo.Create(ctx, environment); err != nil {
+			return fmt.Errorf("failed to create environment %q: %w", environment.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// load carrega as suítes, os ambientes e os schemas armazenados da empresa
+func (s *bundleService) load(ctx context.Context, companyID uuid.UUID) (*companyState, error) {
+	testSuites, err := s.testSuiteRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test suites: %w", err)
+	}
+	environments, err := s.environmentRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environments: %w", err)
+	}
+	schemas, err := s.jsonSchemaRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json schemas: %w", err)
+	}
+
+	sort.SliceStable(testSuites, func(i, j int) bool {
+		if testSuites[i].Name != testSuites[j].Name {
+			return testSuites[i].Name < testSuites[j].Name
+		}
+		return testSuites[i].CreatedAt.Before(testSuites[j].CreatedAt)
+	})
+	sort.SliceStable(environments, func(i, j int) bool {
+		return environments[i].Name < environments[j].Name
+	})
+
+	state := &companyState{
+		suites:       testSuites,
+		environments: environments,
+		suiteNames:   make(map[uuid.UUID]string, len(testSuites)),
+		schemaNames:  make(map[uuid.UUID]string, len(schemas)),
+		schemaIDs:    make(map[string]uuid.UUID, len(schemas)),
+	}
+	for _, testSuite := range testSuites {
+		state.suiteNames[testSuite.ID] = testSuite.Name
+	}
+	for _, schema := range schemas {
+		state.schemaNames[schema.ID] = schema.Name
+		if _, ok := state.schemaIDs[schema.Name]; !ok {
+			state.schemaIDs[schema.Name] = schema.ID
+		}
+	}
+	return state, nil
+}
+
+// toBundleTestSuite converte a suíte para o formato do pacote, referenciando dependências e
+// schemas armazenados pelo nome
+func toBundleTestSuite(testSuite *entities.TestSuite, suiteNames, schemaNames map[uuid.UUID]string) services.BundleTestSuite {
+	bundleSuite := services.BundleTestSuite{
+		Name:           testSuite.Name,
+		Method:         testSuite.Method,
+		URL:            testSuite.URL,
+		Headers:        nonEmptyMap(testSuite.Headers),
+		QueryParams:    nonEmptyMap(testSuite.QueryParams),
+		Body:           testSuite.Body,
+		FormFields:     nonEmptyMap(testSuite.FormFields),
+		ExpectedStatus: testSuite.ExpectedStatus,
+		ExpectedBody:   testSuite.ExpectedBody,
+	}
+	if testSuite.BodyType != entities.RequestBodyNone {
+		bundleSuite.BodyType = testSuite.BodyType
+	}
+	if len(testSuite.ResponseSchema) > 0 {
+		var schema interface{}
+		if err := json.Unmarshal(testSuite.ResponseSchema, &schema); err == nil {
+			bundleSuite.ResponseSchema = schema
+		}
+	}
+	if testSuite.ResponseSchemaID != nil {
+		bundleSuite.ResponseSchemaRef = schemaNames[*testSuite.ResponseSchemaID]
+	}
+	if testSuite.ImportKey != nil {
+		bundleSuite.ImportKey = *testSuite.ImportKey
+	}
+	for _, assertion := range testSuite.Assertions {
+		bundleSuite.Assertions = append(bundleSuite.Assertions, services.BundleAssertion{
+			Type:     assertion.Type,
+			Target:   assertion.Target,
+			Expected: assertion.Expected,
+		})
+	}
+	for _, extraction := range testSuite.Extractions {
+		bundleSuite.Extractions = append(bundleSuite.Extractions, services.BundleExtraction{
+			Name:       extraction.Name,
+			Source:     extraction.Source,
+			Expression: extraction.Expression,
+		})
+	}
+	for _, id := range testSuite.DependsOn {
+		if name, ok := suiteNames[id]; ok {
+			bundleSuite.DependsOn = append(bundleSuite.DependsOn, name)
+		}
+	}
+	sort.Strings(bundleSuite.DependsOn)
+	return bundleSuite
+}
+
+// suitePlan lista as suítes a criar, atualizar e remover e o resumo das alterações
+type suitePlan struct {
+	created []*entities.TestSuite
+	updated []*entities.TestSuite
+	deleted []*entities.TestSuite
+	changes services.BundleChanges
+}
+
+// planTestSuites valida as suítes do pacote e as compara com as da empresa pelo nome.
+// Suítes da empresa ausentes do pacote (ou com o nome repetido) são removidas.
+func planTestSuites(companyID uuid.UUID, bundleSuites []services.BundleTestSuite, state *companyState) (*suitePlan, error) {
+	existing := make(map[string]*entities.TestSuite, len(state.suites))
+	for _, testSuite := range state.suites {
+		if _, ok := existing[testSuite.Name]; !ok {
+			existing[testSuite.Name] = testSuite
+		}
+	}
+
+	desired := make([]*entities.TestSuite, 0, len(bundleSuites))
+	ids := make(map[string]uuid.UUID, len(bundleSuites))
+	importKeys := make(map[string]string)
+	for _, bundleSuite := range bundleSuites {
+		name := strings.TrimSpace(bundleSuite.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w bundle: test suite name is required", domainErrors.ErrInvalid)
+		}
+		if _, ok := ids[name]; ok {
+			return nil, fmt.Errorf("%w bundle: duplicate test suite name %q", domainErrors.ErrInvalid, name)
+		}
+		if key := bundleSuite.ImportKey; key != "" {
+			if other, ok := importKeys[key]; ok {
+				return nil, fmt.Errorf("%w bundle: test suites %q and %q have the same import key", domainErrors.ErrInvalid, other, name)
+			}
+			importKeys[key] = name
+		}
+
+		var testSuite *entities.TestSuite
+		if current, ok := existing[name]; ok {
+			copied := *current
+			testSuite = &copied
+		} else {
+			testSuite = entities.NewTestSuite(companyID, name, "", "", nil, 0, "")
+		}
+		bundleSuite.Name = name
+		if err := applyBundleTestSuite(testSuite, bundleSuite, state); err != nil {
+			return nil, fmt.Errorf("%w bundle: test suite %q: %w", domainErrors.ErrInvalid, name, err)
+		}
+		ids[name] = testSuite.ID
+		desired = append(desired, testSuite)
+	}
+
+	// Dependências são resolvidas depois que todas as suítes do pacote têm ID
+	graph := make(map[uuid.UUID][]uuid.UUID, len(desired))
+	desiredNames := make(map[uuid.UUID]string, len(desired))
+	for i, testSuite := range desired {
+		dependsOn := make([]uuid.UUID, 0, len(bundleSuites[i].DependsOn))
+		for _, dependency := range bundleSuites[i].DependsOn {
+			id, ok := ids[strings.TrimSpace(dependency)]
+			if !ok {
+				return nil, fmt.Errorf("%w bundle: test suite %q depends on unknown test suite %q", domainErrors.ErrInvalid, testSuite.Name, dependency)
+			}
+			dependsOn = append(dependsOn, id)
+		}
+		if err := testSuite.SetDependsOn(dependsOn); err != nil {
+			return nil, fmt.Errorf("%w bundle: test suite %q: %w", domainErrors.ErrInvalid, testSuite.Name, err)
+		}
+		graph[testSuite.ID] = testSuite.DependsOn
+		desiredNames[testSuite.ID] = testSuite.Name
+	}
+	for _, testSuite := range desired {
+		if reaches(graph, testSuite.DependsOn, testSuite.ID) {
+			return nil, fmt.Errorf("%w bundle: dependency cycle detected at test suite %q", domainErrors.ErrInvalid, testSuite.Name)
+		}
+	}
+
+	plan := &suitePlan{changes: newBundleChanges()}
+	kept := make(map[uuid.UUID]bool, len(desired))
+	for _, testSuite := range desired {
+		current, ok := existing[testSuite.Name]
+		if !ok {
+			plan.created = append(plan.created, testSuite)
+			plan.changes.Created = append(plan.changes.Created, testSuite.Name)
+			continue
+		}
+		kept[current.ID] = true
+
+		fields := changedSuiteFields(
+			toBundleTestSuite(current, state.suiteNames, state.schemaNames),
+			toBundleTestSuite(testSuite, desiredNames, state.schemaNames),
+		)
+		if len(fields) == 0 {
+			plan.changes.Unchanged++
+			continue
+		}
+		plan.updated = append(plan.updated, testSuite)
+		plan.changes.Updated = append(plan.changes.Updated, services.BundleChange{Name: testSuite.Name, Fields: fields})
+	}
+	for _, testSuite := range state.suites {
+		if !kept[testSuite.ID] {
+			plan.deleted = append(plan.deleted, testSuite)
+			plan.changes.Deleted = append(plan.changes.Deleted, testSuite.Name)
+		}
+	}
+	return plan, nil
+}
+
+// applyBundleTestSuite substitui a configuração da suíte pela do pacote, validando-a como a API de suítes
+func applyBundleTestSuite(testSuite *entities.TestSuite, bundleSuite services.BundleTestSuite, state *companyState) error {
+	method := strings.ToUpper(strings.TrimSpace(bundleSuite.Method))
+	if !bundleMethods[method] {
+		return fmt.Errorf("method %q is not supported", bundleSuite.Method)
+	}
+	if strings.TrimSpace(bundleSuite.URL) == "" {
+		return fmt.Errorf("url is required")
+	}
+	if bundleSuite.ExpectedStatus < 100 || bundleSuite.ExpectedStatus > 599 {
+		return fmt.Errorf("expected status %d is not a valid HTTP status", bundleSuite.ExpectedStatus)
+	}
+
+	headers := bundleSuite.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	testSuite.Name = bundleSuite.Name
+	testSuite.Method = method
+	testSuite.URL = bundleSuite.URL
+	testSuite.Headers = headers
+	testSuite.ExpectedStatus = bundleSuite.ExpectedStatus
+	testSuite.ExpectedBody = bundleSuite.ExpectedBody
+	testSuite.SetQueryParams(bundleSuite.QueryParams)
+	if err := testSuite.SetRequestBody(bundleSuite.BodyType, bundleSuite.Body, bundleSuite.FormFields); err != nil {
+		return fmt.Errorf("%w request body: %w", domainErrors.ErrInvalid, err)
+	}
+
+	switch {
+	case bundleSuite.ResponseSchema != nil && bundleSuite.ResponseSchemaRef != "":
+		return fmt.Errorf("provide either response_schema or response_schema_ref")
+	case bundleSuite.ResponseSchema != nil:
+		schema, err := json.Marshal(bundleSuite.ResponseSchema)
+		if err != nil {
+			return fmt.Errorf("%w response schema: %w", domainErrors.ErrInvalid, err)
+		}
+		if _, err := runner.CompileSchema(schema); err != nil {
+			return fmt.Errorf("%w response schema: %w", domainErrors.ErrInvalid, err)
+		}
+		testSuite.SetResponseSchema(schema)
+	case bundleSuite.ResponseSchemaRef != "":
+		id, ok := state.schemaIDs[bundleSuite.ResponseSchemaRef]
+		if !ok {
+			return fmt.Errorf("json schema %q not found", bundleSuite.ResponseSchemaRef)
+		}
+		testSuite.SetResponseSchemaID(&id)
+	default:
+		testSuite.SetResponseSchema(nil)
+	}
+
+	testSuite.ImportKey = nil
+	if bundleSuite.ImportKey != "" {
+		testSuite.SetImportKey(bundleSuite.ImportKey)
+	}
+
+	assertionReqs := make([]services.AssertionRequest, 0, len(bundleSuite.Assertions))
+	for _, assertion := range bundleSuite.Assertions {
+		assertionReqs = append(assertionReqs, services.AssertionRequest{Type: assertion.Type, Target: assertion.Target, Expected: assertion.Expected})
+	}
+	assertions, err := buildAssertions(assertionReqs)
+	if err != nil {
+		return err
+	}
+	testSuite.SetAssertions(assertions)
+
+	extractionReqs := make([]services.ExtractionRequest, 0, len(bundleSuite.Extractions))
+	for _, extraction := range bundleSuite.Extractions {
+		extractionReqs = append(extractionReqs, services.ExtractionRequest{Name: extraction.Name, Source: extraction.Source, Expression: extraction.Expression})
+	}
+	extractions, err := buildExtractions(extractionReqs)
+	if err != nil {
+		return err
+	}
+	testSuite.SetExtractions(extractions)
+	return nil
+}
+
+// changedSuiteFields retorna os campos do pacote que diferem entre a suíte atual e a desejada
+func changedSuiteFields(current, desired services.BundleTestSuite) []string {
+	fields := []struct {
+		name             string
+		current, desired interface{}
+	}{
+		{"method", current.Method, desired.Method},
+		{"url", current.URL, desired.URL},
+		{"headers", current.Headers, desired.Headers},
+		{"query_params", current.QueryParams, desired.QueryParams},
+		{"body_type", current.BodyType, desired.BodyType},
+		{"body", current.Body, desired.Body},
+		{"form_fields", current.FormFields, desired.FormFields},
+		{"expected_status", current.ExpectedStatus, desired.ExpectedStatus},
+		{"expected_body", current.ExpectedBody, desired.ExpectedBody},
+		{"response_schema", current.ResponseSchema, desired.ResponseSchema},
+		{"response_schema_ref", current.ResponseSchemaRef, desired.ResponseSchemaRef},
+		{"import_key", current.ImportKey, desired.ImportKey},
+		{"assertions", current.Assertions, desired.Assertions},
+		{"extractions", current.Extractions, desired.Extractions},
+		{"depends_on", current.DependsOn, desired.DependsOn},
+	}
+
+	var changed []string
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.current, field.desired) {
+			changed = append(changed, field.name)
+		}
+	}
+	return changed
+}
+
+// environmentPlan lista os ambientes a criar, atualizar e remover e o resumo das alterações
+type environmentPlan struct {
+	created []*entities.Environment
+	updated []*entities.Environment
+	deleted []*entities.Environment
+	changes services.BundleChanges
+}
+
+// planEnvironments valida os ambientes do pacote e os compara com os da empresa pelo nome.
+// As alterações de um ambiente são relatadas por variável (variables.NOME).
+func planEnvironments(companyID uuid.UUID, bundleEnvironments []services.BundleEnvironment, state *companyState) (*environmentPlan, error) {
+	existing := make(map[string]*entities.Environment, len(state.environments))
+	for _, environment := range state.environments {
+		if _, ok := existing[environment.Name]; !ok {
+			existing[environment.Name] = environment
+		}
+	}
+
+	plan := &environmentPlan{changes: newBundleChanges()}
+	kept := make(map[uuid.UUID]bool, len(bundleEnvironments))
+	seen := make(map[string]bool, len(bundleEnvironments))
+	for _, bundleEnvironment := range bundleEnvironments {
+		name := strings.TrimSpace(bundleEnvironment.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w bundle: environment name is required", domainErrors.ErrInvalid)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w bundle: duplicate environment name %q", domainErrors.ErrInvalid, name)
+		}
+		seen[name] = true
+
+		variables := bundleEnvironment.Variables
+		if variables == nil {
+			variables = map[string]string{}
+		}
+
+		current, ok := existing[name]
+		if !ok {
+			environment := entities.NewEnvironment(companyID, name, variables)
+			if err := environment.ValidateVariables(); err != nil {
+				return nil, fmt.Errorf("%w bundle: environment %q: %w", domainErrors.ErrInvalid, name, err)
+			}
+			plan.created = append(plan.created, environment)
+			plan.changes.Created = append(plan.changes.Created, name)
+			continue
+		}
+		kept[current.ID] = true
+
+		copied := *current
+		copied.UpdateEnvironment("", variables)
+		if err := copied.ValidateVariables(); err != nil {
+			return nil, fmt.Errorf("%w bundle: environment %q: %w", domainErrors.ErrInvalid, name, err)
+		}
+		fields := changedVariables(current.Variables, variables)
+		if len(fields) == 0 {
+			plan.changes.Unchanged++
+			continue
+		}
+		plan.updated = append(plan.updated, &copied)
+		plan.changes.Updated = append(plan.changes.Updated, services.BundleChange{Name: name, Fields: fields})
+	}
+	for _, environment := range state.environments {
+		if !kept[environment.ID] {
+			plan.deleted = append(plan.deleted, environment)
+			plan.changes.Deleted = append(plan.changes.Deleted, environment.Name)
+		}
+	}
+	return plan, nil
+}
+
+// changedVariables retorna as variáveis adicionadas, alteradas ou removidas, em ordem alfabética
+func changedVariables(current, desired map[string]string) []string {
+	var changed []string
+	for name, value := range desired {
+		if currentValue, ok := current[name]; !ok || currentValue != value {
+			changed = append(changed, "variables."+name)
+		}
+	}
+	for name := range current {
+		if _, ok := desired[name]; !ok {
+			changed = append(changed, "variables."+name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// newBundleChanges cria o resumo vazio, com listas em vez de null na resposta
+func newBundleChanges() services.BundleChanges {
+	return services.BundleChanges{
+		Created: []string{},
+		Updated: []services.BundleChange{},
+		Deleted: []string{},
+	}
+}
+
+// nonEmptyMap retorna nil para mapas vazios, que são omitidos do pacote
+func nonEmptyMap(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
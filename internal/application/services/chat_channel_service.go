@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"TestGO/internal/application/notifications"
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type chatChannelService struct {
+	channelRepo  repositories.ChatChannelRepository
+	secretCipher *security.SecretCipher
+	notifier     *notifications.ChatNotifier
+	authorizer   services.Authorizer
+}
+
+// NewChatChannelService cria uma nova instância do serviço de canais de chat
+func NewChatChannelService(
+	channelRepo repositories.ChatChannelRepository,
+	secretCipher *security.SecretCipher,
+	notifier *notifications.ChatNotifier,
+	authorizer services.Authorizer,
+) services.ChatChannelService {
+	return &chatChannelService{
+		channelRepo:  channelRepo,
+		secretCipher: secretCipher,
+		notifier:     notifier,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *chatChannelService) Create(ctx context.Context, req *services.CreateChatChannelRequest) (*entities.ChatChannel, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionIntegrationsWrite); err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	channel := entities.NewChatChannel(req.CompanyID, req.Name, req.Provider, req.OnlyFailures, enabled)
+	if err := channel.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.setWebhookURL(channel, req.WebhookURL); err != nil {
+		return nil, err
+	}
+
+	created, err := s.channelRepo.Create(ctx, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat channel: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *chatChannelService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateChatChannelRequest) (*entities.ChatChannel, error) {
+	channel, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	channel.UpdateChatChannel(req.Name, req.OnlyFailures, req.Enabled)
+	if err := channel.Validate(); err != nil {
+		return nil, err
+	}
+	if req.WebhookURL != "" {
+		if err := s.setWebhookURL(channel, req.WebhookURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.channelRepo.Update(ctx, channel); err != nil {
+		return nil, fmt.Errorf("failed to update chat channel: %w", err)
+	}
+
+	return channel, nil
+}
+
+func (s *chatChannelService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsWrite); err != nil {
+		return err
+	}
+
+	if err := s.channelRepo.Delete(ctx, companyID, id); err != nil {
+		return fmt.Errorf("failed to delete chat channel: %w", err)
+	}
+	return nil
+}
+
+func (s *chatChannelService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.ChatChannel, error) {
+	return s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsRead)
+}
+
+func (s *chatChannelService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionIntegrationsRead); err != nil {
+		return nil, err
+	}
+
+	channels, err := s.channelRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat channels: %w", err)
+	}
+	return channels, nil
+}
+
+func (s *chatChannelService) SendTestMessage(ctx context.Context, companyID, id uuid.UUID) error {
+	channel, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := s.notifier.SendTest(ctx, channel); err != nil {
+		return fmt.Errorf("failed to send test message: %w", err)
+	}
+	return nil
+}
+
+// getAuthorized verifica se o usuário tem a permissão na empresa e busca o canal dentro dela
+func (s *chatChannelService) getAuthorized(ctx context.Context, companyID, id uuid.UUID, permission entities.Permission) (*entities.ChatChannel, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, permission); err != nil {
+		return nil, err
+	}
+
+	return s.channelRepo.GetByID(ctx, companyID, id)
+}
+
+// setWebhookURL valida e cifra a URL do webhook de entrada, vinculada ao canal pelo ID
+func (s *chatChannelService) setWebhookURL(channel *entities.ChatChannel, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return fmt.Errorf("%w chat channel: webhook url must be an absolute https url", domainErrors.ErrInvalid)
+	}
+
+	encrypted, err := s.secretCipher.Encrypt([]byte(rawURL), channel.ID[:])
+	if err != nil {
+		return fmt.Errorf("failed to encrypt chat webhook url: %w", err)
+	}
+	channel.SetEncryptedURL(encrypted)
+	return nil
+}
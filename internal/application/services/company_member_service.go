@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type companyMemberService struct {
+	companyMemberRepo repositories.CompanyMemberRepository
+	userRepo          repositories.UserRepository
+	authorizer        services.Authorizer
+}
+
+// NewCompanyMemberService cria uma nova instância do serviço de membros das empresas
+func NewCompanyMemberService(
+	companyMemberRepo repositories.CompanyMemberRepository,
+	userRepo repositories.UserRepository,
+	authorizer services.Authorizer,
+) services.CompanyMemberService {
+	return &companyMemberService{
+		companyMemberRepo: companyMemberRepo,
+		userRepo:          userRepo,
+		authorizer:        authorizer,
+	}
+}
+
+func (s *companyMemberService) List(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionMembersRead); err != nil {
+		return nil, err
+	}
+
+	members, err := s.companyMemberRepo.ListByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (s *companyMemberService) Add(ctx context.Context, companyID uuid.UUID, req *services.AddCompanyMemberRequest) (*entities.CompanyMember, error) {
+	actor, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionMembersManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Role.IsValid() {
+		return nil, fmt.Errorf("%w role %q", domainErrors.ErrInvalid, req.Role)
+	}
+	if req.Role == entities.CompanyRoleOwner && actor.Role != entities.CompanyRoleOwner {
+		return nil, fmt.Errorf("%w: only owners can grant the owner role", domainErrors.ErrForbidden)
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, req.UserID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	member := entities.NewCompanyMember(companyID, req.UserID, req.Role)
+	if err := s.companyMemberRepo.Create(ctx, member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (s *companyMemberService) UpdateRole(ctx context.Context, companyID, userID uuid.UUID, role entities.CompanyRole) (*entities.CompanyMember, error) {
+	actor, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionMembersManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.IsValid() {
+		return nil, fmt.Errorf("%w role %q", domainErrors.ErrInvalid, role)
+	}
+
+	member, err := s.companyMemberRepo.Get(ctx, companyID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Só owners mexem no papel de owner, seja para concedê-lo ou para retirá-lo
+	if (member.Role == entities.CompanyRoleOwner || role == entities.CompanyRoleOwner) && actor.Role != entities.CompanyRoleOwner {
+		return nil, fmt.Errorf("%w: only owners can change the owner role", domainErrors.ErrForbidden)
+	}
+
+	return s.companyMemberRepo.UpdateRole(ctx, companyID, userID, role)
+}
+
+func (s *companyMemberService) Remove(ctx context.Context, companyID, userID uuid.UUID) error {
+	actor, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionMembersManage)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.companyMemberRepo.Get(ctx, companyID, userID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == entities.CompanyRoleOwner && actor.Role != entities.CompanyRoleOwner {
+		return fmt.Errorf("%w: only owners can remove an owner", domainErrors.ErrForbidden)
+	}
+
+	return s.companyMemberRepo.Delete(ctx, companyID, userID)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 	"TestGO/internal/interfaces/services"
 
@@ -13,23 +14,31 @@ import (
 
 type companyService struct {
 	companyRepo repositories.CompanyRepository
+	authorizer  services.Authorizer
 }
 
 // NewCompanyService cria uma nova instância do serviço de empresa
-func NewCompanyService(companyRepo repositories.CompanyRepository) services.CompanyService {
+func NewCompanyService(companyRepo repositories.CompanyRepository, authorizer services.Authorizer) services.CompanyService {
 	return &companyService{
 		companyRepo: companyRepo,
+		authorizer:  authorizer,
 	}
 }
 
 func (s *companyService) Create(ctx context.Context, req *services.CreateCompanyRequest) (*entities.Company, error) {
+	// Quem cria a empresa se torna o seu primeiro owner
+	userID, ok := services.ActorFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domainErrors.ErrForbidden)
+	}
+
 	// Verificar se o nome já existe
 	exists, err := s.companyRepo.ExistsByName(ctx, req.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check company name: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("company name already exists")
+		return nil, fmt.Errorf("company name %w", domainErrors.ErrAlreadyExists)
 	}
 
 	// Verificar se o email já existe
@@ -38,14 +47,14 @@ func (s *companyService) Create(ctx context.Context, req *services.CreateCompany
 		return nil, fmt.Errorf("failed to check company email: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("company email already exists")
+		return nil, fmt.Errorf("company email %w", domainErrors.ErrAlreadyExists)
 	}
 
 	// Criar empresa
 	company := entities.NewCompany(req.Name, req.Email, req.Phone, req.Address)
 
-	// Salvar no banco
-	err = s.companyRepo.Create(ctx, company)
+	// Salvar no banco junto com o owner
+	err = s.companyRepo.CreateWithOwner(ctx, company, entities.NewCompanyMember(company.ID, userID, entities.CompanyRoleOwner))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create company: %w", err)
 	}
@@ -54,6 +63,10 @@ func (s *companyService) Create(ctx context.Context, req *services.CreateCompany
 }
 
 func (s *companyService) GetByID(ctx context.Context, id uuid.UUID) (*entities.Company, error) {
+	if _, err := s.authorizer.Authorize(ctx, id, entities.PermissionCompanyRead); err != nil {
+		return nil, err
+	}
+
 	company, err := s.companyRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("company not found: %w", err)
@@ -62,6 +75,10 @@ func (s *companyService) GetByID(ctx context.Context, id uuid.UUID) (*entities.C
 }
 
 func (s *companyService) Update(ctx context.Context, id uuid.UUID, req *services.UpdateCompanyRequest) (*entities.Company, error) {
+	if _, err := s.authorizer.Authorize(ctx, id, entities.PermissionCompanyUpdate); err != nil {
+		return nil, err
+	}
+
 	// Buscar empresa existente
 	company, err := s.companyRepo.GetByID(ctx, id)
 	if err != nil {
@@ -75,7 +92,7 @@ func (s *companyService) Update(ctx context.Context, id uuid.UUID, req *services
 			return nil, fmt.Errorf("failed to check company name: %w", err)
 		}
 		if exists {
-			return nil, fmt.Errorf("company name already exists")
+			return nil, fmt.Errorf("company name %w", domainErrors.ErrAlreadyExists)
 		}
 	}
 
@@ -86,7 +103,7 @@ func (s *companyService) Update(ctx context.Context, id uuid.UUID, req *services
 			return nil, fmt.Errorf("failed to check company email: %w", err)
 		}
 		if exists {
-			return nil, fmt.Errorf("company email already exists")
+			return nil, fmt.Errorf("company email %w", domainErrors.ErrAlreadyExists)
 		}
 	}
 
@@ -103,6 +120,11 @@ func (s *companyService) Update(ctx context.Context, id uuid.UUID, req *services
 }
 
 func (s *companyService) Delete(ctx context.Context, id uuid.UUID) error {
+	// Só o owner exclui a empresa
+	if _, err := s.authorizer.Authorize(ctx, id, entities.PermissionCompanyDelete); err != nil {
+		return err
+	}
+
 	// Verificar se a empresa existe
 	_, err := s.companyRepo.GetByID(ctx, id)
 	if err != nil {
@@ -119,6 +141,12 @@ func (s *companyService) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 func (s *companyService) List(ctx context.Context, req *services.ListCompaniesRequest) (*services.ListCompaniesResponse, error) {
+	// A listagem mostra apenas as empresas das quais o usuário participa
+	userID, ok := services.ActorFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domainErrors.ErrForbidden)
+	}
+
 	// Definir valores padrão
 	if req.Limit < 1 {
 		req.Limit = 10
@@ -131,7 +159,7 @@ func (s *companyService) List(ctx context.Context, req *services.ListCompaniesRe
 	}
 
 	// Buscar empresas
-	companies, err := s.companyRepo.List(ctx, req.Limit, req.Offset)
+	companies, err := s.companyRepo.ListByUserID(ctx, userID, req.Limit, req.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list companies: %w", err)
 	}
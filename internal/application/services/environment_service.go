@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type environmentService struct {
+	environmentRepo repositories.EnvironmentRepository
+	authorizer      services.Authorizer
+}
+
+// NewEnvironmentService cria uma nova instância do serviço de ambientes
+func NewEnvironmentService(environmentRepo repositories.EnvironmentRepository, authorizer services.Authorizer) services.EnvironmentService {
+	return &environmentService{
+		environmentRepo: environmentRepo,
+		authorizer:      authorizer,
+	}
+}
+
+func (s *environmentService) Create(ctx context.Context, companyID uuid.UUID, req *services.CreateEnvironmentRequest) (*entities.Environment, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionEnvironmentsWrite); err != nil {
+		return nil, err
+	}
+
+	environment := entities.NewEnvironment(companyID, req.Name, req.Variables)
+	if err := environment.ValidateVariables(); err != nil {
+		return nil, err
+	}
+
+	// Verificar se o nome já existe na empresa
+	exists, err := s.environmentRepo.ExistsByName(ctx, companyID, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check environment name: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("environment name %w", domainErrors.ErrAlreadyExists)
+	}
+
+	created, err := s.environmentRepo.Create(ctx, environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create environment: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *environmentService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateEnvironmentRequest) (*entities.Environment, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionEnvironmentsWrite); err != nil {
+		return nil, err
+	}
+
+	environment, err := s.environmentRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("environment not found: %w", err)
+	}
+
+	// Verificar conflito de nome apenas se o nome mudou
+	if req.Name != "" && req.Name != environment.Name {
+		exists, err := s.environmentRepo.ExistsByName(ctx, companyID, req.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check environment name: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("environment name %w", domainErrors.ErrAlreadyExists)
+		}
+	}
+
+	environment.UpdateEnvironment(req.Name, req.Variables)
+	if err := environment.ValidateVariables(); err != nil {
+		return nil, err
+	}
+
+	if err := s.environmentRepo.Update(ctx, environment); err != nil {
+		return nil, fmt.Errorf("failed to update environment: %w", err)
+	}
+
+	return environment, nil
+}
+
+func (s *environmentService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionEnvironmentsWrite); err != nil {
+		return err
+	}
+
+	if err := s.environmentRepo.Delete(ctx, companyID, id); err != nil {
+		return fmt.Errorf("failed to delete environment: %w", err)
+	}
+	return nil
+}
+
+func (s *environmentService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Environment, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionEnvironmentsRead); err != nil {
+		return nil, err
+	}
+
+	environment, err := s.environmentRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("environment not found: %w", err)
+	}
+	return environment, nil
+}
+
+func (s *environmentService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Environment, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionEnvironmentsRead); err != nil {
+		return nil, err
+	}
+
+	environments, err := s.environmentRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environments: %w", err)
+	}
+	return environments, nil
+}
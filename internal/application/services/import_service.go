@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"TestGO/internal/application/importers"
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type importService struct {
+	transactor      repositories.Transactor
+	testSuiteRepo   repositories.TestSuiteRepository
+	environmentRepo repositories.EnvironmentRepository
+	authorizer      services.Authorizer
+}
+
+// NewImportService cria uma nova instância do serviço de importação
+func NewImportService(
+	transactor repositories.Transactor,
+	testSuiteRepo repositories.TestSuiteRepository,
+	environmentRepo repositories.EnvironmentRepository,
+	authorizer services.Authorizer,
+) services.ImportService {
+	return &importService{
+		transactor:      transactor,
+		testSuiteRepo:   testSuiteRepo,
+		environmentRepo: environmentRepo,
+		authorizer:      authorizer,
+	}
+}
+
+func (s *importService) ImportPostman(ctx context.Context, req *services.ImportRequest) (*services.ImportReport, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	result, err := importers.ParsePostmanCollection(req.CompanyID, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, "postman", req, result)
+}
+
+func (s *importService) ImportOpenAPI(ctx context.Context, req *services.ImportRequest) (*services.ImportReport, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	result, err := importers.ParseOpenAPI(req.CompanyID, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, "openapi", req, result)
+}
+
+func (s *importService) ImportHAR(ctx context.Context, req *services.ImportRequest) (*services.ImportReport, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	result, err := importers.ParseHAR(req.CompanyID, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, "har", req, result)
+}
+
+func (s *importService) ImportCurl(ctx context.Context, req *services.ImportRequest) (*services.ImportReport, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	result, err := importers.ParseCurl(req.CompanyID, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.save(ctx, "curl", req, result)
+}
+
+// save grava o ambiente e as suítes convertidas e monta o relatório da importação
+func (s *importService) save(ctx context.Context, source string, req *services.ImportRequest, result *importers.Result) (*services.ImportReport, error) {
+	report := &services.ImportReport{
+		Source:  source,
+		Created: []services.ImportedTestSuite{},
+		Updated: []services.ImportedTestSuite{},
+		Skipped: []services.ImportIssue{},
+	}
+	for _, issue := range result.Issues {
+		report.Skipped = append(report.Skipped, services.ImportIssue{Item: issue.Item, Reason: issue.Reason})
+	}
+
+	// O ambiente e as suítes são gravados juntos: qualquer falha desfaz a importação inteira
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if len(result.Variables) > 0 {
+			name := req.EnvironmentName
+			if name == "" {
+				name = result.Name
+			}
+			if name == "" {
+				name = source
+			}
+			environment, err := s.mergeEnvironment(ctx, req.CompanyID, name, result.Variables)
+			if err != nil {
+				return err
+			}
+			report.Environment = environment
+		}
+
+		existing, err := s.importedSuites(ctx, req.CompanyID, result.Suites)
+		if err != nil {
+			return err
+		}
+
+		for _, testSuite := range result.Suites {
+			if testSuite.ImportKey != nil {
+				if current, ok := existing[*testSuite.ImportKey]; ok {
+					if err := applyImportedSuite(current, testSuite); err != nil {
+						return fmt.Errorf("failed to update test suite %q: %w", current.Name, err)
+					}
+					if err := s.testSuiteRepo.Update(ctx, current); err != nil {
+						return fmt.Errorf("failed to update test suite %q: %w", current.Name, err)
+					}
+					report.Updated = append(report.Updated, importedTestSuite(current))
+					continue
+				}
+			}
+
+			created, err := s.testSuiteRepo.Create(ctx, testSuite)
+			if err != nil {
+				return fmt.Errorf("failed to create test suite %q: %w", testSuite.Name, err)
+			}
+			report.Created = append(report.Created, importedTestSuite(created))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// importedSuites retorna as suítes já importadas da empresa com as chaves das suítes convertidas
+func (s *importService) importedSuites(ctx context.Context, companyID uuid.UUID, testSuites []*entities.TestSuite) (map[string]*entities.TestSuite, error) {
+	var keys []string
+	for _, testSuite := range testSuites {
+		if testSuite.ImportKey != nil {
+			keys = append(keys, *testSuite.ImportKey)
+		}
+	}
+	existing := make(map[string]*entities.TestSuite)
+	if len(keys) == 0 {
+		return existing, nil
+	}
+
+	suites, err := s.testSuiteRepo.GetByImportKeys(ctx, companyID, keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, testSuite := range suites {
+		existing[*testSuite.ImportKey] = testSuite
+	}
+	return existing, nil
+}
+
+// applyImportedSuite atualiza a requisição, o status esperado e o schema gerados pela importação.
+// Asserções, extrações, dependências e o corpo esperado configurados pelo usuário são mantidos.
+func applyImportedSuite(current, imported *entities.TestSuite) error {
+	current.UpdateTestSuite(imported.Name, imported.Method, imported.URL, imported.Headers, imported.ExpectedStatus, "")
+	current.SetQueryParams(imported.QueryParams)
+	if err := current.SetRequestBody(imported.BodyType, imported.Body, imported.FormFields); err != nil {
+		return err
+	}
+	// Um schema armazenado escolhido pelo usuário só é substituído quando o documento define um schema
+	if len(imported.ResponseSchema) > 0 || current.ResponseSchemaID == nil {
+		current.SetResponseSchema(imported.ResponseSchema)
+	}
+	return nil
+}
+
+// importedTestSuite resume a suíte para o relatório da importação
+func importedTestSuite(testSuite *entities.TestSuite) services.ImportedTestSuite {
+	return services.ImportedTestSuite{
+		ID:     testSuite.ID,
+		Name:   testSuite.Name,
+		Method: testSuite.Method,
+		URL:    testSuite.URL,
+	}
+}
+
+// mergeEnvironment cria o ambiente com as variáveis ou adiciona ao ambiente existente
+// as variáveis que ele ainda não define
+func (s *importService) mergeEnvironment(ctx context.Context, companyID uuid.UUID, name string, variables map[string]string) (*services.ImportedEnvironment, error) {
+	environments, err := s.environmentRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environments: %w", err)
+	}
+
+	var existing *entities.Environment
+	for _, environment := range environments {
+		if environment.Name == name {
+			existing = environment
+			break
+		}
+	}
+
+	if existing == nil {
+		environment := entities.NewEnvironment(companyID, name, variables)
+		if err := environment.ValidateVariables(); err != nil {
+			return nil, fmt.Errorf("%w collection variables: %w", domainErrors.ErrInvalid, err)
+		}
+		created, err := s.environmentRepo.Create(ctx, environment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create environment: %w", err)
+		}
+		return &services.ImportedEnvironment{
+			ID:        created.ID,
+			Name:      created.Name,
+			Created:   true,
+			Variables: sortedKeys(variables),
+		}, nil
+	}
+
+	merged := make(map[string]string, len(existing.Variables)+len(variables))
+	for key, value := range existing.Variables {
+		merged[key] = value
+	}
+	added := map[string]string{}
+	for key, value := range variables {
+		if _, ok := merged[key]; !ok {
+			merged[key] = value
+			added[key] = value
+		}
+	}
+	if len(added) > 0 {
+		existing.UpdateEnvironment("", merged)
+		if err := existing.ValidateVariables(); err != nil {
+			return nil, fmt.Errorf("%w collection variables: %w", domainErrors.ErrInvalid, err)
+		}
+		if err := s.environmentRepo.Update(ctx, existing); err != nil {
+			return nil, fmt.Errorf("failed to update environment: %w", err)
+		}
+	}
+
+	return &services.ImportedEnvironment{
+		ID:        existing.ID,
+		Name:      existing.Name,
+		Created:   false,
+		Variables: sortedKeys(added),
+	}, nil
+}
+
+// sortedKeys retorna as chaves do mapa em ordem alfabética
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/application/runner"
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type jsonSchemaService struct {
+	jsonSchemaRepo repositories.JSONSchemaRepository
+	authorizer     services.Authorizer
+}
+
+// NewJSONSchemaService cria uma nova instância do serviço de JSON Schemas
+func NewJSONSchemaService(jsonSchemaRepo repositories.JSONSchemaRepository, authorizer services.Authorizer) services.JSONSchemaService {
+	return &jsonSchemaService{
+		jsonSchemaRepo: jsonSchemaRepo,
+		authorizer:     authorizer,
+	}
+}
+
+func (s *jsonSchemaService) Create(ctx context.Context, req *services.CreateJSONSchemaRequest) (*entities.JSONSchema, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	// Rejeitar schemas que não compilam antes de gravá-los
+	if _, err := runner.CompileSchema(req.Schema); err != nil {
+		return nil, err
+	}
+
+	schema := entities.NewJSONSchema(req.CompanyID, req.Name, req.Description, req.Schema)
+
+	created, err := s.jsonSchemaRepo.Create(ctx, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create json schema: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *jsonSchemaService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateJSONSchemaRequest) (*entities.JSONSchema, error) {
+	schema, err := s.getAuthorized(ctx, companyID, id, entities.PermissionSuitesWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Schema) > 0 {
+		if _, err := runner.CompileSchema(req.Schema); err != nil {
+			return nil, err
+		}
+	}
+
+	schema.UpdateJSONSchema(req.Name, req.Description, req.Schema)
+
+	if err := s.jsonSchemaRepo.Update(ctx, schema); err != nil {
+		return nil, fmt.Errorf("failed to update json schema: %w", err)
+	}
+
+	return schema, nil
+}
+
+func (s *jsonSchemaService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.getAuthorized(ctx, companyID, id, entities.PermissionSuitesWrite); err != nil {
+		return err
+	}
+
+	if err := s.jsonSchemaRepo.Delete(ctx, companyID, id); err != nil {
+		return fmt.Errorf("failed to delete json schema: %w", err)
+	}
+	return nil
+}
+
+func (s *jsonSchemaService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.JSONSchema, error) {
+	return s.getAuthorized(ctx, companyID, id, entities.PermissionSuitesRead)
+}
+
+func (s *jsonSchemaService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.JSONSchema, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSuitesRead); err != nil {
+		return nil, err
+	}
+
+	schemas, err := s.jsonSchemaRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json schemas: %w", err)
+	}
+	return schemas, nil
+}
+
+// getAuthorized verifica se o usuário tem a permissão na empresa e busca o schema dentro dela
+func (s *jsonSchemaService) getAuthorized(ctx context.Context, companyID, id uuid.UUID, permission entities.Permission) (*entities.JSONSchema, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, permission); err != nil {
+		return nil, err
+	}
+
+	return s.jsonSchemaRepo.GetByID(ctx, companyID, id)
+}
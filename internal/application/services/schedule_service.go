@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type scheduleService struct {
+	scheduleRepo    repositories.ScheduleRepository
+	testSuiteRepo   repositories.TestSuiteRepository
+	environmentRepo repositories.EnvironmentRepository
+	authorizer      services.Authorizer
+}
+
+// NewScheduleService cria uma nova instância do serviço de agendamentos
+func NewScheduleService(
+	scheduleRepo repositories.ScheduleRepository,
+	testSuiteRepo repositories.TestSuiteRepository,
+	environmentRepo repositories.EnvironmentRepository,
+	authorizer services.Authorizer,
+) services.ScheduleService {
+	return &scheduleService{
+		scheduleRepo:    scheduleRepo,
+		testSuiteRepo:   testSuiteRepo,
+		environmentRepo: environmentRepo,
+		authorizer:      authorizer,
+	}
+}
+
+func (s *scheduleService) Create(ctx context.Context, companyID uuid.UUID, req *services.CreateScheduleRequest) (*entities.Schedule, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSchedulesWrite); err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	schedule := entities.NewSchedule(companyID, req.Name, req.CronExpression, req.Timezone, req.TestSuiteIDs, nil, enabled)
+	schedule.SetEnvironmentID(req.EnvironmentID)
+
+	if err := s.validateTargets(ctx, schedule); err != nil {
+		return nil, err
+	}
+	if err := schedule.ScheduleNext(time.Now()); err != nil {
+		return nil, fmt.Errorf("%w schedule: %w", domainErrors.ErrInvalid, err)
+	}
+
+	// Verificar se o nome já existe na empresa
+	exists, err := s.scheduleRepo.ExistsByName(ctx, companyID, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check schedule name: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("schedule name %w", domainErrors.ErrAlreadyExists)
+	}
+
+	created, err := s.scheduleRepo.Create(ctx, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *scheduleService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateScheduleRequest) (*entities.Schedule, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSchedulesWrite); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar conflito de nome apenas se o nome mudou
+	if req.Name != "" && req.Name != schedule.Name {
+		exists, err := s.scheduleRepo.ExistsByName(ctx, companyID, req.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check schedule name: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("schedule name %w", domainErrors.ErrAlreadyExists)
+		}
+	}
+
+	var testSuiteIDs []uuid.UUID
+	if req.TestSuiteIDs != nil {
+		testSuiteIDs = append([]uuid.UUID{}, *req.TestSuiteIDs...)
+	}
+	schedule.UpdateSchedule(req.Name, req.CronExpression, req.Timezone, testSuiteIDs, req.Enabled)
+	if req.EnvironmentID != nil {
+		schedule.SetEnvironmentID(req.EnvironmentID)
+	}
+
+	if err := s.validateTargets(ctx, schedule); err != nil {
+		return nil, err
+	}
+	// A expressão, o fuso ou o estado podem ter mudado: recalcular o próximo disparo
+	if err := schedule.ScheduleNext(time.Now()); err != nil {
+		return nil, fmt.Errorf("%w schedule: %w", domainErrors.ErrInvalid, err)
+	}
+
+	if err := s.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *scheduleService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSchedulesWrite); err != nil {
+		return err
+	}
+
+	if err := s.scheduleRepo.Delete(ctx, companyID, id); err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+	return nil
+}
+
+func (s *scheduleService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Schedule, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSchedulesRead); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("schedule not found: %w", err)
+	}
+	return schedule, nil
+}
+
+func (s *scheduleService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Schedule, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSchedulesRead); err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.scheduleRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// validateTargets verifica se as suítes e o ambiente do agendamento pertencem à empresa
+func (s *scheduleService) validateTargets(ctx context.Context, schedule *entities.Schedule) error {
+	for _, id := range schedule.TestSuiteIDs {
+		if _, err := s.testSuiteRepo.GetByID(ctx, schedule.CompanyID, id); err != nil {
+			return fmt.Errorf("test suite %s %w", id, domainErrors.ErrNotFound)
+		}
+	}
+
+	if schedule.EnvironmentID != nil {
+		if _, err := s.environmentRepo.GetByID(ctx, schedule.CompanyID, *schedule.EnvironmentID); err != nil {
+			return fmt.Errorf("environment %s %w", *schedule.EnvironmentID, domainErrors.ErrNotFound)
+		}
+	}
+
+	return nil
+}
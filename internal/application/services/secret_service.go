@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type secretService struct {
+	secretRepo   repositories.SecretRepository
+	secretCipher *security.SecretCipher
+	authorizer   services.Authorizer
+}
+
+// NewSecretService cria uma nova instância do serviço de segredos
+func NewSecretService(secretRepo repositories.SecretRepository, secretCipher *security.SecretCipher, authorizer services.Authorizer) services.SecretService {
+	return &secretService{
+		secretRepo:   secretRepo,
+		secretCipher: secretCipher,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *secretService) Create(ctx context.Context, companyID uuid.UUID, req *services.CreateSecretRequest) (*entities.Secret, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSecretsWrite); err != nil {
+		return nil, err
+	}
+
+	secret := entities.NewSecret(companyID, req.Name)
+	if err := secret.ValidateName(); err != nil {
+		return nil, err
+	}
+
+	// Verificar se o nome já existe na empresa
+	exists, err := s.secretRepo.ExistsByName(ctx, companyID, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check secret name: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("secret name %w", domainErrors.ErrAlreadyExists)
+	}
+
+	if err := s.encrypt(secret, req.Value); err != nil {
+		return nil, err
+	}
+
+	created, err := s.secretRepo.Create(ctx, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *secretService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateSecretRequest) (*entities.Secret, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSecretsWrite); err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("secret not found: %w", err)
+	}
+
+	// Verificar conflito de nome apenas se o nome mudou
+	if req.Name != "" && req.Name != secret.Name {
+		exists, err := s.secretRepo.ExistsByName(ctx, companyID, req.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check secret name: %w", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("secret name %w", domainErrors.ErrAlreadyExists)
+		}
+	}
+
+	secret.Rename(req.Name)
+	if err := secret.ValidateName(); err != nil {
+		return nil, err
+	}
+
+	if req.Value != nil {
+		if err := s.encrypt(secret, *req.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.secretRepo.Update(ctx, secret); err != nil {
+		return nil, fmt.Errorf("failed to update secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+func (s *secretService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSecretsWrite); err != nil {
+		return err
+	}
+
+	if err := s.secretRepo.Delete(ctx, companyID, id); err != nil {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+	return nil
+}
+
+func (s *secretService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Secret, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSecretsRead); err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("secret not found: %w", err)
+	}
+	return secret, nil
+}
+
+func (s *secretService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Secret, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSecretsRead); err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.secretRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secrets: %w", err)
+	}
+	return secrets, nil
+}
+
+// encrypt cifra o valor vinculando-o ao ID do segredo
+func (s *secretService) encrypt(secret *entities.Secret, value string) error {
+	encrypted, err := s.secretCipher.Encrypt([]byte(value), secret.ID[:])
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	secret.SetEncryptedValue(encrypted)
+	return nil
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"TestGO/internal/application/runner"
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type testRunService struct {
+	testRunRepo     repositories.TestRunRepository
+	testResultRepo  repositories.TestResultRepository
+	testSuiteRepo   repositories.TestSuiteRepository
+	companyRepo     repositories.CompanyRepository
+	environmentRepo repositories.EnvironmentRepository
+	workerPool      *runner.WorkerPool
+	authorizer      services.Authorizer
+}
+
+// NewTestRunService cria uma nova instância do serviço de execuções de teste
+func NewTestRunService(
+	testRunRepo repositories.TestRunRepository,
+	testResultRepo repositories.TestResultRepository,
+	testSuiteRepo repositories.TestSuiteRepository,
+	companyRepo repositories.CompanyRepository,
+	environmentRepo repositories.EnvironmentRepository,
+	workerPool *runner.WorkerPool,
+	authorizer services.Authorizer,
+) services.TestRunService {
+	return &testRunService{
+		testRunRepo:     testRunRepo,
+		testResultRepo:  testResultRepo,
+		testSuiteRepo:   testSuiteRepo,
+		companyRepo:     companyRepo,
+		environmentRepo: environmentRepo,
+		workerPool:      workerPool,
+		authorizer:      authorizer,
+	}
+}
+
+func (s *testRunService) Create(ctx context.Context, req *services.CreateTestRunRequest) (*entities.TestRun, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionRunsExecute); err != nil {
+		return nil, err
+	}
+
+	// Verificar se a empresa existe
+	if _, err := s.companyRepo.GetByID(ctx, req.CompanyID); err != nil {
+		return nil, fmt.Errorf("company not found: %w", err)
+	}
+
+	// Validar as suítes selecionadas antes de enfileirar
+	testSuites, err := s.selectTestSuites(ctx, req.CompanyID, req.TestSuiteIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(testSuites) == 0 {
+		return nil, fmt.Errorf("%w test run: no test suites to run", domainErrors.ErrInvalid)
+	}
+
+	// O ambiente precisa pertencer à mesma empresa
+	if req.EnvironmentID != nil {
+		if _, err := s.environmentRepo.GetByID(ctx, req.CompanyID, *req.EnvironmentID); err != nil {
+			if errors.Is(err, domainErrors.ErrNotFound) {
+				return nil, fmt.Errorf("environment %s %w", *req.EnvironmentID, domainErrors.ErrNotFound)
+			}
+			return nil, fmt.Errorf("failed to get environment: %w", err)
+		}
+	}
+
+	// Enfileirar a execução; os workers a executam em background
+	testRun, err := s.testRunRepo.Create(ctx, entities.NewTestRun(req.CompanyID, req.TestSuiteIDs, req.EnvironmentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test run: %w", err)
+	}
+	s.workerPool.Notify()
+
+	return testRun, nil
+}
+
+func (s *testRunService) Cancel(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error) {
+	testRun, err := s.getAuthorized(ctx, companyID, id, entities.PermissionRunsExecute)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := testRun.Status
+	if err := testRun.Cancel(); err != nil {
+		return nil, fmt.Errorf("%w: test run cannot be cancelled: %w", domainErrors.ErrConflict, err)
+	}
+
+	// Gravar somente se o estado não mudou desde a leitura (ex.: worker concluiu a execução)
+	updated, err := s.testRunRepo.UpdateIfStatus(ctx, testRun, previous)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel test run: %w", err)
+	}
+	if !updated {
+		return nil, fmt.Errorf("%w: test run cannot be cancelled: status changed, try again", domainErrors.ErrConflict)
+	}
+
+	// Interromper as requisições em andamento, se a execução estiver nesta instância
+	s.workerPool.Cancel(id)
+
+	return testRun, nil
+}
+
+func (s *testRunService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error) {
+	return s.getAuthorized(ctx, companyID, id, entities.PermissionRunsRead)
+}
+
+func (s *testRunService) GetResults(ctx context.Context, companyID, id uuid.UUID) ([]*entities.TestResult, error) {
+	// Verificar se a execução existe e se o usuário pode vê-la
+	if _, err := s.getAuthorized(ctx, companyID, id, entities.PermissionRunsRead); err != nil {
+		return nil, err
+	}
+
+	testResults, err := s.testResultRepo.GetByTestRunID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test results: %w", err)
+	}
+	return testResults, nil
+}
+
+// getAuthorized verifica se o usuário tem a permissão na empresa e busca a execução dentro dela
+func (s *testRunService) getAuthorized(ctx context.Context, companyID, id uuid.UUID, permission entities.Permission) (*entities.TestRun, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, permission); err != nil {
+		return nil, err
+	}
+
+	testRun, err := s.testRunRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("test run not found: %w", err)
+	}
+
+	return testRun, nil
+}
+
+// reportSnippetSize é o tamanho máximo do trecho do corpo da resposta incluído no relatório
+const reportSnippetSize = 4096
+
+// GetReport monta o relatório da execução a partir dos resultados gravados, com um caso de teste por suíte
+func (s *testRunService) GetReport(ctx context.Context, companyID, id uuid.UUID) (*services.TestRunReport, error) {
+	testRun, err := s.getAuthorized(ctx, companyID, id, entities.PermissionRunsRead)
+	if err != nil {
+		return nil, err
+	}
+
+	testResults, err := s.testResultRepo.GetByTestRunID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test results: %w", err)
+	}
+
+	report := &services.TestRunReport{
+		TestRun:   testRun,
+		TestCases: make([]services.TestCaseReport, 0, len(testResults)),
+	}
+	for _, result := range testResults {
+		testCase := services.TestCaseReport{
+			TestSuiteID:     result.TestSuiteID,
+			Name:            result.TestSuiteName,
+			Status:          result.Status,
+			ResponseStatus:  result.ResponseStatus,
+			ResponseHeaders: result.ResponseHeaders,
+			TimeMS:          result.ResponseTimeMS,
+			Assertions:      result.AssertionResults,
+			Failures:        resultFailures(result),
+			ResponseSnippet: responseSnippet(result.ResponseBody),
+		}
+		// Resultados gravados antes do nome da suíte passar a ser guardado são identificados pelo ID
+		if testCase.Name == "" {
+			testCase.Name = result.TestSuiteID.String()
+		}
+		// Suítes puladas ou que falharam antes do envio não têm requisição registrada
+		if request := result.Request; request != nil {
+			testCase.Method = request.Method
+			testCase.URL = request.URL
+			testCase.RequestHeaders = request.Headers
+			testCase.QueryParams = request.QueryParams
+			testCase.RequestBody = request.Body
+			testCase.FormFields = request.FormFields
+		}
+		report.TimeMS += result.ResponseTimeMS
+		report.TestCases = append(report.TestCases, testCase)
+	}
+	return report, nil
+}
+
+// resultFailures reúne a mensagem de erro e o detalhe das violações de schema do resultado.
+// A mensagem já traz cada verificação reprovada, inclusive as asserções, mas só a contagem das violações
+func resultFailures(result *entities.TestResult) []string {
+	var failures []string
+	if result.ErrorMessage != "" {
+		failures = append(failures, result.ErrorMessage)
+	}
+	for _, violation := range result.SchemaViolations {
+		path := violation.InstancePath
+		if path == "" {
+			path = "/"
+		}
+		failures = append(failures, fmt.Sprintf("schema %s: %s", path, violation.Message))
+	}
+	return failures
+}
+
+// responseSnippet retorna o início do corpo da resposta, limitado a reportSnippetSize bytes
+func responseSnippet(body string) string {
+	if len(body) <= reportSnippetSize {
+		return body
+	}
+	// Cortar pode dividir um caractere multibyte; a parte inválida é descartada
+	return strings.ToValidUTF8(body[:reportSnippetSize], "") + "..."
+}
+
+// selectTestSuites retorna as suítes solicitadas ou, se nenhuma for informada, todas as da empresa
+func (s *testRunService) selectTestSuites(ctx context.Context, companyID uuid.UUID, ids []uuid.UUID) ([]*entities.TestSuite, error) {
+	if len(ids) == 0 {
+		testSuites, err := s.testSuiteRepo.GetByCompanyID(ctx, companyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get test suites: %w", err)
+		}
+		return testSuites, nil
+	}
+
+	testSuites := make([]*entities.TestSuite, 0, len(ids))
+	for _, id := range ids {
+		testSuite, err := s.testSuiteRepo.GetByID(ctx, companyID, id)
+		if err != nil {
+			if errors.Is(err, domainErrors.ErrNotFound) {
+				return nil, fmt.Errorf("test suite %s %w", id, domainErrors.ErrNotFound)
+			}
+			return nil, fmt.Errorf("failed to get test suite: %w", err)
+		}
+		testSuites = append(testSuites, testSuite)
+	}
+	return testSuites, nil
+}
@@ -1,9 +1,15 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 
+	"TestGO/internal/application/runner"
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 	"TestGO/internal/interfaces/services"
 
@@ -11,34 +17,341 @@ import (
 )
 
 type testSuiteService struct {
-	testSuiteRepo repositories.TestSuiteRepository
+	testSuiteRepo  repositories.TestSuiteRepository
+	jsonSchemaRepo repositories.JSONSchemaRepository
+	authorizer     services.Authorizer
 }
 
-func NewTestSuiteService(testSuiteRepo repositories.TestSuiteRepository) services.TestSuiteService {
+func NewTestSuiteService(testSuiteRepo repositories.TestSuiteRepository, jsonSchemaRepo repositories.JSONSchemaRepository, authorizer services.Authorizer) services.TestSuiteService {
 	return &testSuiteService{
-		testSuiteRepo: testSuiteRepo,
+		testSuiteRepo:  testSuiteRepo,
+		jsonSchemaRepo: jsonSchemaRepo,
+		authorizer:     authorizer,
 	}
 }
 
 func (s *testSuiteService) Create(ctx context.Context, req *services.CreateTestSuiteRequest) (*entities.TestSuite, error) {
-	return nil, nil
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	testSuite := entities.NewTestSuite(
+		req.CompanyID,
+		req.Name,
+		req.Method,
+		req.URL,
+		req.Headers,
+		req.ExpectedStatus,
+		req.ExpectedBody,
+	)
+
+	assertions, err := buildAssertions(req.Assertions)
+	if err != nil {
+		return nil, err
+	}
+	testSuite.SetAssertions(assertions)
+	testSuite.SetQueryParams(req.QueryParams)
+
+	extractions, err := buildExtractions(req.Extractions)
+	if err != nil {
+		return nil, err
+	}
+	testSuite.SetExtractions(extractions)
+
+	if err := s.applyDependsOn(ctx, testSuite, req.DependsOn); err != nil {
+		return nil, err
+	}
+
+	if err := testSuite.SetRequestBody(req.BodyType, req.Body, req.FormFields); err != nil {
+		return nil, fmt.Errorf("%w request body: %w", domainErrors.ErrInvalid, err)
+	}
+
+	if err := s.applyResponseSchema(ctx, testSuite, req.ResponseSchema, req.ResponseSchemaID); err != nil {
+		return nil, err
+	}
+
+	created, err := s.testSuiteRepo.Create(ctx, testSuite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test suite: %w", err)
+	}
+
+	return created, nil
 }
 
-func (s *testSuiteService) Update(ctx context.Context, id uuid.UUID, req *services.UpdateTestSuiteRequest) (*entities.TestSuite, error) {
-	return nil, nil
+func (s *testSuiteService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateTestSuiteRequest) (*entities.TestSuite, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSuitesWrite); err != nil {
+		return nil, err
+	}
+
+	// Buscar suíte existente
+	testSuite, err := s.testSuiteRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Atualizar campos
+	testSuite.UpdateTestSuite(req.Name, req.Method, req.URL, req.Headers, req.ExpectedStatus, req.ExpectedBody)
+	if req.QueryParams != nil {
+		testSuite.SetQueryParams(req.QueryParams)
+	}
+	if req.BodyType != "" || req.Body != nil || req.FormFields != nil {
+		bodyType, body, formFields := testSuite.BodyType, testSuite.Body, testSuite.FormFields
+		if req.BodyType != "" {
+			bodyType = req.BodyType
+		}
+		if req.Body != nil {
+			body = *req.Body
+		}
+		if req.FormFields != nil {
+			formFields = req.FormFields
+		}
+		if err := testSuite.SetRequestBody(bodyType, body, formFields); err != nil {
+			return nil, fmt.Errorf("%w request body: %w", domainErrors.ErrInvalid, err)
+		}
+	}
+	if req.Assertions != nil {
+		assertions, err := buildAssertions(*req.Assertions)
+		if err != nil {
+			return nil, err
+		}
+		testSuite.SetAssertions(assertions)
+	}
+	if req.Extractions != nil {
+		extractions, err := buildExtractions(*req.Extractions)
+		if err != nil {
+			return nil, err
+		}
+		testSuite.SetExtractions(extractions)
+	}
+	if req.DependsOn != nil {
+		if err := s.applyDependsOn(ctx, testSuite, *req.DependsOn); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.applyResponseSchema(ctx, testSuite, req.ResponseSchema, req.ResponseSchemaID); err != nil {
+		return nil, err
+	}
+
+	// Salvar no banco
+	err = s.testSuiteRepo.Update(ctx, testSuite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update test suite: %w", err)
+	}
+
+	return testSuite, nil
 }
 
-func (s *testSuiteService) Delete(ctx context.Context, id uuid.UUID) error {
+func (s *testSuiteService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSuitesWrite); err != nil {
+		return err
+	}
+
+	err := s.testSuiteRepo.Delete(ctx, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete test suite: %w", err)
+	}
 	return nil
 }
-func (s *testSuiteService) GetByID(ctx context.Context, id uuid.UUID) (*entities.TestSuite, error) {
-	return nil, nil
+
+func (s *testSuiteService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestSuite, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSuitesRead); err != nil {
+		return nil, err
+	}
+
+	testSuite, err := s.testSuiteRepo.GetByID(ctx, companyID, id)
+	if err != nil {
+		return nil, fmt.Errorf("test suite not found: %w", err)
+	}
+
+	return testSuite, nil
 }
 
 func (s *testSuiteService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestSuite, error) {
-	return nil, nil
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionSuitesRead); err != nil {
+		return nil, err
+	}
+
+	testSuites, err := s.testSuiteRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test suites: %w", err)
+	}
+	return testSuites, nil
 }
 
 func (s *testSuiteService) List(ctx context.Context, req *services.ListTestSuitesRequest) (*services.ListTestSuitesResponse, error) {
-	return nil, nil
+	// A listagem é sempre de uma empresa da qual o usuário é membro
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionSuitesRead); err != nil {
+		return nil, err
+	}
+
+	// Definir valores padrão
+	if req.Limit < 1 {
+		req.Limit = 10
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	testSuites, err := s.testSuiteRepo.ListByCompanyID(ctx, req.CompanyID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list test suites: %w", err)
+	}
+
+	total, err := s.testSuiteRepo.CountByCompanyID(ctx, req.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count test suites: %w", err)
+	}
+
+	// Converter entities.TestSuite para services.TestSuiteResponse
+	testSuiteResponses := make([]*services.TestSuiteResponse, len(testSuites))
+	for i, testSuite := range testSuites {
+		testSuiteResponses[i] = &services.TestSuiteResponse{
+			ID:               testSuite.ID,
+			CompanyID:        testSuite.CompanyID,
+			Name:             testSuite.Name,
+			Method:           testSuite.Method,
+			URL:              testSuite.URL,
+			Headers:          testSuite.Headers,
+			QueryParams:      testSuite.QueryParams,
+			BodyType:         testSuite.BodyType,
+			Body:             testSuite.Body,
+			FormFields:       testSuite.FormFields,
+			ExpectedStatus:   testSuite.ExpectedStatus,
+			ExpectedBody:     testSuite.ExpectedBody,
+			ResponseSchema:   testSuite.ResponseSchema,
+			ResponseSchemaID: testSuite.ResponseSchemaID,
+			Assertions:       testSuite.Assertions,
+			Extractions:      testSuite.Extractions,
+			DependsOn:        testSuite.DependsOn,
+			CreatedAt:        testSuite.CreatedAt,
+			UpdatedAt:        testSuite.UpdatedAt,
+		}
+	}
+
+	return &services.ListTestSuitesResponse{
+		TestSuites: testSuiteResponses,
+		Total:      total,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	}, nil
+}
+
+// buildAssertions converte e valida as asserções recebidas, na ordem informada
+func buildAssertions(reqs []services.AssertionRequest) ([]*entities.Assertion, error) {
+	assertions := make([]*entities.Assertion, 0, len(reqs))
+	for i, req := range reqs {
+		assertion := entities.NewAssertion(uuid.Nil, req.Type, req.Target, req.Expected, i)
+		if err := assertion.Validate(); err != nil {
+			return nil, fmt.Errorf("%w assertion %d (%s): %w", domainErrors.ErrInvalid, i, req.Type, err)
+		}
+		assertions = append(assertions, assertion)
+	}
+	return assertions, nil
+}
+
+// buildExtractions converte e valida as regras de extração recebidas, na ordem informada
+func buildExtractions(reqs []services.ExtractionRequest) ([]*entities.Extraction, error) {
+	extractions := make([]*entities.Extraction, 0, len(reqs))
+	names := make(map[string]bool, len(reqs))
+	for i, req := range reqs {
+		extraction := entities.NewExtraction(uuid.Nil, req.Name, req.Source, req.Expression, i)
+		if err := extraction.Validate(); err != nil {
+			return nil, fmt.Errorf("%w extraction %d (%s): %w", domainErrors.ErrInvalid, i, req.Name, err)
+		}
+		if names[extraction.Name] {
+			return nil, fmt.Errorf("%w extraction %d (%s): duplicate variable name", domainErrors.ErrInvalid, i, req.Name)
+		}
+		names[extraction.Name] = true
+		extractions = append(extractions, extraction)
+	}
+	return extractions, nil
+}
+
+// applyDependsOn valida e aplica as dependências da suíte: suítes da mesma empresa e sem ciclos
+func (s *testSuiteService) applyDependsOn(ctx context.Context, testSuite *entities.TestSuite, ids []uuid.UUID) error {
+	if err := testSuite.SetDependsOn(ids); err != nil {
+		return fmt.Errorf("%w dependencies: %w", domainErrors.ErrInvalid, err)
+	}
+	if len(testSuite.DependsOn) == 0 {
+		return nil
+	}
+
+	testSuites, err := s.testSuiteRepo.GetByCompanyID(ctx, testSuite.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to get test suites: %w", err)
+	}
+
+	graph := make(map[uuid.UUID][]uuid.UUID, len(testSuites)+1)
+	for _, other := range testSuites {
+		graph[other.ID] = other.DependsOn
+	}
+	for _, id := range testSuite.DependsOn {
+		if _, ok := graph[id]; !ok {
+			return fmt.Errorf("dependency test suite %s %w", id, domainErrors.ErrNotFound)
+		}
+	}
+	graph[testSuite.ID] = testSuite.DependsOn
+
+	if reaches(graph, testSuite.DependsOn, testSuite.ID) {
+		return fmt.Errorf("%w dependencies: dependency cycle detected", domainErrors.ErrInvalid)
+	}
+	return nil
+}
+
+// reaches indica se target é alcançável a partir de from seguindo as dependências do grafo
+func reaches(graph map[uuid.UUID][]uuid.UUID, from []uuid.UUID, target uuid.UUID) bool {
+	visited := make(map[uuid.UUID]bool, len(graph))
+	pending := append([]uuid.UUID(nil), from...)
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if id == target {
+			return true
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		pending = append(pending, graph[id]...)
+	}
+	return false
+}
+
+// applyResponseSchema valida e aplica o schema inline ou a referência a um schema armazenado.
+// Valores ausentes mantêm a configuração atual.
+func (s *testSuiteService) applyResponseSchema(ctx context.Context, testSuite *entities.TestSuite, schema json.RawMessage, schemaID *uuid.UUID) error {
+	clearSchema := string(bytes.TrimSpace(schema)) == "null"
+	if len(schema) > 0 && !clearSchema && schemaID != nil && *schemaID != uuid.Nil {
+		return fmt.Errorf("%w response schema: provide either response_schema or response_schema_id", domainErrors.ErrInvalid)
+	}
+
+	switch {
+	case clearSchema:
+		testSuite.SetResponseSchema(nil)
+	case len(schema) > 0:
+		if _, err := runner.CompileSchema(schema); err != nil {
+			return fmt.Errorf("%w response schema: %w", domainErrors.ErrInvalid, err)
+		}
+		testSuite.SetResponseSchema(schema)
+	}
+
+	if schemaID != nil {
+		if *schemaID == uuid.Nil {
+			testSuite.SetResponseSchemaID(nil)
+			return nil
+		}
+		stored, err := s.jsonSchemaRepo.GetByID(ctx, testSuite.CompanyID, *schemaID)
+		if err != nil {
+			if errors.Is(err, domainErrors.ErrNotFound) {
+				return fmt.Errorf("response schema json schema %s %w", *schemaID, domainErrors.ErrNotFound)
+			}
+			return fmt.Errorf("failed to get response schema: %w", err)
+		}
+		testSuite.SetResponseSchemaID(&stored.ID)
+	}
+
+	return nil
 }
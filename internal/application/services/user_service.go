@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 	"TestGO/internal/infrastructure/security"
 	"TestGO/internal/interfaces/services"
@@ -13,18 +14,21 @@ import (
 )
 
 type userService struct {
-	userRepo        repositories.UserRepository
-	passwordService *security.PasswordService
+	userRepo          repositories.UserRepository
+	companyMemberRepo repositories.CompanyMemberRepository
+	passwordService   *security.PasswordService
 }
 
 // NewUserService cria uma nova instância do serviço de usuário
 func NewUserService(
 	userRepo repositories.UserRepository,
+	companyMemberRepo repositories.CompanyMemberRepository,
 	passwordService *security.PasswordService,
 ) services.UserService {
 	return &userService{
-		userRepo:        userRepo,
-		passwordService: passwordService,
+		userRepo:          userRepo,
+		companyMemberRepo: companyMemberRepo,
+		passwordService:   passwordService,
 	}
 }
 
@@ -36,6 +40,19 @@ func (s *userService) GetByID(ctx context.Context, id uuid.UUID) (*entities.User
 	return user, nil
 }
 
+func (s *userService) GetVisible(ctx context.Context, id uuid.UUID) (*entities.User, error) {
+	viewerID, ok := services.ActorFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domainErrors.ErrForbidden)
+	}
+
+	user, err := s.userRepo.GetVisibleTo(ctx, viewerID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
 func (s *userService) GetByUsername(ctx context.Context, username string) (*entities.User, error) {
 	user, err := s.userRepo.GetByUsername(ctx, username)
 	if err != nil {
@@ -58,7 +75,7 @@ func (s *userService) Update(ctx context.Context, id uuid.UUID, req *services.Up
 			return nil, fmt.Errorf("failed to check username: %w", err)
 		}
 		if exists {
-			return nil, fmt.Errorf("username already exists")
+			return nil, fmt.Errorf("username %w", domainErrors.ErrAlreadyExists)
 		}
 	}
 
@@ -69,7 +86,7 @@ func (s *userService) Update(ctx context.Context, id uuid.UUID, req *services.Up
 			return nil, fmt.Errorf("failed to check email: %w", err)
 		}
 		if exists {
-			return nil, fmt.Errorf("email already exists")
+			return nil, fmt.Errorf("email %w", domainErrors.ErrAlreadyExists)
 		}
 	}
 
@@ -83,6 +100,9 @@ func (s *userService) Update(ctx context.Context, id uuid.UUID, req *services.Up
 	if req.Name != "" {
 		user.Name = req.Name
 	}
+	if req.EmailAlerts != nil {
+		user.SetEmailAlerts(*req.EmailAlerts)
+	}
 
 	// Salvar no banco
 	err = s.userRepo.Update(ctx, user)
@@ -121,30 +141,37 @@ func (s *userService) List(ctx context.Context, req *services.ListUsersRequest)
 		req.Offset = 0
 	}
 
-	// Buscar usuários
-	users, err := s.userRepo.List(ctx, req.Limit, req.Offset)
+	viewerID, ok := services.ActorFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domainErrors.ErrForbidden)
+	}
+
+	// Buscar apenas usuários que compartilham alguma empresa com quem consulta
+	users, err := s.userRepo.ListVisibleTo(ctx, viewerID, req.Limit, req.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list users: %w", err)
 	}
 
+	total, err := s.userRepo.CountVisibleTo(ctx, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
 	// Converter entities.User para services.UserResponse
 	userResponses := make([]*services.UserResponse, len(users))
 	for i, user := range users {
 		userResponses[i] = &services.UserResponse{
-			ID:        user.ID,
-			Username:  user.Username,
-			Email:     user.Email,
-			Name:      user.Name,
-			CompanyID: user.CompanyID,
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
+			ID:          user.ID,
+			Username:    user.Username,
+			Email:       user.Email,
+			Name:        user.Name,
+			CompanyID:   user.CompanyID,
+			EmailAlerts: user.EmailAlerts,
+			CreatedAt:   user.CreatedAt,
+			UpdatedAt:   user.UpdatedAt,
 		}
 	}
 
-	// Para simplicidade, vamos assumir um total fixo
-	// Em uma implementação real, você faria uma query COUNT
-	total := int64(len(users))
-
 	return &services.ListUsersResponse{
 		Users:  userResponses,
 		Total:  total,
@@ -183,7 +210,8 @@ func (s *userService) ChangePassword(ctx context.Context, id uuid.UUID, req *ser
 	return nil
 }
 
-// LinkCompany vincula uma empresa a um usuário
+// LinkCompany vincula uma empresa a um usuário. Só é possível vincular empresas das quais o
+// usuário já é membro; as demais são tratadas como inexistentes.
 func (s *userService) LinkCompany(ctx context.Context, userID, companyID uuid.UUID) error {
 	// Buscar usuário
 	user, err := s.userRepo.GetByID(ctx, userID)
@@ -191,6 +219,10 @@ func (s *userService) LinkCompany(ctx context.Context, userID, companyID uuid.UU
 		return fmt.Errorf("user not found: %w", err)
 	}
 
+	if _, err := s.companyMemberRepo.Get(ctx, companyID, userID); err != nil {
+		return fmt.Errorf("company not found: %w", err)
+	}
+
 	// Verificar se o usuário já está vinculado a uma empresa
 	if user.CompanyID != nil {
 		return fmt.Errorf("user is already linked to a company")
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"TestGO/internal/application/notifications"
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+	"TestGO/internal/infrastructure/security"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/google/uuid"
+)
+
+type webhookService struct {
+	webhookRepo  repositories.WebhookRepository
+	deliveryRepo repositories.WebhookDeliveryRepository
+	secretCipher *security.SecretCipher
+	dispatcher   *notifications.WebhookDispatcher
+	authorizer   services.Authorizer
+}
+
+// NewWebhookService cria uma nova instância do serviço de webhooks
+func NewWebhookService(
+	webhookRepo repositories.WebhookRepository,
+	deliveryRepo repositories.WebhookDeliveryRepository,
+	secretCipher *security.SecretCipher,
+	dispatcher *notifications.WebhookDispatcher,
+	authorizer services.Authorizer,
+) services.WebhookService {
+	return &webhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		secretCipher: secretCipher,
+		dispatcher:   dispatcher,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *webhookService) Create(ctx context.Context, req *services.CreateWebhookRequest) (*services.CreateWebhookResponse, error) {
+	if _, err := s.authorizer.Authorize(ctx, req.CompanyID, entities.PermissionIntegrationsWrite); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	webhook := entities.NewWebhook(req.CompanyID, req.URL, req.Events, enabled)
+	if err := webhook.ValidateEvents(); err != nil {
+		return nil, err
+	}
+
+	// O segredo é vinculado ao webhook pelo ID usado como dado adicional da cifra
+	secret, err := security.GenerateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := s.secretCipher.Encrypt([]byte(secret), webhook.ID[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+	webhook.SetEncryptedSecret(encrypted)
+
+	created, err := s.webhookRepo.Create(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return &services.CreateWebhookResponse{Webhook: created, Secret: secret}, nil
+}
+
+func (s *webhookService) Update(ctx context.Context, companyID, id uuid.UUID, req *services.UpdateWebhookRequest) (*entities.Webhook, error) {
+	webhook, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != "" {
+		if err := validateWebhookURL(req.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	webhook.UpdateWebhook(req.URL, req.Events, req.Enabled)
+	if err := webhook.ValidateEvents(); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (s *webhookService) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	if _, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsWrite); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, companyID, id); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return nil
+}
+
+func (s *webhookService) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Webhook, error) {
+	return s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsRead)
+}
+
+func (s *webhookService) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Webhook, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, entities.PermissionIntegrationsRead); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.webhookRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func (s *webhookService) GetDeliveries(ctx context.Context, companyID, id uuid.UUID, limit int) ([]*entities.WebhookDelivery, error) {
+	if _, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsRead); err != nil {
+		return nil, err
+	}
+
+	if limit < 1 {
+		limit = 50
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	deliveries, err := s.deliveryRepo.GetByWebhookID(ctx, companyID, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (s *webhookService) SendTestEvent(ctx context.Context, companyID, id uuid.UUID) (*entities.WebhookDelivery, error) {
+	webhook, err := s.getAuthorized(ctx, companyID, id, entities.PermissionIntegrationsWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.dispatcher.SendTest(ctx, webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send test event: %w", err)
+	}
+	return delivery, nil
+}
+
+// getAuthorized verifica se o usuário tem a permissão na empresa e busca o webhook dentro dela
+func (s *webhookService) getAuthorized(ctx context.Context, companyID, id uuid.UUID, permission entities.Permission) (*entities.Webhook, error) {
+	if _, err := s.authorizer.Authorize(ctx, companyID, permission); err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepo.GetByID(ctx, companyID, id)
+}
+
+// validateWebhookURL aceita apenas URLs absolutas http ou https
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w webhook url %q", domainErrors.ErrInvalid, rawURL)
+	}
+	return nil
+}
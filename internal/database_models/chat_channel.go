@@ -0,0 +1,20 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatChannel struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID    uuid.UUID `gorm:"type:uuid;not null;index:idx_chat_channels_company_id" json:"company_id"`
+	Company      *Company  `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE" json:"company,omitempty"`
+	Name         string    `gorm:"not null" json:"name"`
+	Provider     string    `gorm:"not null" json:"provider"`
+	EncryptedURL []byte    `gorm:"type:bytea;not null" json:"-"`
+	OnlyFailures bool      `gorm:"not null;default:false" json:"only_failures"`
+	Enabled      bool      `gorm:"not null;default:true" json:"enabled"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CompanyMember struct {
+	CompanyID uuid.UUID `gorm:"primaryKey;type:uuid" json:"company_id"`
+	Company   *Company  `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE" json:"company,omitempty"`
+	UserID    uuid.UUID `gorm:"primaryKey;type:uuid;index:idx_company_members_user_id" json:"user_id"`
+	User      *User     `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
+	Role      string    `gorm:"not null;check:chk_company_members_role,role IN ('owner', 'admin', 'editor', 'viewer')" json:"role"`
+	CreatedAt time.Time `gorm:"not null;default:now()" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null;default:now()" json:"updated_at"`
+}
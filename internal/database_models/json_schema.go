@@ -0,0 +1,18 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JSONSchema struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID   uuid.UUID `gorm:"type:uuid;not null;index:idx_json_schemas_company_id" json:"company_id"`
+	Company     *Company  `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE" json:"company,omitempty"`
+	Name        string    `gorm:"not null" json:"name"`
+	Description string    `gorm:"not null;default:''" json:"description"`
+	Schema      string    `gorm:"type:jsonb;not null" json:"schema"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
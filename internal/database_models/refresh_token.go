@@ -0,0 +1,19 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefreshToken struct {
+	ID        uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index:idx_refresh_tokens_user_id" json:"user_id"`
+	User      *User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
+	FamilyID  uuid.UUID  `gorm:"type:uuid;not null;index:idx_refresh_tokens_family_id" json:"family_id"`
+	TokenHash string     `gorm:"not null;uniqueIndex:idx_refresh_tokens_token_hash" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
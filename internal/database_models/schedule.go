@@ -0,0 +1,24 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Schedule struct {
+	ID             uuid.UUID    `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID      uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_schedules_company_id_name,priority:1" json:"company_id"`
+	Company        *Company     `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE" json:"company,omitempty"`
+	Name           string       `gorm:"not null;uniqueIndex:idx_schedules_company_id_name,priority:2" json:"name"`
+	CronExpression string       `gorm:"not null" json:"cron_expression"`
+	Timezone       string       `gorm:"not null;default:'UTC'" json:"timezone"`
+	TestSuiteIDs   []uuid.UUID  `gorm:"type:uuid[]" json:"test_suite_ids"`
+	EnvironmentID  *uuid.UUID   `gorm:"type:uuid" json:"environment_id"`
+	Environment    *Environment `gorm:"foreignKey:EnvironmentID;constraint:OnDelete:SET NULL" json:"environment,omitempty"`
+	Enabled        bool         `gorm:"not null;default:true" json:"enabled"`
+	NextRunAt      *time.Time   `gorm:"index:idx_schedules_next_run_at,where:enabled" json:"next_run_at"`
+	LastRunAt      *time.Time   `json:"last_run_at"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
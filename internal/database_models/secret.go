@@ -0,0 +1,17 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Secret struct {
+	ID             uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_secrets_company_id_name,priority:1" json:"company_id"`
+	Company        *Company  `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE" json:"company,omitempty"`
+	Name           string    `gorm:"not null;uniqueIndex:idx_secrets_company_id_name,priority:2" json:"name"`
+	EncryptedValue []byte    `gorm:"type:bytea;not null" json:"-"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
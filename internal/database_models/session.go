@@ -0,0 +1,19 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Session struct {
+	ID         uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index:idx_sessions_user_id" json:"user_id"`
+	User       *User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
+	UserAgent  string     `gorm:"not null;default:''" json:"user_agent"`
+	IPAddress  string     `gorm:"not null;default:''" json:"ip_address"`
+	CreatedAt  time.Time  `gorm:"not null;default:now()" json:"created_at"`
+	LastUsedAt time.Time  `gorm:"not null;default:now()" json:"last_used_at"`
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
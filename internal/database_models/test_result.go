@@ -7,16 +7,22 @@ import (
 )
 
 type TestResult struct {
-	ID             uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
-	TestRunID      uuid.UUID  `gorm:"type:uuid" json:"test_run_id"`
-	TestRun        *TestRun   `gorm:"foreignKey:TestRunID" json:"test_run,omitempty"`
-	EndpointTestID uuid.UUID  `gorm:"type:uuid" json:"endpoint_test_id"`
-	EndpointTest   *TestSuite `gorm:"foreignKey:EndpointTestID" json:"endpoint_test,omitempty"`
-	Status         string     `json:"status"`
-	ResponseStatus int        `json:"response_status"`
-	ResponseBody   string     `json:"response_body"`
-	ResponseTimeMS int        `json:"response_time_ms"`
-	ErrorMessage   string     `json:"error_message"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      time.Time  `json:"updated_at"`
+	ID                    uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	TestRunID             uuid.UUID  `gorm:"type:uuid;index:idx_test_results_test_run_id" json:"test_run_id"`
+	TestRun               *TestRun   `gorm:"foreignKey:TestRunID;constraint:OnDelete:CASCADE" json:"test_run,omitempty"`
+	EndpointTestID        uuid.UUID  `gorm:"type:uuid" json:"endpoint_test_id"`
+	EndpointTest          *TestSuite `gorm:"foreignKey:EndpointTestID;constraint:OnDelete:CASCADE" json:"endpoint_test,omitempty"`
+	TestSuiteName         string     `gorm:"not null;default:''" json:"test_suite_name"`
+	Request               string     `gorm:"type:jsonb" json:"request"`
+	Status                string     `json:"status"`
+	ResponseStatus        int        `gorm:"type:integer" json:"response_status"`
+	ResponseHeaders       string     `gorm:"type:jsonb;not null;default:'{}'" json:"response_headers"`
+	ResponseBody          string     `json:"response_body"`
+	ResponseBodyTruncated bool       `gorm:"not null;default:false" json:"response_body_truncated"`
+	ResponseTimeMS        int        `gorm:"type:integer" json:"response_time_ms"`
+	ErrorMessage          string     `json:"error_message"`
+	AssertionResults      string     `gorm:"type:jsonb;not null;default:'[]'" json:"assertion_results"`
+	SchemaViolations      string     `gorm:"type:jsonb;not null;default:'[]'" json:"schema_violations"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
 }
@@ -7,15 +7,22 @@ import (
 )
 
 type TestRun struct {
-	ID          uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
-	CompanyID   uuid.UUID `gorm:"type:uuid" json:"company_id"`
-	Company     *Company  `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
-	StartedAt   time.Time `json:"started_at"`
-	FinishedAt  time.Time `json:"finished_at"`
-	Status      string    `json:"status"`
-	TotalTests  int       `json:"total_tests"`
-	PassedTests int       `json:"passed_tests"`
-	FailedTests int       `json:"failed_tests"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            uuid.UUID    `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID     uuid.UUID    `gorm:"type:uuid;index:idx_test_runs_company_id,priority:1" json:"company_id"`
+	Company       *Company     `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
+	TestSuiteIDs  []uuid.UUID  `gorm:"type:uuid[]" json:"test_suite_ids"`
+	EnvironmentID *uuid.UUID   `gorm:"type:uuid" json:"environment_id"`
+	Environment   *Environment `gorm:"foreignKey:EnvironmentID;constraint:OnDelete:SET NULL" json:"environment,omitempty"`
+	ScheduleID    *uuid.UUID   `gorm:"type:uuid" json:"schedule_id"`
+	Schedule      *Schedule    `gorm:"foreignKey:ScheduleID;constraint:OnDelete:SET NULL" json:"schedule,omitempty"`
+	StartedAt     time.Time    `json:"started_at"`
+	FinishedAt    time.Time    `json:"finished_at"`
+	Status        string       `gorm:"index:idx_test_runs_status_created_at,priority:1" json:"status"`
+	TotalTests    int          `gorm:"type:integer" json:"total_tests"`
+	PassedTests   int          `gorm:"type:integer" json:"passed_tests"`
+	FailedTests   int          `gorm:"type:integer" json:"failed_tests"`
+	SkippedTests  int          `gorm:"type:integer;not null;default:0" json:"skipped_tests"`
+	HeartbeatAt   *time.Time   `gorm:"index:idx_test_runs_running_heartbeat_at,where:status = 'running'" json:"heartbeat_at"`
+	CreatedAt     time.Time    `gorm:"index:idx_test_runs_status_created_at,priority:2;index:idx_test_runs_company_id,priority:2,sort:desc" json:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at"`
 }
@@ -7,15 +7,24 @@ import (
 )
 
 type TestSuite struct {
-	ID             uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
-	CompanyID      uuid.UUID `gorm:"type:uuid" json:"company_id"`
-	Company        *Company  `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
-	Name           string    `json:"name"`
-	Method         string    `json:"method"`
-	URL            string    `json:"url"`
-	Headers        string    `json:"headers"`
-	ExpectedStatus int       `json:"expected_status"`
-	ExpectedBody   string    `json:"expected_body"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID                 uuid.UUID   `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID          uuid.UUID   `gorm:"type:uuid;uniqueIndex:idx_test_suites_company_import_key,priority:1,where:import_key IS NOT NULL" json:"company_id"`
+	Company            *Company    `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
+	Name               string      `json:"name"`
+	Method             string      `json:"method"`
+	URL                string      `json:"url"`
+	Headers            string      `gorm:"type:jsonb;not null;default:'{}'" json:"headers"`
+	QueryParams        string      `gorm:"type:jsonb;not null;default:'{}'" json:"query_params"`
+	BodyType           string      `gorm:"not null;default:'none'" json:"body_type"`
+	Body               string      `gorm:"not null;default:''" json:"body"`
+	FormFields         string      `gorm:"type:jsonb;not null;default:'{}'" json:"form_fields"`
+	ExpectedStatus     int         `gorm:"type:integer" json:"expected_status"`
+	ExpectedBody       string      `json:"expected_body"`
+	ResponseSchemaJSON *string     `gorm:"column:response_schema;type:jsonb" json:"response_schema"`
+	ResponseSchemaID   *uuid.UUID  `gorm:"type:uuid" json:"response_schema_id"`
+	ResponseSchema     *JSONSchema `gorm:"foreignKey:ResponseSchemaID;constraint:OnDelete:RESTRICT" json:"-"`
+	DependsOn          []uuid.UUID `gorm:"type:uuid[];not null;default:'{}'" json:"depends_on"`
+	ImportKey          *string     `gorm:"uniqueIndex:idx_test_suites_company_import_key,priority:2,where:import_key IS NOT NULL" json:"import_key"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
 }
@@ -0,0 +1,19 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TestSuiteAssertion struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	TestSuiteID uuid.UUID  `gorm:"type:uuid;not null;index:idx_test_suite_assertions_test_suite_id,priority:1" json:"test_suite_id"`
+	TestSuite   *TestSuite `gorm:"foreignKey:TestSuiteID;constraint:OnDelete:CASCADE" json:"test_suite,omitempty"`
+	Type        string     `gorm:"not null" json:"type"`
+	Target      string     `gorm:"not null;default:''" json:"target"`
+	Expected    string     `gorm:"not null;default:''" json:"expected"`
+	Position    int        `gorm:"type:integer;not null;default:0;index:idx_test_suite_assertions_test_suite_id,priority:2" json:"position"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
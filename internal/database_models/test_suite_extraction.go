@@ -0,0 +1,19 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TestSuiteExtraction struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	TestSuiteID uuid.UUID  `gorm:"type:uuid;not null;index:idx_test_suite_extractions_test_suite_id,priority:1" json:"test_suite_id"`
+	TestSuite   *TestSuite `gorm:"foreignKey:TestSuiteID;constraint:OnDelete:CASCADE" json:"test_suite,omitempty"`
+	Name        string     `gorm:"not null" json:"name"`
+	Source      string     `gorm:"not null" json:"source"`
+	Expression  string     `gorm:"not null" json:"expression"`
+	Position    int        `gorm:"type:integer;not null;default:0;index:idx_test_suite_extractions_test_suite_id,priority:2" json:"position"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
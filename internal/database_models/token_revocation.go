@@ -0,0 +1,17 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenRevocation struct {
+	ID        uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null" json:"user_id"`
+	User      *User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"user,omitempty"`
+	TokenID   *string    `json:"token_id"`
+	SessionID *uuid.UUID `gorm:"type:uuid" json:"session_id"`
+	ExpiresAt time.Time  `gorm:"not null;index:idx_token_revocations_expires_at" json:"expires_at"`
+	CreatedAt time.Time  `gorm:"not null;default:now();index:idx_token_revocations_created_at" json:"created_at"`
+}
@@ -7,13 +7,14 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
-	Username  string    `gorm:"unique" json:"username"`
-	Email     string    `gorm:"unique" json:"email"`
-	Password  string    `json:"-"`
-	Name      string    `json:"name"`
-	CompanyID uuid.UUID `gorm:"type:uuid" json:"company_id"`
-	Company   *Company  `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	Username    string    `gorm:"unique" json:"username"`
+	Email       string    `gorm:"unique" json:"email"`
+	Password    string    `json:"-"`
+	Name        string    `json:"name"`
+	CompanyID   uuid.UUID `gorm:"type:uuid" json:"company_id"`
+	Company     *Company  `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
+	EmailAlerts bool      `gorm:"not null;default:true" json:"email_alerts"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
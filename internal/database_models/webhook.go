@@ -0,0 +1,33 @@
+package database_models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Webhook struct {
+	ID              uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	CompanyID       uuid.UUID `gorm:"type:uuid;not null;index:idx_webhooks_company_id" json:"company_id"`
+	Company         *Company  `gorm:"foreignKey:CompanyID;constraint:OnDelete:CASCADE" json:"company,omitempty"`
+	URL             string    `gorm:"not null" json:"url"`
+	Events          []string  `gorm:"type:text[];not null;default:'{}'" json:"events"`
+	Enabled         bool      `gorm:"not null;default:true" json:"enabled"`
+	EncryptedSecret []byte    `gorm:"type:bytea;not null" json:"-"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID  `gorm:"primaryKey;type:uuid" json:"id"`
+	WebhookID     uuid.UUID  `gorm:"type:uuid;not null;index:idx_webhook_deliveries_webhook_id_created_at,priority:1" json:"webhook_id"`
+	Webhook       *Webhook   `gorm:"foreignKey:WebhookID;constraint:OnDelete:CASCADE" json:"webhook,omitempty"`
+	Event         string     `gorm:"not null" json:"event"`
+	Payload       string     `gorm:"type:jsonb;not null" json:"payload"`
+	Status        string     `gorm:"not null" json:"status"`
+	Attempts      string     `gorm:"type:jsonb;not null;default:'[]'" json:"attempts"`
+	NextAttemptAt *time.Time `gorm:"index:idx_webhook_deliveries_next_attempt_at,where:status = 'pending'" json:"next_attempt_at"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+	CreatedAt     time.Time  `gorm:"index:idx_webhook_deliveries_webhook_id_created_at,priority:2" json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
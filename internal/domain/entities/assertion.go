@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// AssertionType representa o tipo de verificação aplicada à resposta
+type AssertionType string
+
+const (
+	AssertionJSONPathEquals    AssertionType = "json_path_equals"
+	AssertionJSONPathContains  AssertionType = "json_path_contains"
+	AssertionJSONPathExists    AssertionType = "json_path_exists"
+	AssertionBodyRegex         AssertionType = "body_regex"
+	AssertionHeaderEquals      AssertionType = "header_equals"
+	AssertionHeaderPresent     AssertionType = "header_present"
+	AssertionResponseTimeUnder AssertionType = "response_time_under"
+	AssertionBodySizeMax       AssertionType = "body_size_max"
+	AssertionBodySizeMin       AssertionType = "body_size_min"
+	AssertionContentType       AssertionType = "content_type"
+)
+
+// Assertion representa uma verificação tipada sobre a resposta de uma suíte de teste.
+// Target é a expressão JSONPath ou o nome do header; Expected é o valor, regex ou limite esperado.
+type Assertion struct {
+	ID          uuid.UUID     `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TestSuiteID uuid.UUID     `json:"test_suite_id" db:"test_suite_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Type        AssertionType `json:"type" db:"type" example:"json_path_equals" enums:"json_path_equals,json_path_contains,json_path_exists,body_regex,header_equals,header_present,response_time_under,body_size_max,body_size_min,content_type"`
+	Target      string        `json:"target,omitempty" db:"target" example:"$.user.id"`
+	Expected    string        `json:"expected,omitempty" db:"expected" example:"42"`
+	Position    int           `json:"position" db:"position" example:"0"`
+	CreatedAt   time.Time     `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt   time.Time     `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewAssertion cria uma nova instância de Assertion
+func NewAssertion(testSuiteID uuid.UUID, assertionType AssertionType, target, expected string, position int) *Assertion {
+	return &Assertion{
+		ID:          uuid.New(),
+		TestSuiteID: testSuiteID,
+		Type:        assertionType,
+		Target:      strings.TrimSpace(target),
+		Expected:    expected,
+		Position:    position,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validate verifica se os campos exigidos pelo tipo da asserção estão presentes e bem formados.
+// Campos com {{variável}} só têm o formato verificado após a substituição, na execução.
+func (a *Assertion) Validate() error {
+	templatedTarget := value_objects.HasTemplateVariables(a.Target)
+	templatedExpected := value_objects.HasTemplateVariables(a.Expected)
+
+	switch a.Type {
+	case AssertionJSONPathEquals, AssertionJSONPathContains:
+		if err := a.validateJSONPath(templatedTarget); err != nil {
+			return err
+		}
+		if a.Expected == "" {
+			return fmt.Errorf("expected value is required")
+		}
+	case AssertionJSONPathExists:
+		if err := a.validateJSONPath(templatedTarget); err != nil {
+			return err
+		}
+	case AssertionBodyRegex:
+		if a.Expected == "" {
+			return fmt.Errorf("regular expression is required")
+		}
+		if templatedExpected {
+			break
+		}
+		if _, err := regexp.Compile(a.Expected); err != nil {
+			return fmt.Errorf("invalid regular expression: %w", err)
+		}
+	case AssertionHeaderEquals, AssertionHeaderPresent:
+		if a.Target == "" {
+			return fmt.Errorf("header name is required")
+		}
+	case AssertionResponseTimeUnder, AssertionBodySizeMax, AssertionBodySizeMin:
+		if templatedExpected {
+			break
+		}
+		if _, err := a.Limit(); err != nil {
+			return err
+		}
+	case AssertionContentType:
+		if strings.TrimSpace(a.Expected) == "" {
+			return fmt.Errorf("expected content type is required")
+		}
+	default:
+		return fmt.Errorf("unknown assertion type %q", a.Type)
+	}
+	return nil
+}
+
+// validateJSONPath verifica a expressão JSONPath do alvo, exceto quando ela depende de variáveis
+func (a *Assertion) validateJSONPath(templated bool) error {
+	if templated {
+		return nil
+	}
+	_, err := value_objects.NewJSONPath(a.Target)
+	return err
+}
+
+// Limit retorna o limite numérico das asserções de tempo (ms) e tamanho (bytes)
+func (a *Assertion) Limit() (int64, error) {
+	limit, err := strconv.ParseInt(strings.TrimSpace(a.Expected), 10, 64)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("expected must be a non-negative integer")
+	}
+	return limit, nil
+}
+
+// AssertionResult representa o resultado individual de uma asserção em uma execução
+type AssertionResult struct {
+	AssertionID uuid.UUID     `json:"assertion_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type        AssertionType `json:"type" example:"json_path_equals"`
+	Target      string        `json:"target,omitempty" example:"$.user.id"`
+	Expected    string        `json:"expected,omitempty" example:"42"`
+	Actual      string        `json:"actual,omitempty" example:"41"`
+	Passed      bool          `json:"passed" example:"false"`
+	Message     string        `json:"message,omitempty" example:"expected 42, got 41"`
+}
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// ChatProvider representa o formato de mensagem aceito pelo webhook de entrada do chat
+type ChatProvider string
+
+const (
+	// Slack: mensagens em Block Kit
+	ChatProviderSlack ChatProvider = "slack"
+	// Microsoft Teams: mensagens com Adaptive Card
+	ChatProviderTeams ChatProvider = "teams"
+)
+
+// IsValid verifica se o provedor é suportado
+func (p ChatProvider) IsValid() bool {
+	return p == ChatProviderSlack || p == ChatProviderTeams
+}
+
+// ChatChannel representa um canal de chat (Slack ou Teams) que recebe o resumo das execuções
+// encerradas por um webhook de entrada. A URL do webhook dá acesso ao canal, por isso é
+// gravada cifrada e nunca é devolvida pela API.
+type ChatChannel struct {
+	ID           uuid.UUID    `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID    uuid.UUID    `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name         string       `json:"name" db:"name" example:"#qa-alerts"`
+	Provider     ChatProvider `json:"provider" db:"provider" example:"slack" enums:"slack,teams"`
+	EncryptedURL []byte       `json:"-" db:"encrypted_url"`
+	OnlyFailures bool         `json:"only_failures" db:"only_failures" example:"true"`
+	Enabled      bool         `json:"enabled" db:"enabled" example:"true"`
+	CreatedAt    time.Time    `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt    time.Time    `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewChatChannel cria uma nova instância de ChatChannel.
+// A URL cifrada é definida com SetEncryptedURL.
+func NewChatChannel(companyID uuid.UUID, name string, provider ChatProvider, onlyFailures, enabled bool) *ChatChannel {
+	return &ChatChannel{
+		ID:           uuid.New(),
+		CompanyID:    companyID,
+		Name:         name,
+		Provider:     provider,
+		OnlyFailures: onlyFailures,
+		Enabled:      enabled,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// UpdateChatChannel atualiza os campos informados; valores vazios ou nil mantêm os atuais
+func (c *ChatChannel) UpdateChatChannel(name string, onlyFailures, enabled *bool) {
+	if name != "" {
+		c.Name = name
+	}
+	if onlyFailures != nil {
+		c.OnlyFailures = *onlyFailures
+	}
+	if enabled != nil {
+		c.Enabled = *enabled
+	}
+	c.UpdatedAt = time.Now()
+}
+
+// SetEncryptedURL substitui a URL cifrada do webhook de entrada
+func (c *ChatChannel) SetEncryptedURL(encrypted []byte) {
+	c.EncryptedURL = encrypted
+	c.UpdatedAt = time.Now()
+}
+
+// Validate verifica os dados do canal
+func (c *ChatChannel) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("%w chat channel: name is required", domainErrors.ErrInvalid)
+	}
+	if !c.Provider.IsValid() {
+		return fmt.Errorf("%w chat channel: unsupported provider %q", domainErrors.ErrInvalid, c.Provider)
+	}
+	return nil
+}
+
+// Accepts indica se o canal recebe o resumo da execução
+func (c *ChatChannel) Accepts(testRun *TestRun) bool {
+	if !c.Enabled {
+		return false
+	}
+	return !c.OnlyFailures || testRun.Status != TestRunStatusPassed
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CompanyRole representa o papel de um membro dentro da empresa
+type CompanyRole string
+
+const (
+	// Controle total, incluindo a exclusão da empresa e a gestão de outros owners
+	CompanyRoleOwner CompanyRole = "owner"
+	// Administra a empresa, os membros, os segredos e as integrações
+	CompanyRoleAdmin CompanyRole = "admin"
+	// Edita suítes, ambientes e agendamentos e dispara execuções
+	CompanyRoleEditor CompanyRole = "editor"
+	// Somente leitura
+	CompanyRoleViewer CompanyRole = "viewer"
+)
+
+// CompanyRoles lista os papéis válidos, do maior para o menor privilégio
+var CompanyRoles = []CompanyRole{
+	CompanyRoleOwner,
+	CompanyRoleAdmin,
+	CompanyRoleEditor,
+	CompanyRoleViewer,
+}
+
+// IsValid indica se o papel é um dos papéis conhecidos
+func (r CompanyRole) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Can indica se o papel concede a permissão, segundo a matriz rolePermissions
+func (r CompanyRole) Can(permission Permission) bool {
+	return rolePermissions[r][permission]
+}
+
+// CompanyMember representa o vínculo de um usuário com uma empresa e o papel que ele exerce nela
+type CompanyMember struct {
+	CompanyID uuid.UUID   `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	UserID    uuid.UUID   `json:"user_id" db:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Role      CompanyRole `json:"role" db:"role" example:"editor"`
+	CreatedAt time.Time   `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt time.Time   `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewCompanyMember cria o vínculo do usuário com a empresa no papel informado
+func NewCompanyMember(companyID, userID uuid.UUID, role CompanyRole) *CompanyMember {
+	now := time.Now()
+	return &CompanyMember{
+		CompanyID: companyID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Can indica se o papel do membro concede a permissão
+func (m *CompanyMember) Can(permission Permission) bool {
+	return m.Role.Can(permission)
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// Environment representa um ambiente da empresa (ex.: dev, staging, prod) com as variáveis
+// substituídas em {{variável}} nas suítes de teste durante a execução
+type Environment struct {
+	ID        uuid.UUID         `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID uuid.UUID         `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name      string            `json:"name" db:"name" example:"staging"`
+	Variables map[string]string `json:"variables" db:"variables"`
+	CreatedAt time.Time         `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt time.Time         `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewEnvironment cria uma nova instância de Environment
+func NewEnvironment(companyID uuid.UUID, name string, variables map[string]string) *Environment {
+	if variables == nil {
+		variables = map[string]string{}
+	}
+	return &Environment{
+		ID:        uuid.New(),
+		CompanyID: companyID,
+		Name:      name,
+		Variables: variables,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// UpdateEnvironment atualiza o nome e, se informadas, substitui as variáveis
+func (e *Environment) UpdateEnvironment(name string, variables map[string]string) {
+	if name != "" {
+		e.Name = name
+	}
+	if variables != nil {
+		e.Variables = variables
+	}
+	e.UpdatedAt = time.Now()
+}
+
+// ValidateVariables verifica se os nomes das variáveis podem ser usados em {{variável}}
+func (e *Environment) ValidateVariables() error {
+	for name := range e.Variables {
+		if !value_objects.IsValidVariableName(name) {
+			return fmt.Errorf("%w variable name %q", domainErrors.ErrInvalid, name)
+		}
+		// Referências secret.NOME e extracted.NOME são resolvidas na execução
+		for _, prefix := range []string{SecretVariablePrefix, ExtractedVariablePrefix} {
+			if strings.HasPrefix(name, prefix) {
+				return fmt.Errorf("%w variable name %q: prefix %q is reserved", domainErrors.ErrInvalid, name, prefix)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// ExtractedVariablePrefix é o prefixo das referências a valores extraídos ({{extracted.NOME}})
+const ExtractedVariablePrefix = "extracted."
+
+// ExtractionSource representa de onde o valor é extraído na resposta
+type ExtractionSource string
+
+const (
+	ExtractionJSONPath ExtractionSource = "json_path"
+	ExtractionHeader   ExtractionSource = "header"
+	ExtractionRegex    ExtractionSource = "regex"
+	ExtractionCookie   ExtractionSource = "cookie"
+)
+
+// Extraction representa uma regra que salva um valor da resposta de uma suíte em uma variável
+// da execução, disponível para as suítes seguintes como {{extracted.NOME}}.
+// Expression é a expressão JSONPath, o nome do header, a regex (primeiro grupo) ou o nome do cookie.
+type Extraction struct {
+	ID          uuid.UUID        `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TestSuiteID uuid.UUID        `json:"test_suite_id" db:"test_suite_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name        string           `json:"name" db:"name" example:"token"`
+	Source      ExtractionSource `json:"source" db:"source" example:"json_path" enums:"json_path,header,regex,cookie"`
+	Expression  string           `json:"expression" db:"expression" example:"$.access_token"`
+	Position    int              `json:"position" db:"position" example:"0"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt   time.Time        `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewExtraction cria uma nova instância de Extraction
+func NewExtraction(testSuiteID uuid.UUID, name string, source ExtractionSource, expression string, position int) *Extraction {
+	return &Extraction{
+		ID:          uuid.New(),
+		TestSuiteID: testSuiteID,
+		Name:        strings.TrimSpace(name),
+		Source:      source,
+		Expression:  strings.TrimSpace(expression),
+		Position:    position,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Validate verifica o nome da variável e a expressão conforme a origem
+func (e *Extraction) Validate() error {
+	if !value_objects.IsValidVariableName(e.Name) {
+		return fmt.Errorf("invalid variable name %q", e.Name)
+	}
+	if e.Expression == "" {
+		return fmt.Errorf("expression is required")
+	}
+
+	switch e.Source {
+	case ExtractionJSONPath:
+		if _, err := value_objects.NewJSONPath(e.Expression); err != nil {
+			return err
+		}
+	case ExtractionRegex:
+		if _, err := regexp.Compile(e.Expression); err != nil {
+			return fmt.Errorf("invalid regular expression: %w", err)
+		}
+	case ExtractionHeader, ExtractionCookie:
+	default:
+		return fmt.Errorf("unknown extraction source %q", e.Source)
+	}
+	return nil
+}
+
+// VariableName retorna o nome usado para referenciar o valor extraído nas suítes
+func (e *Extraction) VariableName() string {
+	return ExtractedVariablePrefix + e.Name
+}
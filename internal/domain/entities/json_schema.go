@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JSONSchema representa um JSON Schema (draft 2020-12) armazenado pela empresa
+// para ser referenciado por várias suítes de teste
+type JSONSchema struct {
+	ID          uuid.UUID       `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID   uuid.UUID       `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name        string          `json:"name" db:"name" example:"User response"`
+	Description string          `json:"description" db:"description" example:"Schema da resposta de GET /users/{id}"`
+	Schema      json.RawMessage `json:"schema" db:"schema" swaggertype:"object"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewJSONSchema cria uma nova instância de JSONSchema
+func NewJSONSchema(companyID uuid.UUID, name, description string, schema json.RawMessage) *JSONSchema {
+	return &JSONSchema{
+		ID:          uuid.New(),
+		CompanyID:   companyID,
+		Name:        name,
+		Description: description,
+		Schema:      schema,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// UpdateJSONSchema atualiza os dados do schema
+func (s *JSONSchema) UpdateJSONSchema(name, description string, schema json.RawMessage) {
+	if name != "" {
+		s.Name = name
+	}
+	if description != "" {
+		s.Description = description
+	}
+	if len(schema) > 0 {
+		s.Schema = schema
+	}
+	s.UpdatedAt = time.Now()
+}
+
+// SchemaViolation representa uma violação do JSON Schema encontrada no corpo da resposta
+type SchemaViolation struct {
+	InstancePath string `json:"instance_path" example:"/user/id"`
+	KeywordPath  string `json:"keyword_path,omitempty" example:"/properties/user/properties/id/type"`
+	Message      string `json:"message" example:"got string, want integer"`
+}
@@ -0,0 +1,85 @@
+package entities
+
+// Permission representa uma ação sobre os recursos de uma empresa
+type Permission string
+
+const (
+	PermissionCompanyRead   Permission = "company:read"
+	PermissionCompanyUpdate Permission = "company:update"
+	PermissionCompanyDelete Permission = "company:delete"
+
+	PermissionMembersRead   Permission = "members:read"
+	PermissionMembersManage Permission = "members:manage"
+
+	// Suítes de teste, JSON Schemas, importações e pacotes de suítes
+	PermissionSuitesRead  Permission = "suites:read"
+	PermissionSuitesWrite Permission = "suites:write"
+
+	// Execuções, resultados e relatórios
+	PermissionRunsRead    Permission = "runs:read"
+	PermissionRunsExecute Permission = "runs:execute"
+
+	PermissionEnvironmentsRead  Permission = "environments:read"
+	PermissionEnvironmentsWrite Permission = "environments:write"
+
+	// Os valores dos segredos nunca são devolvidos, apenas os metadados
+	PermissionSecretsRead  Permission = "secrets:read"
+	PermissionSecretsWrite Permission = "secrets:write"
+
+	PermissionSchedulesRead  Permission = "schedules:read"
+	PermissionSchedulesWrite Permission = "schedules:write"
+
+	// Webhooks e canais de chat
+	PermissionIntegrationsRead  Permission = "integrations:read"
+	PermissionIntegrationsWrite Permission = "integrations:write"
+)
+
+// viewerPermissions são as permissões de leitura, concedidas a todos os membros
+var viewerPermissions = []Permission{
+	PermissionCompanyRead,
+	PermissionMembersRead,
+	PermissionSuitesRead,
+	PermissionRunsRead,
+	PermissionEnvironmentsRead,
+	PermissionSecretsRead,
+	PermissionSchedulesRead,
+	PermissionIntegrationsRead,
+}
+
+// editorPermissions são as permissões de quem mantém os testes da empresa
+var editorPermissions = append(viewerPermissions[:len(viewerPermissions):len(viewerPermissions)],
+	PermissionSuitesWrite,
+	PermissionRunsExecute,
+	PermissionEnvironmentsWrite,
+	PermissionSchedulesWrite,
+)
+
+// adminPermissions são as permissões de quem administra a empresa, exceto excluí-la
+var adminPermissions = append(editorPermissions[:len(editorPermissions):len(editorPermissions)],
+	PermissionCompanyUpdate,
+	PermissionMembersManage,
+	PermissionSecretsWrite,
+	PermissionIntegrationsWrite,
+)
+
+// ownerPermissions são todas as permissões; só o owner exclui a empresa
+var ownerPermissions = append(adminPermissions[:len(adminPermissions):len(adminPermissions)],
+	PermissionCompanyDelete,
+)
+
+// rolePermissions é a matriz de permissões de cada papel
+var rolePermissions = map[CompanyRole]map[Permission]bool{
+	CompanyRoleOwner:  permissionSet(ownerPermissions),
+	CompanyRoleAdmin:  permissionSet(adminPermissions),
+	CompanyRoleEditor: permissionSet(editorPermissions),
+	CompanyRoleViewer: permissionSet(viewerPermissions),
+}
+
+// permissionSet converte a lista de permissões em um conjunto
+func permissionSet(permissions []Permission) map[Permission]bool {
+	set := make(map[Permission]bool, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = true
+	}
+	return set
+}
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken representa um refresh token emitido para o usuário. Apenas o hash do token é gravado.
+// Os tokens gerados a partir de um mesmo login formam uma família: cada uso emite o próximo token
+// da família, e o reuso de um token já usado indica vazamento e revoga a família inteira.
+type RefreshToken struct {
+	ID        uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	FamilyID  uuid.UUID  `json:"family_id" db:"family_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at" example:"2023-01-08T00:00:00Z"`
+	UsedAt    *time.Time `json:"used_at,omitempty" db:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewRefreshToken cria um refresh token da família informada
+func NewRefreshToken(userID, familyID uuid.UUID, tokenHash string, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsExpired indica se o token passou da validade
+func (t *RefreshToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// IsUsed indica se o token já foi trocado por um novo par de tokens
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsRevoked indica se a família do token foi revogada
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
@@ -0,0 +1,12 @@
+package entities
+
+// ResourceType identifica os recursos que pertencem a uma empresa e são acessados pelo próprio ID
+type ResourceType string
+
+const (
+	ResourceTestSuite   ResourceType = "test_suite"
+	ResourceTestRun     ResourceType = "test_run"
+	ResourceJSONSchema  ResourceType = "json_schema"
+	ResourceWebhook     ResourceType = "webhook"
+	ResourceChatChannel ResourceType = "chat_channel"
+)
@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"time"
+
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// DefaultScheduleTimezone é o fuso usado quando o agendamento não informa um
+const DefaultScheduleTimezone = "UTC"
+
+// Schedule representa um agendamento que enfileira execuções da empresa segundo uma expressão cron
+type Schedule struct {
+	ID             uuid.UUID   `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID      uuid.UUID   `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name           string      `json:"name" db:"name" example:"Monitoramento de produção"`
+	CronExpression string      `json:"cron_expression" db:"cron_expression" example:"*/5 * * * *"`
+	Timezone       string      `json:"timezone" db:"timezone" example:"America/Sao_Paulo"`
+	TestSuiteIDs   []uuid.UUID `json:"test_suite_ids,omitempty" db:"test_suite_ids"`
+	EnvironmentID  *uuid.UUID  `json:"environment_id,omitempty" db:"environment_id"`
+	Enabled        bool        `json:"enabled" db:"enabled" example:"true"`
+	NextRunAt      *time.Time  `json:"next_run_at,omitempty" db:"next_run_at"`
+	LastRunAt      *time.Time  `json:"last_run_at,omitempty" db:"last_run_at"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt      time.Time   `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewSchedule cria uma nova instância de Schedule
+func NewSchedule(companyID uuid.UUID, name, cronExpression, timezone string, testSuiteIDs []uuid.UUID, environmentID *uuid.UUID, enabled bool) *Schedule {
+	if timezone == "" {
+		timezone = DefaultScheduleTimezone
+	}
+	if len(testSuiteIDs) == 0 {
+		testSuiteIDs = nil
+	}
+	return &Schedule{
+		ID:             uuid.New(),
+		CompanyID:      companyID,
+		Name:           name,
+		CronExpression: cronExpression,
+		Timezone:       timezone,
+		TestSuiteIDs:   testSuiteIDs,
+		EnvironmentID:  environmentID,
+		Enabled:        enabled,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// Cron retorna a expressão cron do agendamento validada no seu fuso horário
+func (s *Schedule) Cron() (*value_objects.CronExpression, error) {
+	return value_objects.NewCronExpression(s.CronExpression, s.Timezone)
+}
+
+// ScheduleNext recalcula o próximo disparo a partir de now; agendamentos desativados não têm próximo disparo.
+// Os horários são gravados em UTC, pois as colunas timestamp não guardam o fuso.
+func (s *Schedule) ScheduleNext(now time.Time) error {
+	s.UpdatedAt = time.Now()
+	if !s.Enabled {
+		s.NextRunAt = nil
+		return nil
+	}
+
+	expr, err := s.Cron()
+	if err != nil {
+		return err
+	}
+	next := expr.Next(now).UTC()
+	s.NextRunAt = &next
+	return nil
+}
+
+// NewTestRun cria a execução disparada pelo agendamento e avança o próximo disparo.
+// Disparos perdidos enquanto a aplicação estava parada não são repetidos.
+func (s *Schedule) NewTestRun(now time.Time) (*TestRun, error) {
+	testRun := NewTestRun(s.CompanyID, s.TestSuiteIDs, s.EnvironmentID)
+	testRun.ScheduleID = &s.ID
+
+	lastRunAt := now.UTC()
+	s.LastRunAt = &lastRunAt
+	if err := s.ScheduleNext(now); err != nil {
+		return nil, err
+	}
+	return testRun, nil
+}
+
+// UpdateSchedule atualiza os campos informados; valores vazios ou nil mantêm os atuais.
+// Uma lista de suítes vazia (não nil) passa a executar todas as suítes da empresa.
+func (s *Schedule) UpdateSchedule(name, cronExpression, timezone string, testSuiteIDs []uuid.UUID, enabled *bool) {
+	if name != "" {
+		s.Name = name
+	}
+	if cronExpression != "" {
+		s.CronExpression = cronExpression
+	}
+	if timezone != "" {
+		s.Timezone = timezone
+	}
+	if testSuiteIDs != nil {
+		s.TestSuiteIDs = testSuiteIDs
+		if len(testSuiteIDs) == 0 {
+			s.TestSuiteIDs = nil
+		}
+	}
+	if enabled != nil {
+		s.Enabled = *enabled
+	}
+	s.UpdatedAt = time.Now()
+}
+
+// SetEnvironmentID define o ambiente usado nas execuções; uuid.Nil remove o ambiente
+func (s *Schedule) SetEnvironmentID(environmentID *uuid.UUID) {
+	if environmentID != nil && *environmentID == uuid.Nil {
+		environmentID = nil
+	}
+	s.EnvironmentID = environmentID
+	s.UpdatedAt = time.Now()
+}
@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// SecretVariablePrefix é o prefixo das referências a segredos nas suítes ({{secret.NOME}})
+const SecretVariablePrefix = "secret."
+
+// Secret representa um segredo da empresa (chave de API, senha) usado nas suítes de teste.
+// O valor é gravado cifrado e nunca é devolvido pela API.
+type Secret struct {
+	ID             uuid.UUID `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID      uuid.UUID `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name           string    `json:"name" db:"name" example:"API_KEY"`
+	EncryptedValue []byte    `json:"-" db:"encrypted_value"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt      time.Time `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewSecret cria uma nova instância de Secret; o valor cifrado é definido com SetEncryptedValue
+func NewSecret(companyID uuid.UUID, name string) *Secret {
+	return &Secret{
+		ID:        uuid.New(),
+		CompanyID: companyID,
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Rename altera o nome do segredo
+func (s *Secret) Rename(name string) {
+	if name != "" {
+		s.Name = name
+	}
+	s.UpdatedAt = time.Now()
+}
+
+// SetEncryptedValue substitui o valor cifrado do segredo
+func (s *Secret) SetEncryptedValue(encrypted []byte) {
+	s.EncryptedValue = encrypted
+	s.UpdatedAt = time.Now()
+}
+
+// ValidateName verifica se o nome pode ser referenciado em {{secret.NOME}}
+func (s *Secret) ValidateName() error {
+	if !value_objects.IsValidVariableName(s.Name) {
+		return fmt.Errorf("%w secret name %q", domainErrors.ErrInvalid, s.Name)
+	}
+	return nil
+}
+
+// VariableName retorna o nome usado para referenciar o segredo nas suítes
+func (s *Secret) VariableName() string {
+	return SecretVariablePrefix + s.Name
+}
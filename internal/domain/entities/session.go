@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session representa um login do usuário em um dispositivo. O ID da sessão é o da família de
+// refresh tokens emitida no login; cada renovação dos tokens atualiza o último uso e a validade.
+type Session struct {
+	ID         uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID     uuid.UUID  `json:"user_id" db:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	UserAgent  string     `json:"user_agent" db:"user_agent" example:"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)"`
+	IPAddress  string     `json:"ip_address" db:"ip_address" example:"203.0.113.10"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	LastUsedAt time.Time  `json:"last_used_at" db:"last_used_at" example:"2023-01-01T12:00:00Z"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at" example:"2023-01-08T12:00:00Z"`
+	RevokedAt  *time.Time `json:"-" db:"revoked_at"`
+}
+
+// NewSession cria a sessão da família de refresh tokens informada
+func NewSession(id, userID uuid.UUID, userAgent, ipAddress string, expiresAt time.Time) *Session {
+	now := time.Now()
+	return &Session{
+		ID:         id,
+		UserID:     userID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// IsActive indica se a sessão não foi encerrada e o último refresh token ainda é válido
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && time.Now().Before(s.ExpiresAt)
+}
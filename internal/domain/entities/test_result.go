@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TestResultStatus representa o resultado de uma suíte dentro de uma execução
+type TestResultStatus string
+
+const (
+	TestResultStatusPassed  TestResultStatus = "passed"
+	TestResultStatusFailed  TestResultStatus = "failed"
+	TestResultStatusErrored TestResultStatus = "errored"
+	// Suíte não executada porque uma dependência não passou
+	TestResultStatusSkipped TestResultStatus = "skipped"
+)
+
+// TestResult representa o resultado de uma suíte de teste em uma execução
+type TestResult struct {
+	ID                    uuid.UUID           `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TestRunID             uuid.UUID           `json:"test_run_id" db:"test_run_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TestSuiteID           uuid.UUID           `json:"test_suite_id" db:"endpoint_test_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	TestSuiteName         string              `json:"test_suite_name" db:"test_suite_name" example:"API Login Test"`
+	Request               *ExecutedRequest    `json:"request,omitempty" db:"request"`
+	Status                TestResultStatus    `json:"status" db:"status" example:"passed" enums:"passed,failed,errored,skipped"`
+	ResponseStatus        int                 `json:"response_status" db:"response_status" example:"200"`
+	ResponseHeaders       map[string][]string `json:"response_headers" db:"response_headers"`
+	ResponseBody          string              `json:"response_body" db:"response_body" example:"{\"success\": true}"`
+	ResponseBodyTruncated bool                `json:"response_body_truncated" db:"response_body_truncated" example:"false"`
+	ResponseTimeMS        int                 `json:"response_time_ms" db:"response_time_ms" example:"120"`
+	ErrorMessage          string              `json:"error_message" db:"error_message" example:""`
+	AssertionResults      []AssertionResult   `json:"assertion_results" db:"assertion_results"`
+	SchemaViolations      []SchemaViolation   `json:"schema_violations" db:"schema_violations"`
+	CreatedAt             time.Time           `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt             time.Time           `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// ExecutedRequest é a requisição enviada pela suíte na execução, com as variáveis já substituídas
+// e os valores de segredos ocultados; não muda se a suíte for editada depois
+type ExecutedRequest struct {
+	Method      string            `json:"method" example:"POST"`
+	URL         string            `json:"url" example:"https://api.example.com/login"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	QueryParams map[string]string `json:"query_params,omitempty"`
+	Body        string            `json:"body,omitempty" example:"{\"username\": \"admin\"}"`
+	FormFields  map[string]string `json:"form_fields,omitempty"`
+}
+
+// NewTestResult cria uma nova instância de TestResult
+func NewTestResult(testRunID, testSuiteID uuid.UUID, status TestResultStatus, responseStatus int, responseBody string, responseTimeMS int, errorMessage string) *TestResult {
+	return &TestResult{
+		ID:               uuid.New(),
+		TestRunID:        testRunID,
+		TestSuiteID:      testSuiteID,
+		Status:           status,
+		ResponseStatus:   responseStatus,
+		ResponseBody:     responseBody,
+		ResponseTimeMS:   responseTimeMS,
+		ErrorMessage:     errorMessage,
+		ResponseHeaders:  map[string][]string{},
+		AssertionResults: []AssertionResult{},
+		SchemaViolations: []SchemaViolation{},
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
+
+// Passed indica se a suíte passou na execução
+func (r *TestResult) Passed() bool {
+	return r.Status == TestResultStatusPassed
+}
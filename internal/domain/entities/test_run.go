@@ -0,0 +1,162 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TestRunStatus representa o estado de uma execução de testes
+type TestRunStatus string
+
+const (
+	TestRunStatusQueued    TestRunStatus = "queued"
+	TestRunStatusRunning   TestRunStatus = "running"
+	TestRunStatusPassed    TestRunStatus = "passed"
+	TestRunStatusFailed    TestRunStatus = "failed"
+	TestRunStatusErrored   TestRunStatus = "errored"
+	TestRunStatusCancelled TestRunStatus = "cancelled"
+)
+
+// testRunTransitions define as transições de estado permitidas
+var testRunTransitions = map[TestRunStatus][]TestRunStatus{
+	TestRunStatusQueued:  {TestRunStatusRunning, TestRunStatusCancelled},
+	TestRunStatusRunning: {TestRunStatusPassed, TestRunStatusFailed, TestRunStatusErrored, TestRunStatusCancelled, TestRunStatusQueued},
+}
+
+// IsTerminal indica se o estado é final (a execução não muda mais)
+func (s TestRunStatus) IsTerminal() bool {
+	return len(testRunTransitions[s]) == 0
+}
+
+// IsValid verifica se o estado é conhecido
+func (s TestRunStatus) IsValid() bool {
+	switch s {
+	case TestRunStatusQueued, TestRunStatusRunning, TestRunStatusPassed,
+		TestRunStatusFailed, TestRunStatusErrored, TestRunStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// TestRun representa uma execução de suítes de teste de uma empresa.
+// TestSuiteIDs vazio significa que todas as suítes da empresa são executadas.
+// EnvironmentID define o ambiente cujas variáveis substituem {{variável}} nas suítes.
+type TestRun struct {
+	ID            uuid.UUID     `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID     uuid.UUID     `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TestSuiteIDs  []uuid.UUID   `json:"test_suite_ids,omitempty" db:"test_suite_ids"`
+	EnvironmentID *uuid.UUID    `json:"environment_id,omitempty" db:"environment_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	ScheduleID    *uuid.UUID    `json:"schedule_id,omitempty" db:"schedule_id" example:"550e8400-e29b-41d4-a716-446655440003"`
+	Status        TestRunStatus `json:"status" db:"status" example:"queued" enums:"queued,running,passed,failed,errored,cancelled"`
+	StartedAt     *time.Time    `json:"started_at" db:"started_at"`
+	FinishedAt    *time.Time    `json:"finished_at" db:"finished_at"`
+	TotalTests    int           `json:"total_tests" db:"total_tests" example:"10"`
+	PassedTests   int           `json:"passed_tests" db:"passed_tests" example:"8"`
+	FailedTests   int           `json:"failed_tests" db:"failed_tests" example:"2"`
+	SkippedTests  int           `json:"skipped_tests" db:"skipped_tests" example:"0"`
+	CreatedAt     time.Time     `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt     time.Time     `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewTestRun cria uma nova execução na fila
+func NewTestRun(companyID uuid.UUID, testSuiteIDs []uuid.UUID, environmentID *uuid.UUID) *TestRun {
+	return &TestRun{
+		ID:            uuid.New(),
+		CompanyID:     companyID,
+		TestSuiteIDs:  testSuiteIDs,
+		EnvironmentID: environmentID,
+		Status:        TestRunStatusQueued,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// CanTransitionTo verifica se a execução pode mudar para o estado informado
+func (r *TestRun) CanTransitionTo(status TestRunStatus) bool {
+	for _, allowed := range testRunTransitions[r.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Start marca a execução como em andamento
+func (r *TestRun) Start() error {
+	if err := r.transitionTo(TestRunStatusRunning); err != nil {
+		return err
+	}
+	now := time.Now()
+	r.StartedAt = &now
+	return nil
+}
+
+// Requeue devolve uma execução interrompida para a fila
+func (r *TestRun) Requeue() error {
+	if err := r.transitionTo(TestRunStatusQueued); err != nil {
+		return err
+	}
+	r.StartedAt = nil
+	r.RecordProgress(0, 0, 0, 0)
+	return nil
+}
+
+// RecordProgress atualiza os totais parciais sem alterar o estado
+func (r *TestRun) RecordProgress(total, passed, failed, skipped int) {
+	r.TotalTests = total
+	r.PassedTests = passed
+	r.FailedTests = failed
+	r.SkippedTests = skipped
+	r.UpdatedAt = time.Now()
+}
+
+// Finish encerra a execução com os totais e define o estado final.
+// Suítes puladas impedem que a execução seja considerada aprovada.
+func (r *TestRun) Finish(total, passed, failed, skipped int) error {
+	status := TestRunStatusPassed
+	if failed > 0 || skipped > 0 {
+		status = TestRunStatusFailed
+	}
+	if err := r.transitionTo(status); err != nil {
+		return err
+	}
+	r.RecordProgress(total, passed, failed, skipped)
+	r.markFinished()
+	return nil
+}
+
+// MarkErrored encerra a execução por um erro que impediu sua conclusão
+func (r *TestRun) MarkErrored() error {
+	if err := r.transitionTo(TestRunStatusErrored); err != nil {
+		return err
+	}
+	r.markFinished()
+	return nil
+}
+
+// Cancel cancela a execução, esteja ela na fila ou em andamento
+func (r *TestRun) Cancel() error {
+	if err := r.transitionTo(TestRunStatusCancelled); err != nil {
+		return err
+	}
+	r.markFinished()
+	return nil
+}
+
+// transitionTo altera o estado validando a transição
+func (r *TestRun) transitionTo(status TestRunStatus) error {
+	if !r.CanTransitionTo(status) {
+		return fmt.Errorf("invalid test run status transition from %s to %s", r.Status, status)
+	}
+	r.Status = status
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// markFinished registra o horário de término da execução
+func (r *TestRun) markFinished() {
+	now := time.Now()
+	r.FinishedAt = &now
+}
@@ -1,27 +1,68 @@
 package entities
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"TestGO/internal/domain/value_objects"
+
 	"github.com/google/uuid"
 )
 
-// TestSuite representa uma suíte de testes de API
+// RequestBodyType representa o formato do corpo enviado na requisição da suíte
+type RequestBodyType string
+
+const (
+	RequestBodyNone      RequestBodyType = "none"
+	RequestBodyJSON      RequestBodyType = "json"
+	RequestBodyForm      RequestBodyType = "form"
+	RequestBodyMultipart RequestBodyType = "multipart"
+	RequestBodyRaw       RequestBodyType = "raw"
+)
+
+// IsValid verifica se o tipo de corpo é conhecido
+func (t RequestBodyType) IsValid() bool {
+	switch t {
+	case RequestBodyNone, RequestBodyJSON, RequestBodyForm, RequestBodyMultipart, RequestBodyRaw:
+		return true
+	}
+	return false
+}
+
+// TestSuite representa uma suíte de testes de API.
+// Body é usado pelos tipos json e raw; FormFields pelos tipos form e multipart.
+// DependsOn lista as suítes que precisam passar antes desta na mesma execução.
 type TestSuite struct {
-	ID             uuid.UUID `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	CompanyID      uuid.UUID `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
-	Name           string    `json:"name" db:"name" example:"API Login Test"`
-	Method         string    `json:"method" db:"method" example:"POST" enums:"GET,POST,PUT,DELETE,PATCH"`
-	URL            string    `json:"url" db:"url" example:"https://api.example.com/login"`
-	Headers        string    `json:"headers" db:"headers" example:"Content-Type: application/json"`
-	ExpectedStatus int       `json:"expected_status" db:"expected_status" example:"200"`
-	ExpectedBody   string    `json:"expected_body" db:"expected_body" example:"{\"success\": true}"`
-	CreatedAt      time.Time `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
-	UpdatedAt      time.Time `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+	ID             uuid.UUID         `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID      uuid.UUID         `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name           string            `json:"name" db:"name" example:"API Login Test"`
+	Method         string            `json:"method" db:"method" example:"POST" enums:"GET,POST,PUT,DELETE,PATCH"`
+	URL            string            `json:"url" db:"url" example:"https://api.example.com/login"`
+	Headers        map[string]string `json:"headers" db:"headers"`
+	QueryParams    map[string]string `json:"query_params" db:"query_params"`
+	BodyType       RequestBodyType   `json:"body_type" db:"body_type" example:"json" enums:"none,json,form,multipart,raw"`
+	Body           string            `json:"body" db:"body" example:"{\"username\": \"admin\"}"`
+	FormFields     map[string]string `json:"form_fields" db:"form_fields"`
+	ExpectedStatus int               `json:"expected_status" db:"expected_status" example:"200"`
+	ExpectedBody   string            `json:"expected_body" db:"expected_body" example:"{\"success\": true}"`
+	// Schema da resposta: inline (ResponseSchema) ou armazenado (ResponseSchemaID), nunca ambos
+	ResponseSchema   json.RawMessage `json:"response_schema,omitempty" db:"response_schema" swaggertype:"object"`
+	ResponseSchemaID *uuid.UUID      `json:"response_schema_id,omitempty" db:"response_schema_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Assertions       []*Assertion    `json:"assertions" db:"-"`
+	Extractions      []*Extraction   `json:"extractions" db:"-"`
+	DependsOn        []uuid.UUID     `json:"depends_on" db:"depends_on"`
+	// ImportKey identifica a operação de origem das suítes importadas, para que a reimportação as atualize
+	ImportKey *string   `json:"import_key,omitempty" db:"import_key" example:"openapi:createUser"`
+	CreatedAt time.Time `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
 }
 
 // NewTestSuite cria uma nova instância de TestSuite
-func NewTestSuite(companyID uuid.UUID, name, method, url, headers string, expectedStatus int, expectedBody string) *TestSuite {
+func NewTestSuite(companyID uuid.UUID, name, method, url string, headers map[string]string, expectedStatus int, expectedBody string) *TestSuite {
+	if headers == nil {
+		headers = map[string]string{}
+	}
 	return &TestSuite{
 		ID:             uuid.New(),
 		CompanyID:      companyID,
@@ -29,6 +70,10 @@ func NewTestSuite(companyID uuid.UUID, name, method, url, headers string, expect
 		Method:         method,
 		URL:            url,
 		Headers:        headers,
+		QueryParams:    map[string]string{},
+		BodyType:       RequestBodyNone,
+		FormFields:     map[string]string{},
+		DependsOn:      []uuid.UUID{},
 		ExpectedStatus: expectedStatus,
 		ExpectedBody:   expectedBody,
 		CreatedAt:      time.Now(),
@@ -37,7 +82,7 @@ func NewTestSuite(companyID uuid.UUID, name, method, url, headers string, expect
 }
 
 // UpdateTestSuite atualiza os dados da suíte de teste
-func (ts *TestSuite) UpdateTestSuite(name, method, url, headers string, expectedStatus int, expectedBody string) {
+func (ts *TestSuite) UpdateTestSuite(name, method, url string, headers map[string]string, expectedStatus int, expectedBody string) {
 	if name != "" {
 		ts.Name = name
 	}
@@ -47,7 +92,7 @@ func (ts *TestSuite) UpdateTestSuite(name, method, url, headers string, expected
 	if url != "" {
 		ts.URL = url
 	}
-	if headers != "" {
+	if headers != nil {
 		ts.Headers = headers
 	}
 	if expectedStatus > 0 {
@@ -58,3 +103,109 @@ func (ts *TestSuite) UpdateTestSuite(name, method, url, headers string, expected
 	}
 	ts.UpdatedAt = time.Now()
 }
+
+// SetQueryParams substitui os parâmetros de query enviados na requisição
+func (ts *TestSuite) SetQueryParams(queryParams map[string]string) {
+	if queryParams == nil {
+		queryParams = map[string]string{}
+	}
+	ts.QueryParams = queryParams
+	ts.UpdatedAt = time.Now()
+}
+
+// SetRequestBody define o corpo da requisição, descartando os campos que o tipo não utiliza
+func (ts *TestSuite) SetRequestBody(bodyType RequestBodyType, body string, formFields map[string]string) error {
+	if bodyType == "" {
+		bodyType = RequestBodyNone
+	}
+	if !bodyType.IsValid() {
+		return fmt.Errorf("unknown body type %q", bodyType)
+	}
+
+	switch bodyType {
+	case RequestBodyNone:
+		body, formFields = "", nil
+	case RequestBodyJSON:
+		// Corpos com {{variável}} só podem ser verificados após a substituição, na execução
+		if !value_objects.HasTemplateVariables(body) && !json.Valid([]byte(body)) {
+			return fmt.Errorf("body is not valid JSON")
+		}
+		formFields = nil
+	case RequestBodyRaw:
+		formFields = nil
+	case RequestBodyForm, RequestBodyMultipart:
+		body = ""
+	}
+	if formFields == nil {
+		formFields = map[string]string{}
+	}
+
+	ts.BodyType = bodyType
+	ts.Body = body
+	ts.FormFields = formFields
+	ts.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetExtractions substitui as regras de extração da suíte, vinculando-as e numerando-as na ordem recebida
+func (ts *TestSuite) SetExtractions(extractions []*Extraction) {
+	for i, extraction := range extractions {
+		extraction.TestSuiteID = ts.ID
+		extraction.Position = i
+	}
+	ts.Extractions = extractions
+	ts.UpdatedAt = time.Now()
+}
+
+// SetDependsOn substitui as dependências da suíte, ignorando repetições
+func (ts *TestSuite) SetDependsOn(ids []uuid.UUID) error {
+	dependsOn := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if id == ts.ID {
+			return fmt.Errorf("test suite cannot depend on itself")
+		}
+		if !seen[id] {
+			seen[id] = true
+			dependsOn = append(dependsOn, id)
+		}
+	}
+	ts.DependsOn = dependsOn
+	ts.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetAssertions substitui as asserções da suíte, vinculando-as e numerando-as na ordem recebida
+func (ts *TestSuite) SetAssertions(assertions []*Assertion) {
+	for i, assertion := range assertions {
+		assertion.TestSuiteID = ts.ID
+		assertion.Position = i
+	}
+	ts.Assertions = assertions
+	ts.UpdatedAt = time.Now()
+}
+
+// SetResponseSchema define o schema inline da resposta, removendo a referência a um schema armazenado
+func (ts *TestSuite) SetResponseSchema(schema json.RawMessage) {
+	ts.ResponseSchema = schema
+	ts.ResponseSchemaID = nil
+	ts.UpdatedAt = time.Now()
+}
+
+// SetResponseSchemaID referencia um schema armazenado, removendo o schema inline
+func (ts *TestSuite) SetResponseSchemaID(schemaID *uuid.UUID) {
+	ts.ResponseSchemaID = schemaID
+	ts.ResponseSchema = nil
+	ts.UpdatedAt = time.Now()
+}
+
+// SetImportKey vincula a suíte à operação do documento importado que a gerou
+func (ts *TestSuite) SetImportKey(key string) {
+	ts.ImportKey = &key
+	ts.UpdatedAt = time.Now()
+}
+
+// HasResponseSchema indica se a resposta deve ser validada contra um JSON Schema
+func (ts *TestSuite) HasResponseSchema() bool {
+	return len(ts.ResponseSchema) > 0 || ts.ResponseSchemaID != nil
+}
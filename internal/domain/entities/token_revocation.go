@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenRevocation representa a revogação de access tokens antes da expiração.
+// Com TokenID, revoga apenas o token com aquele jti; com SessionID, todos os tokens emitidos na sessão
+// (logout ou sessão encerrada); sem nenhum dos dois, todos os tokens do usuário emitidos até CreatedAt
+// (sair de todas as sessões).
+// A revogação só precisa ser mantida até ExpiresAt, quando os tokens revogados já expiraram.
+type TokenRevocation struct {
+	ID        uuid.UUID  `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TokenID   *string    `json:"token_id,omitempty" db:"token_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	SessionID *uuid.UUID `json:"session_id,omitempty" db:"session_id" example:"550e8400-e29b-41d4-a716-446655440003"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at" example:"2023-01-01T00:15:00Z"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewTokenRevocation revoga o access token com o jti informado até a sua expiração
+func NewTokenRevocation(userID uuid.UUID, tokenID string, expiresAt time.Time) *TokenRevocation {
+	return &TokenRevocation{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TokenID:   &tokenID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewSessionTokenRevocation revoga os access tokens emitidos na sessão informada.
+// expiresAt deve cobrir a validade do último token emitido.
+func NewSessionTokenRevocation(userID, sessionID uuid.UUID, expiresAt time.Time) *TokenRevocation {
+	return &TokenRevocation{
+		ID:        uuid.New(),
+		UserID:    userID,
+		SessionID: &sessionID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewUserTokenRevocation revoga todos os access tokens já emitidos para o usuário.
+// expiresAt deve cobrir a validade do último token emitido.
+func NewUserTokenRevocation(userID uuid.UUID, expiresAt time.Time) *TokenRevocation {
+	return &TokenRevocation{
+		ID:        uuid.New(),
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsExpired indica se os tokens revogados já expiraram e a revogação pode ser descartada
+func (r *TokenRevocation) IsExpired() bool {
+	return time.Now().After(r.ExpiresAt)
+}
+
+// Covers indica se a revogação se aplica ao token do usuário com o jti, a sessão e a emissão informados
+func (r *TokenRevocation) Covers(userID uuid.UUID, tokenID string, sessionID uuid.UUID, issuedAt time.Time) bool {
+	if r.UserID != userID {
+		return false
+	}
+	if r.TokenID != nil {
+		return *r.TokenID == tokenID
+	}
+	if r.SessionID != nil {
+		return *r.SessionID == sessionID
+	}
+	// A emissão tem precisão de milissegundos; só tokens emitidos no mesmo milissegundo da revogação
+	// também são revogados. Tokens antigos, só com o iat em segundos, chegam com a emissão truncada
+	// ao início do segundo e continuam revogados se emitidos no segundo da revogação.
+	return !issuedAt.After(r.CreatedAt.Truncate(time.Millisecond))
+}
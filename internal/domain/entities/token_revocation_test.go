@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTokenRevocationCovers(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	revokedAt := time.Date(2026, 10, 18, 12, 0, 0, 500_400_000, time.UTC)
+
+	userRevocation := NewUserTokenRevocation(userID, revokedAt.Add(time.Hour))
+	userRevocation.CreatedAt = revokedAt
+	tokenRevocation := NewTokenRevocation(userID, "jti-1", revokedAt.Add(time.Hour))
+	sessionRevocation := NewSessionTokenRevocation(userID, sessionID, revokedAt.Add(time.Hour))
+
+	tests := []struct {
+		name       string
+		revocation *TokenRevocation
+		userID     uuid.UUID
+		tokenID    string
+		sessionID  uuid.UUID
+		issuedAt   time.Time
+		want       bool
+	}{
+		{name: "token issued before logout all", revocation: userRevocation, userID: userID, issuedAt: revokedAt.Add(-time.Second), want: true},
+		{name: "token issued earlier in the same second", revocation: userRevocation, userID: userID, issuedAt: revokedAt.Add(-200 * time.Millisecond).Truncate(time.Millisecond), want: true},
+		{name: "token issued in the same millisecond", revocation: userRevocation, userID: userID, issuedAt: revokedAt.Truncate(time.Millisecond), want: true},
+		{name: "token issued later in the same second", revocation: userRevocation, userID: userID, issuedAt: revokedAt.Add(time.Millisecond).Truncate(time.Millisecond)},
+		{name: "token issued after logout all", revocation: userRevocation, userID: userID, issuedAt: revokedAt.Add(time.Minute)},
+		{name: "token without iat_ms issued in the same second", revocation: userRevocation, userID: userID, issuedAt: revokedAt.Truncate(time.Second), want: true},
+		{name: "another user", revocation: userRevocation, userID: uuid.New(), issuedAt: revokedAt.Add(-time.Minute)},
+		{name: "revoked jti", revocation: tokenRevocation, userID: userID, tokenID: "jti-1", want: true},
+		{name: "another jti", revocation: tokenRevocation, userID: userID, tokenID: "jti-2"},
+		{name: "revoked session", revocation: sessionRevocation, userID: userID, sessionID: sessionID, issuedAt: time.Now().Add(time.Minute), want: true},
+		{name: "another session", revocation: sessionRevocation, userID: userID, sessionID: uuid.New()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.revocation.Covers(tt.userID, tt.tokenID, tt.sessionID, tt.issuedAt); got != tt.want {
+				t.Errorf("Covers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
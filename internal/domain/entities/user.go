@@ -14,32 +14,36 @@ type User struct {
 	Password  string     `json:"-" db:"password"` // Não expor no JSON
 	Name      string     `json:"name" db:"name"`
 	CompanyID *uuid.UUID `json:"company_id" db:"company_id"`
-	CreatedAt time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+	// Recebe os alertas por email das execuções com falha da empresa
+	EmailAlerts bool      `json:"email_alerts" db:"email_alerts"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // NewUser cria uma nova instância de usuário
 func NewUser(username, email, hashedPassword string) *User {
 	return &User{
-		ID:        uuid.New(),
-		Username:  username,
-		Email:     email,
-		Password:  hashedPassword,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:          uuid.New(),
+		Username:    username,
+		Email:       email,
+		Password:    hashedPassword,
+		EmailAlerts: true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 }
 
 // NewUserWithName cria uma nova instância de usuário com nome
 func NewUserWithName(username, email, hashedPassword, name string) *User {
 	return &User{
-		ID:        uuid.New(),
-		Username:  username,
-		Email:     email,
-		Password:  hashedPassword,
-		Name:      name,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:          uuid.New(),
+		Username:    username,
+		Email:       email,
+		Password:    hashedPassword,
+		Name:        name,
+		EmailAlerts: true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 }
 
@@ -61,4 +65,10 @@ func (u *User) UpdateProfile(username, email, name string) {
 		u.Name = name
 	}
 	u.UpdatedAt = time.Now()
+}
+
+// SetEmailAlerts ativa ou desativa o recebimento de alertas por email
+func (u *User) SetEmailAlerts(enabled bool) {
+	u.EmailAlerts = enabled
+	u.UpdatedAt = time.Now()
 }
\ No newline at end of file
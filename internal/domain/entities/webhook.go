@@ -0,0 +1,188 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEvent representa um evento que pode ser enviado aos webhooks da empresa
+type WebhookEvent string
+
+const (
+	// Execução encerrada (aprovada, reprovada ou com erro)
+	WebhookEventTestRunFinished WebhookEvent = "test_run.finished"
+	// Suíte que passava na execução anterior falhou
+	WebhookEventTestSuiteFailing WebhookEvent = "test_suite.failing"
+	// Suíte que falhava na execução anterior voltou a passar
+	WebhookEventTestSuiteRecovered WebhookEvent = "test_suite.recovered"
+	// Evento de teste enviado sob demanda
+	WebhookEventPing WebhookEvent = "ping"
+)
+
+// WebhookEvents lista os eventos que podem ser assinados
+var WebhookEvents = []WebhookEvent{
+	WebhookEventTestRunFinished,
+	WebhookEventTestSuiteFailing,
+	WebhookEventTestSuiteRecovered,
+}
+
+// Webhook representa uma URL da empresa que recebe eventos assinados com HMAC-SHA256.
+// O segredo de assinatura é gravado cifrado e só é devolvido na criação.
+type Webhook struct {
+	ID              uuid.UUID      `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID       uuid.UUID      `json:"company_id" db:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	URL             string         `json:"url" db:"url" example:"https://hooks.example.com/eztest"`
+	Events          []WebhookEvent `json:"events" db:"events"`
+	Enabled         bool           `json:"enabled" db:"enabled" example:"true"`
+	EncryptedSecret []byte         `json:"-" db:"encrypted_secret"`
+	CreatedAt       time.Time      `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt       time.Time      `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewWebhook cria uma nova instância de Webhook; sem eventos informados, assina todos.
+// O segredo cifrado é definido com SetEncryptedSecret.
+func NewWebhook(companyID uuid.UUID, url string, events []WebhookEvent, enabled bool) *Webhook {
+	if len(events) == 0 {
+		events = append([]WebhookEvent{}, WebhookEvents...)
+	}
+	return &Webhook{
+		ID:        uuid.New(),
+		CompanyID: companyID,
+		URL:       url,
+		Events:    events,
+		Enabled:   enabled,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// UpdateWebhook atualiza os campos informados; valores vazios ou nil mantêm os atuais
+func (w *Webhook) UpdateWebhook(url string, events []WebhookEvent, enabled *bool) {
+	if url != "" {
+		w.URL = url
+	}
+	if len(events) > 0 {
+		w.Events = events
+	}
+	if enabled != nil {
+		w.Enabled = *enabled
+	}
+	w.UpdatedAt = time.Now()
+}
+
+// SetEncryptedSecret substitui o segredo de assinatura cifrado
+func (w *Webhook) SetEncryptedSecret(encrypted []byte) {
+	w.EncryptedSecret = encrypted
+	w.UpdatedAt = time.Now()
+}
+
+// ValidateEvents verifica se todos os eventos assinados são conhecidos
+func (w *Webhook) ValidateEvents() error {
+	for _, event := range w.Events {
+		if !isWebhookEvent(event) {
+			return fmt.Errorf("%w webhook event %q", domainErrors.ErrInvalid, event)
+		}
+	}
+	return nil
+}
+
+// Subscribes indica se o webhook recebe o evento; o evento de teste é sempre aceito
+func (w *Webhook) Subscribes(event WebhookEvent) bool {
+	if event == WebhookEventPing {
+		return true
+	}
+	for _, subscribed := range w.Events {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+// isWebhookEvent indica se o evento pode ser assinado
+func isWebhookEvent(event WebhookEvent) bool {
+	for _, known := range WebhookEvents {
+		if known == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus representa o estado da entrega de um evento
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookAttempt registra uma tentativa de entrega
+type WebhookAttempt struct {
+	AttemptedAt    time.Time `json:"attempted_at"`
+	ResponseStatus int       `json:"response_status,omitempty"`
+	DurationMS     int       `json:"duration_ms"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// WebhookDelivery representa a entrega de um evento a um webhook, com o histórico de tentativas
+type WebhookDelivery struct {
+	ID            uuid.UUID             `json:"id" db:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	WebhookID     uuid.UUID             `json:"webhook_id" db:"webhook_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	CompanyID     uuid.UUID             `json:"-" db:"-"` // empresa dona do webhook, usada para buscá-lo no envio
+	Event         WebhookEvent          `json:"event" db:"event" example:"test_run.finished"`
+	Payload       json.RawMessage       `json:"payload" db:"payload" swaggertype:"object"`
+	Status        WebhookDeliveryStatus `json:"status" db:"status" example:"pending" enums:"pending,succeeded,failed"`
+	Attempts      []WebhookAttempt      `json:"attempts" db:"attempts"`
+	NextAttemptAt *time.Time            `json:"next_attempt_at,omitempty" db:"next_attempt_at"`
+	DeliveredAt   *time.Time            `json:"delivered_at,omitempty" db:"delivered_at"`
+	CreatedAt     time.Time             `json:"created_at" db:"created_at" example:"2023-01-01T00:00:00Z"`
+	UpdatedAt     time.Time             `json:"updated_at" db:"updated_at" example:"2023-01-01T00:00:00Z"`
+}
+
+// NewWebhookDelivery cria uma entrega pendente, disponível para envio imediato
+func NewWebhookDelivery(webhook *Webhook, event WebhookEvent, payload json.RawMessage) *WebhookDelivery {
+	now := time.Now().UTC()
+	return &WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     webhook.ID,
+		CompanyID:     webhook.CompanyID,
+		Event:         event,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		Attempts:      []WebhookAttempt{},
+		NextAttemptAt: &now,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// RecordAttempt registra o resultado de uma tentativa. Falhas são reagendadas com backoff
+// exponencial (baseBackoff, 2x, 4x, ...) até maxAttempts, quando a entrega é dada como falha.
+func (d *WebhookDelivery) RecordAttempt(attempt WebhookAttempt, succeeded bool, maxAttempts int, baseBackoff time.Duration) {
+	d.Attempts = append(d.Attempts, attempt)
+	d.UpdatedAt = time.Now()
+
+	switch {
+	case succeeded:
+		deliveredAt := attempt.AttemptedAt.UTC()
+		d.Status = WebhookDeliverySucceeded
+		d.DeliveredAt = &deliveredAt
+		d.NextAttemptAt = nil
+	case len(d.Attempts) >= maxAttempts:
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+	default:
+		backoff := time.Duration(float64(baseBackoff) * math.Pow(2, float64(len(d.Attempts)-1)))
+		next := attempt.AttemptedAt.Add(backoff).UTC()
+		d.Status = WebhookDeliveryPending
+		d.NextAttemptAt = &next
+	}
+}
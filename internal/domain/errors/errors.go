@@ -1,10 +1,28 @@
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
 
+// Erros sentinela compartilhados pelas camadas. Os erros concretos os envolvem com %w
+// (ex.: "test suite not found", "invalid schedule: ...") e os handlers os identificam com errors.Is.
+var (
+	// ErrNotFound indica um recurso inexistente ou de uma empresa da qual o usuário não é membro
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden indica que o papel do usuário na empresa não concede a permissão
+	ErrForbidden = errors.New("permission denied")
+	// ErrInvalid indica dados rejeitados pela validação; a mensagem descreve o problema
+	ErrInvalid = errors.New("invalid")
+	// ErrAlreadyExists indica um nome ou vínculo que precisa ser único e já está em uso
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrConflict indica uma operação incompatível com o estado atual do recurso
+	ErrConflict = errors.New("conflict")
+	// ErrUnauthenticated indica credenciais ou tokens inválidos, expirados ou revogados
+	ErrUnauthenticated = errors.New("unauthenticated")
+)
+
 // ErrorType representa o tipo de erro
 type ErrorType string
 
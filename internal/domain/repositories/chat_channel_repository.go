@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ChatChannelRepository define as operações de persistência dos canais de chat.
+// Todas as operações são restritas à empresa dona do canal; Update usa a empresa do próprio canal.
+type ChatChannelRepository interface {
+	Create(ctx context.Context, channel *entities.ChatChannel) (*entities.ChatChannel, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.ChatChannel, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error)
+	Update(ctx context.Context, channel *entities.ChatChannel) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+
+	// GetEnabledByCompanyID retorna os canais ativos da empresa
+	GetEnabledByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error)
+}
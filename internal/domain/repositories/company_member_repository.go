@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// CompanyMemberRepository define as operações de persistência dos membros das empresas.
+// UpdateRole e Delete recusam a operação quando ela deixaria a empresa sem nenhum owner.
+type CompanyMemberRepository interface {
+	Create(ctx context.Context, member *entities.CompanyMember) error
+	Get(ctx context.Context, companyID, userID uuid.UUID) (*entities.CompanyMember, error)
+	ListByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error)
+	UpdateRole(ctx context.Context, companyID, userID uuid.UUID, role entities.CompanyRole) (*entities.CompanyMember, error)
+	Delete(ctx context.Context, companyID, userID uuid.UUID) error
+
+	// GetResourceCompanyID retorna a empresa dona do recurso, desde que o usuário seja membro dela.
+	// Recursos de outras empresas respondem "not found", como se não existissem.
+	GetResourceCompanyID(ctx context.Context, userID uuid.UUID, resource entities.ResourceType, id uuid.UUID) (uuid.UUID, error)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// EnvironmentRepository define as operações de persistência de ambientes.
+// Todas as operações são restritas à empresa dona do ambiente.
+type EnvironmentRepository interface {
+	Create(ctx context.Context, environment *entities.Environment) (*entities.Environment, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Environment, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Environment, error)
+	Update(ctx context.Context, environment *entities.Environment) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error)
+}
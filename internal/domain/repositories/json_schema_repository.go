@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// JSONSchemaRepository define as operações de persistência dos schemas JSON.
+// Todas as operações são restritas à empresa dona do schema; Update usa a empresa do próprio schema.
+type JSONSchemaRepository interface {
+	Create(ctx context.Context, schema *entities.JSONSchema) (*entities.JSONSchema, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.JSONSchema, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.JSONSchema, error)
+	Update(ctx context.Context, schema *entities.JSONSchema) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenRepository define as operações de persistência dos refresh tokens
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entities.RefreshToken) (*entities.RefreshToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	// MarkUsed marca o token como usado e retorna false se ele já tinha sido usado ou revogado,
+	// garantindo que cada token seja trocado uma única vez mesmo com requisições concorrentes
+	MarkUsed(ctx context.Context, id uuid.UUID) (bool, error)
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	RevokeByUserID(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleRepository define as operações de persistência de agendamentos.
+// Todas as operações de CRUD são restritas à empresa dona do agendamento.
+type ScheduleRepository interface {
+	Create(ctx context.Context, schedule *entities.Schedule) (*entities.Schedule, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Schedule, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Schedule, error)
+	Update(ctx context.Context, schedule *entities.Schedule) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error)
+
+	// EnqueueDue enfileira as execuções dos agendamentos vencidos até now e avança o próximo disparo,
+	// sem disparos duplicados quando há várias instâncias da API
+	EnqueueDue(ctx context.Context, now time.Time, limit int) ([]*entities.TestRun, error)
+}
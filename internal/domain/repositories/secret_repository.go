@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// SecretRepository define as operações de persistência de segredos.
+// Todas as operações são restritas à empresa dona do segredo.
+type SecretRepository interface {
+	Create(ctx context.Context, secret *entities.Secret) (*entities.Secret, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Secret, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Secret, error)
+	Update(ctx context.Context, secret *entities.Secret) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error)
+}
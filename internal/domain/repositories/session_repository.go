@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// SessionRepository define as operações de persistência das sessões.
+// As operações de leitura e revogação são restritas ao usuário dono da sessão.
+type SessionRepository interface {
+	// Save cria a sessão ou, se ela já existir e não tiver sido encerrada, atualiza o último uso,
+	// o dispositivo, o endereço e a validade
+	Save(ctx context.Context, session *entities.Session) error
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]*entities.Session, error)
+	Revoke(ctx context.Context, userID, id uuid.UUID) error
+	RevokeByUserID(ctx context.Context, userID uuid.UUID) error
+}
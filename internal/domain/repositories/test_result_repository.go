@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// TestResultRepository define as operações de persistência dos resultados de teste. As leituras e
+// exclusões são restritas às execuções da empresa informada; Create e Update partem do worker,
+// que grava os resultados da execução que ele mesmo retirou da fila.
+type TestResultRepository interface {
+	Create(ctx context.Context, testResult *entities.TestResult) (*entities.TestResult, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestResult, error)
+	GetByTestRunID(ctx context.Context, companyID, testRunID uuid.UUID) ([]*entities.TestResult, error)
+	Update(ctx context.Context, testResult *entities.TestResult) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	DeleteByTestRunID(ctx context.Context, companyID, testRunID uuid.UUID) error
+
+	// GetLatestByTestSuiteID retorna o último resultado executado (não pulado) da suíte, ou nil se não houver
+	GetLatestByTestSuiteID(ctx context.Context, companyID, testSuiteID uuid.UUID) (*entities.TestResult, error)
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// TestRunRepository define as operações de persistência das execuções. As operações por ID são
+// restritas à empresa dona da execução; Update e UpdateIfStatus usam a empresa da própria execução.
+// Execuções em andamento têm o heartbeat renovado pela instância que as executa; RequeueStale só devolve
+// à fila as que estão com o heartbeat expirado.
+type TestRunRepository interface {
+	Create(ctx context.Context, testRun *entities.TestRun) (*entities.TestRun, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestRun, error)
+	Update(ctx context.Context, testRun *entities.TestRun) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+
+	// Operações da fila de execuções
+	ClaimNext(ctx context.Context, companyConcurrency int) (*entities.TestRun, error)
+	UpdateIfStatus(ctx context.Context, testRun *entities.TestRun, expected entities.TestRunStatus) (bool, error)
+	Heartbeat(ctx context.Context, ids []uuid.UUID) error
+	RequeueStale(ctx context.Context, heartbeatBefore time.Time) (int64, error)
+}
@@ -8,11 +8,17 @@ import (
 	"github.com/google/uuid"
 )
 
+// TestSuiteRepository define as operações de persistência das suítes de teste.
+// Todas as operações são restritas à empresa dona da suíte; Update usa a empresa da própria suíte.
 type TestSuiteRepository interface {
 	Create(ctx context.Context, testSuite *entities.TestSuite) (*entities.TestSuite, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*entities.TestSuite, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestSuite, error)
 	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestSuite, error)
+	// ListByCompanyID retorna uma página das suítes da empresa; CountByCompanyID retorna o total
+	ListByCompanyID(ctx context.Context, companyID uuid.UUID, limit, offset int) ([]*entities.TestSuite, error)
+	CountByCompanyID(ctx context.Context, companyID uuid.UUID) (int64, error)
 	Update(ctx context.Context, testSuite *entities.TestSuite) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int) ([]*entities.TestSuite, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	// GetByImportKeys retorna as suítes da empresa geradas por importação com as chaves informadas
+	GetByImportKeys(ctx context.Context, companyID uuid.UUID, keys []string) ([]*entities.TestSuite, error)
 }
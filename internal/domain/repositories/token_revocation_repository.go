@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"TestGO/internal/domain/entities"
+)
+
+// TokenRevocationRepository define as operações de persistência das revogações de access tokens
+type TokenRevocationRepository interface {
+	Create(ctx context.Context, revocation *entities.TokenRevocation) (*entities.TokenRevocation, error)
+	// GetActiveSince retorna as revogações ainda não expiradas criadas a partir de since
+	GetActiveSince(ctx context.Context, since time.Time) ([]*entities.TokenRevocation, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "context"
+
+// Transactor executa um conjunto de operações de repositórios em uma única transação.
+// Os repositórios chamados com o contexto recebido por fn participam da transação;
+// se fn retornar erro, tudo é desfeito.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -15,7 +15,12 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*entities.User, error)
 	Update(ctx context.Context, user *entities.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int) ([]*entities.User, error)
+	// ListVisibleTo lista o próprio viewer e os usuários que compartilham alguma empresa com ele
+	ListVisibleTo(ctx context.Context, viewerID uuid.UUID, limit, offset int) ([]*entities.User, error)
+	CountVisibleTo(ctx context.Context, viewerID uuid.UUID) (int64, error)
+	// GetVisibleTo busca o usuário somente se ele for o viewer ou compartilhar uma empresa com ele
+	GetVisibleTo(ctx context.Context, viewerID, id uuid.UUID) (*entities.User, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.User, error)
 	ExistsByUsername(ctx context.Context, username string) (bool, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 }
\ No newline at end of file
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// WebhookRepository define as operações de persistência de webhooks.
+// Todas as operações são restritas à empresa dona do webhook; Update usa a empresa do próprio webhook.
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Webhook, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Webhook, error)
+	Update(ctx context.Context, webhook *entities.Webhook) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+
+	// GetSubscribed retorna os webhooks ativos da empresa que assinam o evento
+	GetSubscribed(ctx context.Context, companyID uuid.UUID, event entities.WebhookEvent) ([]*entities.Webhook, error)
+}
+
+// WebhookDeliveryRepository define as operações de persistência das entregas de webhooks
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *entities.WebhookDelivery) (*entities.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *entities.WebhookDelivery) error
+
+	// GetByWebhookID retorna as entregas mais recentes do webhook, desde que ele pertença à empresa
+	GetByWebhookID(ctx context.Context, companyID, webhookID uuid.UUID, limit int) ([]*entities.WebhookDelivery, error)
+
+	// ClaimDue reserva as entregas pendentes vencidas até now, adiando a próxima tentativa para
+	// now+lease para que outras instâncias não as enviem ao mesmo tempo
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, error)
+}
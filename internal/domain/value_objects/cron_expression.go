@@ -0,0 +1,58 @@
+package value_objects
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser aceita expressões de cinco campos (minuto hora dia mês dia-da-semana)
+// e os atalhos @hourly, @daily, @weekly, @monthly e @every <duração>
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// CronExpression representa uma expressão cron validada, avaliada em um fuso horário
+type CronExpression struct {
+	value    string
+	location *time.Location
+	schedule cron.Schedule
+}
+
+// NewCronExpression cria uma nova expressão cron com validação da expressão e do fuso horário
+func NewCronExpression(expr, timezone string) (*CronExpression, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("cron expression cannot be empty")
+	}
+	// O fuso é definido separadamente; prefixos CRON_TZ/TZ na expressão são recusados
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		return nil, fmt.Errorf("cron expression must not include a timezone")
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", timezone)
+	}
+
+	schedule, err := cronParser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression: %w", err)
+	}
+
+	return &CronExpression{
+		value:    expr,
+		location: location,
+		schedule: schedule,
+	}, nil
+}
+
+// String retorna a expressão original
+func (c CronExpression) String() string {
+	return c.value
+}
+
+// Next retorna o próximo horário de disparo estritamente posterior a from
+func (c CronExpression) Next(from time.Time) time.Time {
+	return c.schedule.Next(from.In(c.location))
+}
@@ -0,0 +1,170 @@
+package value_objects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONPath representa uma expressão JSONPath validada.
+// Suporta o subconjunto usado nas asserções: raiz ($), campos (.campo ou ['campo']),
+// índices ([0], [-1]) e curinga (.* ou [*]).
+type JSONPath struct {
+	value    string
+	segments []pathSegment
+}
+
+// pathSegment representa um passo da expressão
+type pathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// NewJSONPath cria uma nova expressão JSONPath com validação
+func NewJSONPath(expr string) (*JSONPath, error) {
+	expr = strings.TrimSpace(expr)
+	segments, err := parseJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONPath{
+		value:    expr,
+		segments: segments,
+	}, nil
+}
+
+// String retorna a expressão original
+func (p JSONPath) String() string {
+	return p.value
+}
+
+// HasWildcard indica se a expressão pode retornar mais de um valor
+func (p JSONPath) HasWildcard() bool {
+	for _, segment := range p.segments {
+		if segment.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate aplica a expressão a um documento JSON decodificado e retorna os valores encontrados
+func (p JSONPath) Evaluate(document interface{}) []interface{} {
+	current := []interface{}{document}
+	for _, segment := range p.segments {
+		var next []interface{}
+		for _, node := range current {
+			next = append(next, segment.apply(node)...)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// apply retorna os filhos de node selecionados pelo segmento
+func (s pathSegment) apply(node interface{}) []interface{} {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		if s.wildcard {
+			children := make([]interface{}, 0, len(value))
+			for _, child := range value {
+				children = append(children, child)
+			}
+			return children
+		}
+		if s.isIndex {
+			return nil
+		}
+		if child, ok := value[s.key]; ok {
+			return []interface{}{child}
+		}
+	case []interface{}:
+		if s.wildcard {
+			return value
+		}
+		if !s.isIndex {
+			return nil
+		}
+		index := s.index
+		if index < 0 {
+			index += len(value)
+		}
+		if index >= 0 && index < len(value) {
+			return []interface{}{value[index]}
+		}
+	}
+	return nil
+}
+
+// parseJSONPath converte a expressão em segmentos
+func parseJSONPath(expr string) ([]pathSegment, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("json path cannot be empty")
+	}
+	if expr[0] != '$' {
+		return nil, fmt.Errorf("json path must start with '$'")
+	}
+
+	var segments []pathSegment
+	rest := expr[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			if strings.HasPrefix(rest, ".") {
+				return nil, fmt.Errorf("recursive descent '..' is not supported")
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("empty field name in json path %q", expr)
+			}
+			if key == "*" {
+				segments = append(segments, pathSegment{wildcard: true})
+			} else {
+				segments = append(segments, pathSegment{key: key})
+			}
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated '[' in json path %q", expr)
+			}
+			segment, err := parseBracket(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid json path %q: %w", expr, err)
+			}
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected character %q in json path %q", rest[0], expr)
+		}
+	}
+
+	return segments, nil
+}
+
+// parseBracket interpreta o conteúdo entre colchetes: índice, curinga ou campo entre aspas
+func parseBracket(content string) (pathSegment, error) {
+	switch {
+	case content == "*":
+		return pathSegment{wildcard: true}, nil
+	case len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0]:
+		return pathSegment{key: content[1 : len(content)-1]}, nil
+	}
+
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return pathSegment{}, fmt.Errorf("invalid index %q", content)
+	}
+	return pathSegment{index: index, isIndex: true}, nil
+}
@@ -0,0 +1,93 @@
+package value_objects
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewJSONPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "root", expr: "$"},
+		{name: "dotted fields", expr: "$.user.id"},
+		{name: "quoted field", expr: "$['user name'].id"},
+		{name: "indexes and wildcards", expr: "$.items[*].tags[-1]"},
+		{name: "surrounding spaces", expr: "  $.id  "},
+		{name: "empty", expr: "", wantErr: "json path cannot be empty"},
+		{name: "missing root", expr: "user.id", wantErr: "json path must start with '$'"},
+		{name: "recursive descent", expr: "$..id", wantErr: "recursive descent '..' is not supported"},
+		{name: "empty field", expr: "$.user.", wantErr: `empty field name in json path "$.user."`},
+		{name: "unterminated bracket", expr: "$.items[0", wantErr: `unterminated '[' in json path "$.items[0"`},
+		{name: "invalid index", expr: "$.items[first]", wantErr: `invalid json path "$.items[first]": invalid index "first"`},
+		{name: "unexpected character", expr: "$id", wantErr: `unexpected character 'i' in json path "$id"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewJSONPath(tt.expr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("NewJSONPath(%q) error = %v", tt.expr, err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("NewJSONPath(%q) error = %v, want %q", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJSONPathEvaluate(t *testing.T) {
+	var document interface{}
+	err := json.Unmarshal([]byte(`{
+		"user": {"id": 42, "name": "admin", "user name": "root"},
+		"items": [
+			{"id": 1, "tags": ["a", "b"]},
+			{"id": 2, "tags": ["c"]}
+		],
+		"empty": []
+	}`), &document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		expr         string
+		want         []interface{}
+		wantWildcard bool
+	}{
+		{name: "root", expr: "$", want: []interface{}{document}},
+		{name: "nested field", expr: "$.user.id", want: []interface{}{float64(42)}},
+		{name: "quoted field", expr: `$.user["user name"]`, want: []interface{}{"root"}},
+		{name: "index", expr: "$.items[1].id", want: []interface{}{float64(2)}},
+		{name: "negative index", expr: "$.items[0].tags[-1]", want: []interface{}{"b"}},
+		{name: "array wildcard", expr: "$.items[*].id", want: []interface{}{float64(1), float64(2)}, wantWildcard: true},
+		{name: "dotted wildcard", expr: "$.items.*.tags[0]", want: []interface{}{"a", "c"}, wantWildcard: true},
+		{name: "missing field", expr: "$.user.email"},
+		{name: "index out of range", expr: "$.items[5]"},
+		{name: "index on object", expr: "$.user[0]"},
+		{name: "field on array", expr: "$.items.id"},
+		{name: "wildcard on empty array", expr: "$.empty[*]", wantWildcard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := NewJSONPath(tt.expr)
+			if err != nil {
+				t.Fatalf("NewJSONPath(%q) error = %v", tt.expr, err)
+			}
+			if got := path.Evaluate(document); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate() = %#v, want %#v", got, tt.want)
+			}
+			if got := path.HasWildcard(); got != tt.wantWildcard {
+				t.Errorf("HasWildcard() = %v, want %v", got, tt.wantWildcard)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package value_objects
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// templateVariablePattern reconhece referências {{variável}}, com espaços opcionais
+	templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.\-]*)\s*\}\}`)
+	// variableNamePattern define os nomes que podem ser referenciados em {{variável}}
+	variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\-]*$`)
+)
+
+// IsValidVariableName indica se o nome pode ser referenciado em {{variável}}
+func IsValidVariableName(name string) bool {
+	return variableNamePattern.MatchString(name)
+}
+
+// HasTemplateVariables indica se o texto contém referências {{variável}}
+func HasTemplateVariables(text string) bool {
+	return templateVariablePattern.MatchString(text)
+}
+
+// TemplateVariables retorna os nomes referenciados em {{variável}} no texto, sem repetições
+func TemplateVariables(text string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range templateVariablePattern.FindAllStringSubmatch(text, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// Template substitui referências {{variável}} pelos valores informados,
+// acumulando as variáveis sem valor para que sejam reportadas de uma só vez
+type Template struct {
+	variables map[string]string
+	missing   map[string]struct{}
+}
+
+// NewTemplate cria um novo Template com as variáveis informadas
+func NewTemplate(variables map[string]string) *Template {
+	return &Template{
+		variables: variables,
+		missing:   make(map[string]struct{}),
+	}
+}
+
+// Render substitui as referências do texto; referências indefinidas são mantidas
+func (t *Template) Render(text string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	return templateVariablePattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := templateVariablePattern.FindStringSubmatch(match)[1]
+		value, ok := t.variables[name]
+		if !ok {
+			t.missing[name] = struct{}{}
+			return match
+		}
+		return value
+	})
+}
+
+// RenderMap retorna uma cópia do mapa com os valores renderizados
+func (t *Template) RenderMap(values map[string]string) map[string]string {
+	rendered := make(map[string]string, len(values))
+	for key, value := range values {
+		rendered[key] = t.Render(value)
+	}
+	return rendered
+}
+
+// Err retorna um erro listando as variáveis referenciadas e não definidas
+func (t *Template) Err() error {
+	if len(t.missing) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(t.missing))
+	for name := range t.missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("undefined variables: %s", strings.Join(names, ", "))
+}
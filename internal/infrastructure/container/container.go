@@ -1,11 +1,17 @@
 package container
 
 import (
+	"log"
 	"time"
 
+	"TestGO/configs"
+	"TestGO/internal/application/auth"
+	"TestGO/internal/application/notifications"
+	"TestGO/internal/application/runner"
 	"TestGO/internal/application/services"
 	"TestGO/internal/domain/repositories"
 	sqlRepo "TestGO/internal/infrastructure/database/sql"
+	"TestGO/internal/infrastructure/email"
 	"TestGO/internal/infrastructure/security"
 	"TestGO/internal/interfaces/http/handlers"
 	"TestGO/internal/interfaces/http/middleware"
@@ -17,83 +23,257 @@ import (
 // Container gerencia todas as dependências da aplicação
 type Container struct {
 	// Repositories
-	UserRepository      repositories.UserRepository
-	CompanyRepository   repositories.CompanyRepository
-	TestSuiteRepository repositories.TestSuiteRepository
+	UserRepository            repositories.UserRepository
+	CompanyRepository         repositories.CompanyRepository
+	TestSuiteRepository       repositories.TestSuiteRepository
+	TestRunRepository         repositories.TestRunRepository
+	TestResultRepository      repositories.TestResultRepository
+	JSONSchemaRepository      repositories.JSONSchemaRepository
+	EnvironmentRepository     repositories.EnvironmentRepository
+	SecretRepository          repositories.SecretRepository
+	ScheduleRepository        repositories.ScheduleRepository
+	WebhookRepository         repositories.WebhookRepository
+	WebhookDeliveryRepository repositories.WebhookDeliveryRepository
+	ChatChannelRepository     repositories.ChatChannelRepository
+	RefreshTokenRepository    repositories.RefreshTokenRepository
+	TokenRevocationRepository repositories.TokenRevocationRepository
+	SessionRepository         repositories.SessionRepository
+	CompanyMemberRepository   repositories.CompanyMemberRepository
 
 	// Services
-	AuthService      interfaceServices.AuthService
-	UserService      interfaceServices.UserService
-	CompanyService   interfaceServices.CompanyService
-	TestSuiteService interfaceServices.TestSuiteService
+	Authorizer           interfaceServices.Authorizer
+	AuthService          interfaceServices.AuthService
+	UserService          interfaceServices.UserService
+	CompanyService       interfaceServices.CompanyService
+	TestSuiteService     interfaceServices.TestSuiteService
+	TestRunService       interfaceServices.TestRunService
+	JSONSchemaService    interfaceServices.JSONSchemaService
+	EnvironmentService   interfaceServices.EnvironmentService
+	SecretService        interfaceServices.SecretService
+	ScheduleService      interfaceServices.ScheduleService
+	WebhookService       interfaceServices.WebhookService
+	ChatChannelService   interfaceServices.ChatChannelService
+	ImportService        interfaceServices.ImportService
+	BundleService        interfaceServices.BundleService
+	CompanyMemberService interfaceServices.CompanyMemberService
 
 	// Infrastructure Services
-	PasswordService *security.PasswordService
-	JWTService      *security.JWTService
+	PasswordService   *security.PasswordService
+	JWTService        *security.JWTService
+	SecretCipher      *security.SecretCipher
+	Executor          *runner.Executor
+	WorkerPool        *runner.WorkerPool
+	Scheduler         *runner.Scheduler
+	RevocationStore   *auth.RevocationStore
+	WebhookDispatcher *notifications.WebhookDispatcher
+	EmailNotifier     *notifications.EmailNotifier
+	ChatNotifier      *notifications.ChatNotifier
 
 	// Handlers
-	AuthHandler      *handlers.AuthHandler
-	UserHandler      *handlers.UserHandler
-	CompanyHandler   *handlers.CompanyHandler
-	TestSuiteHandler *handlers.TestSuiteHandler
+	AuthHandler          *handlers.AuthHandler
+	UserHandler          *handlers.UserHandler
+	CompanyHandler       *handlers.CompanyHandler
+	TestSuiteHandler     *handlers.TestSuiteHandler
+	TestRunHandler       *handlers.TestRunHandler
+	JSONSchemaHandler    *handlers.JSONSchemaHandler
+	EnvironmentHandler   *handlers.EnvironmentHandler
+	SecretHandler        *handlers.SecretHandler
+	ScheduleHandler      *handlers.ScheduleHandler
+	WebhookHandler       *handlers.WebhookHandler
+	ChatChannelHandler   *handlers.ChatChannelHandler
+	ImportHandler        *handlers.ImportHandler
+	BundleHandler        *handlers.BundleHandler
+	SessionHandler       *handlers.SessionHandler
+	CompanyMemberHandler *handlers.CompanyMemberHandler
 
 	// Middleware
-	AuthMiddleware *middleware.AuthMiddleware
+	AuthMiddleware       *middleware.AuthMiddleware
+	PermissionMiddleware *middleware.PermissionMiddleware
 }
 
 // NewContainer cria uma nova instância do container
-func NewContainer(db *pgxpool.Pool, jwtSecret string) *Container {
+func NewContainer(db *pgxpool.Pool, cfg *configs.Config) *Container {
 	// Infrastructure Services
 	passwordService := security.NewPasswordService(12)
 	jwtService := security.NewJWTService(
-		jwtSecret,
+		cfg.JWTSecret,
 		15*time.Minute, // Access token expiry
 		7*24*time.Hour, // Refresh token expiry
 	)
+	secretCipher, err := security.NewSecretCipher(cfg.SecretsMasterKey)
+	if err != nil {
+		log.Fatalf("❌ [ERROR] Failed to create secret cipher: %v", err)
+	}
+	executor := runner.NewExecutor(cfg.Runner.RequestTimeout)
 
 	// Repositories
 	userRepo := sqlRepo.NewUserRepository(db)
+	refreshTokenRepo := sqlRepo.NewRefreshTokenRepository(db)
+	tokenRevocationRepo := sqlRepo.NewTokenRevocationRepository(db)
+	sessionRepo := sqlRepo.NewSessionRepository(db)
+	companyMemberRepo := sqlRepo.NewCompanyMemberRepository(db)
 	companyRepo := sqlRepo.NewCompanyRepository(db)
 	testSuiteRepo := sqlRepo.NewTestSuiteRepository(db)
+	testRunRepo := sqlRepo.NewTestRunRepository(db)
+	testResultRepo := sqlRepo.NewTestResultRepository(db)
+	jsonSchemaRepo := sqlRepo.NewJSONSchemaRepository(db)
+	environmentRepo := sqlRepo.NewEnvironmentRepository(db)
+	secretRepo := sqlRepo.NewSecretRepository(db)
+	scheduleRepo := sqlRepo.NewScheduleRepository(db)
+	webhookRepo := sqlRepo.NewWebhookRepository(db)
+	webhookDeliveryRepo := sqlRepo.NewWebhookDeliveryRepository(db)
+	chatChannelRepo := sqlRepo.NewChatChannelRepository(db)
+	transactor := sqlRepo.NewTransactor(db)
+
+	// Background Workers
+	webhookDispatcher := notifications.NewWebhookDispatcher(webhookRepo, webhookDeliveryRepo, secretCipher, notifications.DispatcherConfig{
+		Timeout: cfg.Runner.RequestTimeout,
+	})
+	emailNotifier := newEmailNotifier(cfg.SMTP, companyRepo, userRepo, testResultRepo, testSuiteRepo)
+	chatNotifier := notifications.NewChatNotifier(chatChannelRepo, companyRepo, testResultRepo, testSuiteRepo, secretCipher, cfg.Runner.RequestTimeout)
+	notifiers := []notifications.Notifier{webhookDispatcher, chatNotifier}
+	if emailNotifier != nil {
+		notifiers = append(notifiers, emailNotifier)
+	}
+	notificationPipeline := notifications.NewPipeline(notifiers...)
+	workerPool := runner.NewWorkerPool(testRunRepo, testResultRepo, testSuiteRepo, jsonSchemaRepo, environmentRepo, secretRepo, secretCipher, executor, webhookDispatcher, notificationPipeline, runner.PoolConfig{
+		Workers:            cfg.Runner.Workers,
+		CompanyConcurrency: cfg.Runner.CompanyConcurrency,
+	})
+	scheduler := runner.NewScheduler(scheduleRepo, workerPool, cfg.Runner.SchedulerInterval)
+	revocationStore := auth.NewRevocationStore(tokenRevocationRepo, 5*time.Second, time.Hour)
 
 	// Application Services
-	authService := services.NewAuthService(userRepo, passwordService, jwtService)
-	userService := services.NewUserService(userRepo, passwordService)
-	companyService := services.NewCompanyService(companyRepo)
-	testSuiteService := services.NewTestSuiteService(testSuiteRepo)
+	authorizer := services.NewAuthorizationService(companyMemberRepo)
+	authService := services.NewAuthService(userRepo, refreshTokenRepo, sessionRepo, revocationStore, passwordService, jwtService)
+	userService := services.NewUserService(userRepo, companyMemberRepo, passwordService)
+	companyService := services.NewCompanyService(companyRepo, authorizer)
+	testSuiteService := services.NewTestSuiteService(testSuiteRepo, jsonSchemaRepo, authorizer)
+	testRunService := services.NewTestRunService(testRunRepo, testResultRepo, testSuiteRepo, companyRepo, environmentRepo, workerPool, authorizer)
+	jsonSchemaService := services.NewJSONSchemaService(jsonSchemaRepo, authorizer)
+	environmentService := services.NewEnvironmentService(environmentRepo, authorizer)
+	secretService := services.NewSecretService(secretRepo, secretCipher, authorizer)
+	scheduleService := services.NewScheduleService(scheduleRepo, testSuiteRepo, environmentRepo, authorizer)
+	webhookService := services.NewWebhookService(webhookRepo, webhookDeliveryRepo, secretCipher, webhookDispatcher, authorizer)
+	chatChannelService := services.NewChatChannelService(chatChannelRepo, secretCipher, chatNotifier, authorizer)
+	importService := services.NewImportService(transactor, testSuiteRepo, environmentRepo, authorizer)
+	bundleService := services.NewBundleService(transactor, testSuiteRepo, environmentRepo, jsonSchemaRepo, authorizer)
+	companyMemberService := services.NewCompanyMemberService(companyMemberRepo, userRepo, authorizer)
 
 	// Handlers
 	authHandler := handlers.NewAuthHandler(authService)
 	userHandler := handlers.NewUserHandler(userService, companyService)
 	companyHandler := handlers.NewCompanyHandler(companyService)
 	testSuiteHandler := handlers.NewTestSuiteHandler(testSuiteService)
+	testRunHandler := handlers.NewTestRunHandler(testRunService)
+	jsonSchemaHandler := handlers.NewJSONSchemaHandler(jsonSchemaService)
+	environmentHandler := handlers.NewEnvironmentHandler(environmentService)
+	secretHandler := handlers.NewSecretHandler(secretService)
+	scheduleHandler := handlers.NewScheduleHandler(scheduleService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	chatChannelHandler := handlers.NewChatChannelHandler(chatChannelService)
+	importHandler := handlers.NewImportHandler(importService)
+	bundleHandler := handlers.NewBundleHandler(bundleService)
+	sessionHandler := handlers.NewSessionHandler(authService)
+	companyMemberHandler := handlers.NewCompanyMemberHandler(companyMemberService)
 
 	// Middleware
 	authMiddleware := middleware.NewAuthMiddleware(authService)
+	permissionMiddleware := middleware.NewPermissionMiddleware(authorizer)
 
 	return &Container{
 		// Repositories
-		UserRepository:      userRepo,
-		CompanyRepository:   companyRepo,
-		TestSuiteRepository: testSuiteRepo,
+		UserRepository:            userRepo,
+		CompanyRepository:         companyRepo,
+		TestSuiteRepository:       testSuiteRepo,
+		TestRunRepository:         testRunRepo,
+		TestResultRepository:      testResultRepo,
+		JSONSchemaRepository:      jsonSchemaRepo,
+		EnvironmentRepository:     environmentRepo,
+		SecretRepository:          secretRepo,
+		ScheduleRepository:        scheduleRepo,
+		WebhookRepository:         webhookRepo,
+		WebhookDeliveryRepository: webhookDeliveryRepo,
+		ChatChannelRepository:     chatChannelRepo,
+		RefreshTokenRepository:    refreshTokenRepo,
+		TokenRevocationRepository: tokenRevocationRepo,
+		SessionRepository:         sessionRepo,
+		CompanyMemberRepository:   companyMemberRepo,
 
 		// Services
-		AuthService:      authService,
-		UserService:      userService,
-		CompanyService:   companyService,
-		TestSuiteService: testSuiteService,
+		Authorizer:           authorizer,
+		AuthService:          authService,
+		UserService:          userService,
+		CompanyService:       companyService,
+		TestSuiteService:     testSuiteService,
+		TestRunService:       testRunService,
+		JSONSchemaService:    jsonSchemaService,
+		EnvironmentService:   environmentService,
+		SecretService:        secretService,
+		ScheduleService:      scheduleService,
+		WebhookService:       webhookService,
+		ChatChannelService:   chatChannelService,
+		ImportService:        importService,
+		BundleService:        bundleService,
+		CompanyMemberService: companyMemberService,
 
 		// Infrastructure Services
-		PasswordService: passwordService,
-		JWTService:      jwtService,
+		PasswordService:   passwordService,
+		JWTService:        jwtService,
+		SecretCipher:      secretCipher,
+		Executor:          executor,
+		WorkerPool:        workerPool,
+		Scheduler:         scheduler,
+		RevocationStore:   revocationStore,
+		WebhookDispatcher: webhookDispatcher,
+		EmailNotifier:     emailNotifier,
+		ChatNotifier:      chatNotifier,
 
 		// Handlers
-		AuthHandler:      authHandler,
-		UserHandler:      userHandler,
-		CompanyHandler:   companyHandler,
-		TestSuiteHandler: testSuiteHandler,
+		AuthHandler:          authHandler,
+		UserHandler:          userHandler,
+		CompanyHandler:       companyHandler,
+		TestSuiteHandler:     testSuiteHandler,
+		TestRunHandler:       testRunHandler,
+		JSONSchemaHandler:    jsonSchemaHandler,
+		EnvironmentHandler:   environmentHandler,
+		SecretHandler:        secretHandler,
+		ScheduleHandler:      scheduleHandler,
+		WebhookHandler:       webhookHandler,
+		ChatChannelHandler:   chatChannelHandler,
+		ImportHandler:        importHandler,
+		BundleHandler:        bundleHandler,
+		SessionHandler:       sessionHandler,
+		CompanyMemberHandler: companyMemberHandler,
 
 		// Middleware
-		AuthMiddleware: authMiddleware,
+		AuthMiddleware:       authMiddleware,
+		PermissionMiddleware: permissionMiddleware,
+	}
+}
+
+// newEmailNotifier cria o notificador por email; retorna nil quando o SMTP não está configurado
+func newEmailNotifier(
+	cfg configs.SMTPConfig,
+	companyRepo repositories.CompanyRepository,
+	userRepo repositories.UserRepository,
+	testResultRepo repositories.TestResultRepository,
+	testSuiteRepo repositories.TestSuiteRepository,
+) *notifications.EmailNotifier {
+	if cfg.Host == "" {
+		log.Println("⚠️  [WARN] SMTP_HOST not set, email alerts are disabled")
+		return nil
+	}
+
+	mailer, err := email.NewSMTPMailer(email.SMTPConfig{
+		Host:     cfg.Host,
+		Port:     cfg.Port,
+		Username: cfg.Username,
+		Password: cfg.Password,
+		From:     cfg.From,
+	})
+	if err != nil {
+		log.Fatalf("❌ [ERROR] Failed to create SMTP mailer: %v", err)
 	}
+	return notifications.NewEmailNotifier(mailer, companyRepo, userRepo, testResultRepo, testSuiteRepo)
 }
@@ -0,0 +1,171 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const chatChannelColumns = `id, company_id, name, provider, encrypted_url, only_failures, enabled, created_at, updated_at`
+
+type chatChannelRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewChatChannelRepository cria uma nova instância do repositório de canais de chat
+func NewChatChannelRepository(db *pgxpool.Pool) repositories.ChatChannelRepository {
+	return &chatChannelRepository{db: db}
+}
+
+// scanChatChannel lê uma linha de chat_channels na ordem de chatChannelColumns
+func scanChatChannel(row pgx.Row) (*entities.ChatChannel, error) {
+	var channel entities.ChatChannel
+	err := row.Scan(
+		&channel.ID,
+		&channel.CompanyID,
+		&channel.Name,
+		&channel.Provider,
+		&channel.EncryptedURL,
+		&channel.OnlyFailures,
+		&channel.Enabled,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// collectChatChannels lê todas as linhas de uma consulta de canais de chat
+func collectChatChannels(rows pgx.Rows) ([]*entities.ChatChannel, error) {
+	defer rows.Close()
+
+	var channels []*entities.ChatChannel
+	for rows.Next() {
+		channel, err := scanChatChannel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan chat channel: %w", err)
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, rows.Err()
+}
+
+func (r *chatChannelRepository) Create(ctx context.Context, channel *entities.ChatChannel) (*entities.ChatChannel, error) {
+	query := `
+		INSERT INTO chat_channels (id, company_id, name, provider, encrypted_url, only_failures, enabled, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING ` + chatChannelColumns
+
+	created, err := scanChatChannel(connFrom(ctx, r.db).QueryRow(ctx, query,
+		channel.ID,
+		channel.CompanyID,
+		channel.Name,
+		channel.Provider,
+		channel.EncryptedURL,
+		channel.OnlyFailures,
+		channel.Enabled,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat channel: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *chatChannelRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.ChatChannel, error) {
+	query := `
+		SELECT ` + chatChannelColumns + `
+		FROM chat_channels
+		WHERE company_id = $1 AND id = $2`
+
+	channel, err := scanChatChannel(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("chat channel %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get chat channel: %w", err)
+	}
+
+	return channel, nil
+}
+
+func (r *chatChannelRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error) {
+	query := `
+		SELECT ` + chatChannelColumns + `
+		FROM chat_channels
+		WHERE company_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat channels by company: %w", err)
+	}
+
+	return collectChatChannels(rows)
+}
+
+func (r *chatChannelRepository) GetEnabledByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error) {
+	query := `
+		SELECT ` + chatChannelColumns + `
+		FROM chat_channels
+		WHERE company_id = $1 AND enabled
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled chat channels: %w", err)
+	}
+
+	return collectChatChannels(rows)
+}
+
+func (r *chatChannelRepository) Update(ctx context.Context, channel *entities.ChatChannel) error {
+	query := `
+		UPDATE chat_channels
+		SET name = $3, encrypted_url = $4, only_failures = $5, enabled = $6, updated_at = NOW()
+		WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		channel.CompanyID,
+		channel.ID,
+		channel.Name,
+		channel.EncryptedURL,
+		channel.OnlyFailures,
+		channel.Enabled,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update chat channel: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("chat channel %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *chatChannelRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM chat_channels WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete chat channel: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("chat channel %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation é o código do PostgreSQL para violação de chave única
+const uniqueViolation = "23505"
+
+const companyMemberColumns = `company_id, user_id, role, created_at, updated_at`
+
+// resourceTables mapeia cada tipo de recurso para a tabela que o guarda; o nome da tabela entra
+// na consulta, então apenas os tipos listados aqui são aceitos
+var resourceTables = map[entities.ResourceType]string{
+	entities.ResourceTestSuite:   "test_suites",
+	entities.ResourceTestRun:     "test_runs",
+	entities.ResourceJSONSchema:  "json_schemas",
+	entities.ResourceWebhook:     "webhooks",
+	entities.ResourceChatChannel: "chat_channels",
+}
+
+type companyMemberRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewCompanyMemberRepository cria uma nova instância do repositório de membros das empresas
+func NewCompanyMemberRepository(db *pgxpool.Pool) repositories.CompanyMemberRepository {
+	return &companyMemberRepository{db: db}
+}
+
+// scanCompanyMember lê uma linha de company_members na ordem de companyMemberColumns
+func scanCompanyMember(row pgx.Row) (*entities.CompanyMember, error) {
+	var member entities.CompanyMember
+	err := row.Scan(
+		&member.CompanyID,
+		&member.UserID,
+		&member.Role,
+		&member.CreatedAt,
+		&member.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *companyMemberRepository) Create(ctx context.Context, member *entities.CompanyMember) error {
+	query := `
+		INSERT INTO company_members (company_id, user_id, role, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := connFrom(ctx, r.db).Exec(ctx, query,
+		member.CompanyID,
+		member.UserID,
+		member.Role,
+		member.CreatedAt.UTC(),
+		member.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("company member %w", domainErrors.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to create company member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *companyMemberRepository) Get(ctx context.Context, companyID, userID uuid.UUID) (*entities.CompanyMember, error) {
+	query := `
+		SELECT ` + companyMemberColumns + `
+		FROM company_members
+		WHERE company_id = $1 AND user_id = $2`
+
+	member, err := scanCompanyMember(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("company member %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get company member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (r *companyMemberRepository) ListByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error) {
+	query := `
+		SELECT ` + companyMemberColumns + `
+		FROM company_members
+		WHERE company_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*entities.CompanyMember
+	for rows.Next() {
+		member, err := scanCompanyMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan company member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+func (r *companyMemberRepository) UpdateRole(ctx context.Context, companyID, userID uuid.UUID, role entities.CompanyRole) (*entities.CompanyMember, error) {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if role != entities.CompanyRoleOwner {
+		if err := ensureAnotherOwner(ctx, tx, companyID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		UPDATE company_members
+		SET role = $3, updated_at = NOW()
+		WHERE company_id = $1 AND user_id = $2
+		RETURNING ` + companyMemberColumns
+
+	member, err := scanCompanyMember(tx.QueryRow(ctx, query, companyID, userID, role))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("company member %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to update company member: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit company member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (r *companyMemberRepository) Delete(ctx context.Context, companyID, userID uuid.UUID) error {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := ensureAnotherOwner(ctx, tx, companyID, userID); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM company_members WHERE company_id = $1 AND user_id = $2`, companyID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete company member: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("company member %w", domainErrors.ErrNotFound)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit company member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *companyMemberRepository) GetResourceCompanyID(ctx context.Context, userID uuid.UUID, resource entities.ResourceType, id uuid.UUID) (uuid.UUID, error) {
+	table, ok := resourceTables[resource]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("unknown resource type %q", resource)
+	}
+	name := strings.ReplaceAll(string(resource), "_", " ")
+
+	query := `
+		SELECT r.company_id
+		FROM ` + table + ` r
+		JOIN company_members m ON m.company_id = r.company_id
+		WHERE r.id = $1 AND m.user_id = $2`
+
+	var companyID uuid.UUID
+	if err := connFrom(ctx, r.db).QueryRow(ctx, query, id, userID).Scan(&companyID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("%s %w", name, domainErrors.ErrNotFound)
+		}
+		return uuid.Nil, fmt.Errorf("failed to get %s company: %w", name, err)
+	}
+
+	return companyID, nil
+}
+
+// ensureAnotherOwner recusa a remoção do papel de owner do usuário quando ele é o único owner da empresa.
+// Os owners ficam bloqueados até o fim da transação, para que dois owners não rebaixem um ao outro ao mesmo tempo.
+func ensureAnotherOwner(ctx context.Context, tx pgx.Tx, companyID, userID uuid.UUID) error {
+	query := `
+		SELECT user_id
+		FROM company_members
+		WHERE company_id = $1 AND role = $2
+		FOR UPDATE`
+
+	rows, err := tx.Query(ctx, query, companyID, entities.CompanyRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to lock company owners: %w", err)
+	}
+	defer rows.Close()
+
+	isOwner, others := false, 0
+	for rows.Next() {
+		var ownerID uuid.UUID
+		if err := rows.Scan(&ownerID); err != nil {
+			return fmt.Errorf("failed to scan company owner: %w", err)
+		}
+		if ownerID == userID {
+			isOwner = true
+		} else {
+			others++
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to lock company owners: %w", err)
+	}
+
+	if isOwner && others == 0 {
+		return fmt.Errorf("%w: company must have at least one owner", domainErrors.ErrConflict)
+	}
+	return nil
+}
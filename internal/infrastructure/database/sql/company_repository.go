@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 )
 
@@ -26,7 +27,7 @@ func (r *companyRepository) Create(ctx context.Context, company *entities.Compan
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 	
-	_, err := r.db.Exec(ctx, query,
+	_, err := connFrom(ctx, r.db).Exec(ctx, query,
 		company.ID,
 		company.Name,
 		company.Email,
@@ -39,6 +40,51 @@ func (r *companyRepository) Create(ctx context.Context, company *entities.Compan
 	return err
 }
 
+// CreateWithOwner cria a empresa e o seu primeiro owner na mesma transação
+func (r *companyRepository) CreateWithOwner(ctx context.Context, company *entities.Company, owner *entities.CompanyMember) error {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO companies (id, name, email, phone, address, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err = tx.Exec(ctx, query,
+		company.ID,
+		company.Name,
+		company.Email,
+		company.Phone,
+		company.Address,
+		company.CreatedAt,
+		company.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert company: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO company_members (company_id, user_id, role, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		owner.CompanyID,
+		owner.UserID,
+		owner.Role,
+		owner.CreatedAt.UTC(),
+		owner.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert company owner: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit company: %w", err)
+	}
+
+	return nil
+}
+
 func (r *companyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Company, error) {
 	query := `
 		SELECT id, name, email, phone, address, created_at, updated_at
@@ -47,7 +93,7 @@ func (r *companyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entitie
 	`
 	
 	company := &entities.Company{}
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, id).Scan(
 		&company.ID,
 		&company.Name,
 		&company.Email,
@@ -59,7 +105,7 @@ func (r *companyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entitie
 	
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("company not found")
+			return nil, fmt.Errorf("company %w", domainErrors.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -75,7 +121,7 @@ func (r *companyRepository) GetByName(ctx context.Context, name string) (*entiti
 	`
 	
 	company := &entities.Company{}
-	err := r.db.QueryRow(ctx, query, name).Scan(
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, name).Scan(
 		&company.ID,
 		&company.Name,
 		&company.Email,
@@ -87,7 +133,7 @@ func (r *companyRepository) GetByName(ctx context.Context, name string) (*entiti
 	
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("company not found")
+			return nil, fmt.Errorf("company %w", domainErrors.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -103,7 +149,7 @@ func (r *companyRepository) GetByEmail(ctx context.Context, email string) (*enti
 	`
 	
 	company := &entities.Company{}
-	err := r.db.QueryRow(ctx, query, email).Scan(
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, email).Scan(
 		&company.ID,
 		&company.Name,
 		&company.Email,
@@ -115,7 +161,7 @@ func (r *companyRepository) GetByEmail(ctx context.Context, email string) (*enti
 	
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("company not found")
+			return nil, fmt.Errorf("company %w", domainErrors.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -130,7 +176,7 @@ func (r *companyRepository) Update(ctx context.Context, company *entities.Compan
 		WHERE id = $1
 	`
 	
-	_, err := r.db.Exec(ctx, query,
+	_, err := connFrom(ctx, r.db).Exec(ctx, query,
 		company.ID,
 		company.Name,
 		company.Email,
@@ -144,19 +190,21 @@ func (r *companyRepository) Update(ctx context.Context, company *entities.Compan
 
 func (r *companyRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM companies WHERE id = $1`
-	_, err := r.db.Exec(ctx, query, id)
+	_, err := connFrom(ctx, r.db).Exec(ctx, query, id)
 	return err
 }
 
-func (r *companyRepository) List(ctx context.Context, limit, offset int) ([]*entities.Company, error) {
+func (r *companyRepository) ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Company, error) {
 	query := `
-		SELECT id, name, email, phone, address, created_at, updated_at
-		FROM companies
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
+		SELECT c.id, c.name, c.email, c.phone, c.address, c.created_at, c.updated_at
+		FROM companies c
+		JOIN company_members m ON m.company_id = c.id
+		WHERE m.user_id = $1
+		ORDER BY c.created_at DESC
+		LIMIT $2 OFFSET $3
 	`
 	
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -186,13 +234,13 @@ func (r *companyRepository) List(ctx context.Context, limit, offset int) ([]*ent
 func (r *companyRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
 	query := `SELECT EXISTS(SELECT 1 FROM companies WHERE name = $1)`
 	var exists bool
-	err := r.db.QueryRow(ctx, query, name).Scan(&exists)
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, name).Scan(&exists)
 	return exists, err
 }
 
 func (r *companyRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	query := `SELECT EXISTS(SELECT 1 FROM companies WHERE email = $1)`
 	var exists bool
-	err := r.db.QueryRow(ctx, query, email).Scan(&exists)
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, email).Scan(&exists)
 	return exists, err
 }
\ No newline at end of file
@@ -0,0 +1,149 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const environmentColumns = `id, company_id, name, variables, created_at, updated_at`
+
+type environmentRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewEnvironmentRepository cria uma nova instância do repositório de ambientes
+func NewEnvironmentRepository(db *pgxpool.Pool) repositories.EnvironmentRepository {
+	return &environmentRepository{db: db}
+}
+
+// scanEnvironment lê uma linha de environments na ordem de environmentColumns
+func scanEnvironment(row pgx.Row) (*entities.Environment, error) {
+	var environment entities.Environment
+	err := row.Scan(
+		&environment.ID,
+		&environment.CompanyID,
+		&environment.Name,
+		&environment.Variables,
+		&environment.CreatedAt,
+		&environment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &environment, nil
+}
+
+func (r *environmentRepository) Create(ctx context.Context, environment *entities.Environment) (*entities.Environment, error) {
+	query := `
+		INSERT INTO environments (id, company_id, name, variables, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING ` + environmentColumns
+
+	created, err := scanEnvironment(connFrom(ctx, r.db).QueryRow(ctx, query,
+		environment.ID,
+		environment.CompanyID,
+		environment.Name,
+		environment.Variables,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create environment: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *environmentRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Environment, error) {
+	query := `
+		SELECT ` + environmentColumns + `
+		FROM environments
+		WHERE company_id = $1 AND id = $2`
+
+	environment, err := scanEnvironment(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("environment %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	return environment, nil
+}
+
+func (r *environmentRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Environment, error) {
+	query := `
+		SELECT ` + environmentColumns + `
+		FROM environments
+		WHERE company_id = $1
+		ORDER BY name ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environments by company: %w", err)
+	}
+	defer rows.Close()
+
+	var environments []*entities.Environment
+	for rows.Next() {
+		environment, err := scanEnvironment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan environment: %w", err)
+		}
+		environments = append(environments, environment)
+	}
+
+	return environments, rows.Err()
+}
+
+func (r *environmentRepository) Update(ctx context.Context, environment *entities.Environment) error {
+	query := `
+		UPDATE environments
+		SET name = $3, variables = $4, updated_at = NOW()
+		WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		environment.CompanyID,
+		environment.ID,
+		environment.Name,
+		environment.Variables,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update environment: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("environment %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *environmentRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM environments WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete environment: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("environment %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *environmentRepository) ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM environments WHERE company_id = $1 AND name = $2)`
+	var exists bool
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, companyID, name).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,153 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const jsonSchemaColumns = `id, company_id, name, description, schema, created_at, updated_at`
+
+// foreignKeyViolation é o código do Postgres para violação de chave estrangeira
+const foreignKeyViolation = "23503"
+
+type jsonSchemaRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewJSONSchemaRepository cria uma nova instância do repositório de JSON Schemas
+func NewJSONSchemaRepository(db *pgxpool.Pool) repositories.JSONSchemaRepository {
+	return &jsonSchemaRepository{db: db}
+}
+
+// scanJSONSchema lê uma linha de json_schemas na ordem de jsonSchemaColumns
+func scanJSONSchema(row pgx.Row) (*entities.JSONSchema, error) {
+	var schema entities.JSONSchema
+	err := row.Scan(
+		&schema.ID,
+		&schema.CompanyID,
+		&schema.Name,
+		&schema.Description,
+		&schema.Schema,
+		&schema.CreatedAt,
+		&schema.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+func (r *jsonSchemaRepository) Create(ctx context.Context, schema *entities.JSONSchema) (*entities.JSONSchema, error) {
+	query := `
+		INSERT INTO json_schemas (id, company_id, name, description, schema, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING ` + jsonSchemaColumns
+
+	created, err := scanJSONSchema(connFrom(ctx, r.db).QueryRow(ctx, query,
+		schema.ID,
+		schema.CompanyID,
+		schema.Name,
+		schema.Description,
+		schema.Schema,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create json schema: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *jsonSchemaRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.JSONSchema, error) {
+	query := `
+		SELECT ` + jsonSchemaColumns + `
+		FROM json_schemas
+		WHERE company_id = $1 AND id = $2`
+
+	schema, err := scanJSONSchema(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("json schema %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get json schema: %w", err)
+	}
+
+	return schema, nil
+}
+
+func (r *jsonSchemaRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.JSONSchema, error) {
+	query := `
+		SELECT ` + jsonSchemaColumns + `
+		FROM json_schemas
+		WHERE company_id = $1
+		ORDER BY name ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json schemas by company: %w", err)
+	}
+	defer rows.Close()
+
+	var schemas []*entities.JSONSchema
+	for rows.Next() {
+		schema, err := scanJSONSchema(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan json schema: %w", err)
+		}
+		schemas = append(schemas, schema)
+	}
+
+	return schemas, rows.Err()
+}
+
+func (r *jsonSchemaRepository) Update(ctx context.Context, schema *entities.JSONSchema) error {
+	query := `
+		UPDATE json_schemas
+		SET name = $3, description = $4, schema = $5, updated_at = NOW()
+		WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		schema.CompanyID,
+		schema.ID,
+		schema.Name,
+		schema.Description,
+		schema.Schema,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update json schema: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("json schema %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *jsonSchemaRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM json_schemas WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return fmt.Errorf("%w: json schema is in use by test suites", domainErrors.ErrConflict)
+		}
+		return fmt.Errorf("failed to delete json schema: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("json schema %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,526 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// testDatabaseURLEnv aponta para um Postgres descartável usado pelos testes dos repositórios.
+// Sem ela os testes são ignorados.
+const testDatabaseURLEnv = "TEST_DATABASE_URL"
+
+// migrationsDir é o diretório das migrations relativo a este pacote
+const migrationsDir = "../../../../migrations"
+
+// newTestPool cria um schema próprio para o teste, aplica as migrations nele e devolve um pool
+// que o usa como search_path. O schema é removido ao fim do teste.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dbURL := os.Getenv(testDatabaseURLEnv)
+	if dbURL == "" {
+		t.Skipf("%s não definido", testDatabaseURLEnv)
+	}
+	ctx := context.Background()
+
+	admin, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(admin.Close)
+
+	schema := "repo_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.Exec(ctx, `CREATE SCHEMA `+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(context.Background(), `DROP SCHEMA `+schema+` CASCADE`); err != nil {
+			t.Errorf("failed to drop schema: %v", err)
+		}
+	})
+
+	config, err := pgxpool.ParseConfig(dbURL)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", testDatabaseURLEnv, err)
+	}
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	applyMigrations(t, pool)
+	return pool
+}
+
+// applyMigrations executa a seção "+goose Up" de cada migration, em ordem
+func applyMigrations(t *testing.T, pool *pgxpool.Pool) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(migrationsDir, "*.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no migrations found in %s: %v", migrationsDir, err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		up, _, _ := strings.Cut(string(content), "-- +goose Down")
+		// Sem argumentos o pgx usa o protocolo simples, que aceita vários comandos
+		if _, err := pool.Exec(context.Background(), up); err != nil {
+			t.Fatalf("failed to apply %s: %v", filepath.Base(file), err)
+		}
+	}
+}
+
+// fixture são duas empresas com um membro cada, além de um usuário que pertence às duas
+type fixture struct {
+	home, other          uuid.UUID
+	homeUser, otherUser  uuid.UUID
+	sharedUser, loneUser uuid.UUID
+	memberRepo           *companyMemberRepository
+	userRepo             *userRepository
+	suiteRepo            *testSuiteRepository
+	webhookRepo          *webhookRepository
+	deliveryRepo         *webhookDeliveryRepository
+	jsonSchemaRepo       *jsonSchemaRepository
+	chatChannelRepo      *chatChannelRepository
+	environmentRepo      *environmentRepository
+	testRunRepo          *testRunRepository
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	pool := newTestPool(t)
+	ctx := context.Background()
+	f := &fixture{
+		memberRepo:      &companyMemberRepository{db: pool},
+		userRepo:        &userRepository{db: pool},
+		suiteRepo:       &testSuiteRepository{db: pool},
+		webhookRepo:     &webhookRepository{db: pool},
+		deliveryRepo:    &webhookDeliveryRepository{db: pool},
+		jsonSchemaRepo:  &jsonSchemaRepository{db: pool},
+		chatChannelRepo: &chatChannelRepository{db: pool},
+		environmentRepo: &environmentRepository{db: pool},
+		testRunRepo:     &testRunRepository{db: pool},
+	}
+
+	companyRepo := NewCompanyRepository(pool)
+	newCompany := func(name string) uuid.UUID {
+		company := entities.NewCompany(name, name+"@example.com", "", "")
+		if err := companyRepo.Create(ctx, company); err != nil {
+			t.Fatalf("failed to create company: %v", err)
+		}
+		return company.ID
+	}
+	newUser := func(name string, companies ...uuid.UUID) uuid.UUID {
+		user, err := f.userRepo.Create(ctx, entities.NewUser(name, name+"@example.com", "hash"))
+		if err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		for _, companyID := range companies {
+			if err := f.memberRepo.Create(ctx, entities.NewCompanyMember(companyID, user.ID, entities.CompanyRoleEditor)); err != nil {
+				t.Fatalf("failed to create member: %v", err)
+			}
+		}
+		return user.ID
+	}
+
+	f.home = newCompany("home")
+	f.other = newCompany("other")
+	f.homeUser = newUser("home", f.home)
+	f.otherUser = newUser("other", f.other)
+	f.sharedUser = newUser("shared", f.home, f.other)
+	f.loneUser = newUser("lone")
+	return f
+}
+
+// scopedRepository é o contrato comum dos repositórios restritos à empresa dona do registro
+type scopedRepository[T any] interface {
+	Create(ctx context.Context, row *T) (*T, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*T, error)
+	Update(ctx context.Context, row *T) error
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+// scopedCase cria um registro na empresa informada e executa as operações restritas sobre ele
+type scopedCase struct {
+	name   string
+	create func(ctx context.Context, companyID uuid.UUID) (uuid.UUID, error)
+	get    func(ctx context.Context, companyID, id uuid.UUID) error
+	// update busca o registro na empresa dona e tenta gravá-lo como se fosse da empresa informada
+	update func(ctx context.Context, owner, companyID, id uuid.UUID) error
+	delete func(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+func newScopedCase[T any](name string, repo scopedRepository[T], build func(companyID uuid.UUID) *T, key func(*T) (id uuid.UUID, company *uuid.UUID)) scopedCase {
+	return scopedCase{
+		name: name,
+		create: func(ctx context.Context, companyID uuid.UUID) (uuid.UUID, error) {
+			row, err := repo.Create(ctx, build(companyID))
+			if err != nil {
+				return uuid.Nil, err
+			}
+			id, _ := key(row)
+			return id, nil
+		},
+		get: func(ctx context.Context, companyID, id uuid.UUID) error {
+			_, err := repo.GetByID(ctx, companyID, id)
+			return err
+		},
+		update: func(ctx context.Context, owner, companyID, id uuid.UUID) error {
+			row, err := repo.GetByID(ctx, owner, id)
+			if err != nil {
+				return err
+			}
+			_, company := key(row)
+			*company = companyID
+			return repo.Update(ctx, row)
+		},
+		delete: repo.Delete,
+	}
+}
+
+func (f *fixture) scopedCases() []scopedCase {
+	return []scopedCase{
+		newScopedCase[entities.TestSuite]("test suite", f.suiteRepo,
+			func(companyID uuid.UUID) *entities.TestSuite {
+				return entities.NewTestSuite(companyID, "suite", "GET", "https://example.com", nil, 200, "")
+			},
+			func(s *entities.TestSuite) (uuid.UUID, *uuid.UUID) { return s.ID, &s.CompanyID }),
+		newScopedCase[entities.JSONSchema]("json schema", f.jsonSchemaRepo,
+			func(companyID uuid.UUID) *entities.JSONSchema {
+				return entities.NewJSONSchema(companyID, "schema", "", json.RawMessage(`{"type":"object"}`))
+			},
+			func(s *entities.JSONSchema) (uuid.UUID, *uuid.UUID) { return s.ID, &s.CompanyID }),
+		newScopedCase[entities.Environment]("environment", f.environmentRepo,
+			func(companyID uuid.UUID) *entities.Environment {
+				return entities.NewEnvironment(companyID, "env-"+uuid.NewString(), map[string]string{"host": "example.com"})
+			},
+			func(e *entities.Environment) (uuid.UUID, *uuid.UUID) { return e.ID, &e.CompanyID }),
+		newScopedCase[entities.Webhook]("webhook", f.webhookRepo,
+			func(companyID uuid.UUID) *entities.Webhook {
+				webhook := entities.NewWebhook(companyID, "https://example.com/hook", []entities.WebhookEvent{entities.WebhookEventPing}, true)
+				webhook.SetEncryptedSecret([]byte("secret"))
+				return webhook
+			},
+			func(w *entities.Webhook) (uuid.UUID, *uuid.UUID) { return w.ID, &w.CompanyID }),
+		newScopedCase[entities.ChatChannel]("chat channel", f.chatChannelRepo,
+			func(companyID uuid.UUID) *entities.ChatChannel {
+				channel := entities.NewChatChannel(companyID, "channel", entities.ChatProviderSlack, false, true)
+				channel.SetEncryptedURL([]byte("url"))
+				return channel
+			},
+			func(c *entities.ChatChannel) (uuid.UUID, *uuid.UUID) { return c.ID, &c.CompanyID }),
+		newScopedCase[entities.TestRun]("test run", f.testRunRepo,
+			func(companyID uuid.UUID) *entities.TestRun {
+				return entities.NewTestRun(companyID, []uuid.UUID{uuid.New()}, nil)
+			},
+			func(r *entities.TestRun) (uuid.UUID, *uuid.UUID) { return r.ID, &r.CompanyID }),
+	}
+}
+
+// TestScopedRepositoriesRejectOtherCompany garante que Get, Update e Delete filtram pela empresa no SQL
+func TestScopedRepositoriesRejectOtherCompany(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	for _, tc := range f.scopedCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := tc.create(ctx, f.home)
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			if err := tc.get(ctx, f.other, id); !errors.Is(err, domainErrors.ErrNotFound) {
+				t.Errorf("get from other company: got %v, want not found", err)
+			}
+			if err := tc.update(ctx, f.home, f.other, id); !errors.Is(err, domainErrors.ErrNotFound) {
+				t.Errorf("update as other company: got %v, want not found", err)
+			}
+			if err := tc.delete(ctx, f.other, id); !errors.Is(err, domainErrors.ErrNotFound) {
+				t.Errorf("delete from other company: got %v, want not found", err)
+			}
+
+			if err := tc.get(ctx, f.home, id); err != nil {
+				t.Errorf("get from owner: %v", err)
+			}
+			if err := tc.update(ctx, f.home, f.home, id); err != nil {
+				t.Errorf("update as owner: %v", err)
+			}
+			if err := tc.delete(ctx, f.home, id); err != nil {
+				t.Errorf("delete from owner: %v", err)
+			}
+			if err := tc.get(ctx, f.home, id); !errors.Is(err, domainErrors.ErrNotFound) {
+				t.Errorf("get after delete: got %v, want not found", err)
+			}
+		})
+	}
+}
+
+// TestGetResourceCompanyID garante que só membros da empresa dona enxergam o recurso
+func TestGetResourceCompanyID(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	resources := map[string]entities.ResourceType{
+		"test suite":   entities.ResourceTestSuite,
+		"json schema":  entities.ResourceJSONSchema,
+		"webhook":      entities.ResourceWebhook,
+		"chat channel": entities.ResourceChatChannel,
+		"test run":     entities.ResourceTestRun,
+	}
+
+	for _, tc := range f.scopedCases() {
+		resource, ok := resources[tc.name]
+		if !ok {
+			continue
+		}
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := tc.create(ctx, f.home)
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			tests := []struct {
+				name    string
+				userID  uuid.UUID
+				want    uuid.UUID
+				wantErr error
+			}{
+				{name: "member", userID: f.homeUser, want: f.home},
+				{name: "member of both companies", userID: f.sharedUser, want: f.home},
+				{name: "member of other company", userID: f.otherUser, wantErr: domainErrors.ErrNotFound},
+				{name: "member of no company", userID: f.loneUser, wantErr: domainErrors.ErrNotFound},
+			}
+			for _, tt := range tests {
+				got, err := f.memberRepo.GetResourceCompanyID(ctx, tt.userID, resource, id)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+				}
+				if got != tt.want {
+					t.Errorf("%s: got company %s, want %s", tt.name, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestListTestSuitesByCompanyID garante que a paginação acontece no SQL e que o total conta só a empresa
+func TestListTestSuitesByCompanyID(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if _, err := f.suiteRepo.Create(ctx, entities.NewTestSuite(f.home, fmt.Sprintf("home %d", i), "GET", "https://example.com", nil, 200, "")); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := f.suiteRepo.Create(ctx, entities.NewTestSuite(f.other, fmt.Sprintf("other %d", i), "GET", "https://example.com", nil, 200, "")); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	total, err := f.suiteRepo.CountByCompanyID(ctx, f.home)
+	if err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if total != 5 {
+		t.Errorf("total: got %d, want 5", total)
+	}
+
+	tests := []struct {
+		name          string
+		limit, offset int
+		want          int
+	}{
+		{name: "first page", limit: 2, offset: 0, want: 2},
+		{name: "last page", limit: 2, offset: 4, want: 1},
+		{name: "past the end", limit: 2, offset: 6, want: 0},
+		{name: "everything", limit: 10, offset: 0, want: 5},
+	}
+	seen := map[uuid.UUID]bool{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suites, err := f.suiteRepo.ListByCompanyID(ctx, f.home, tt.limit, tt.offset)
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if len(suites) != tt.want {
+				t.Errorf("got %d suites, want %d", len(suites), tt.want)
+			}
+			for _, suite := range suites {
+				if suite.CompanyID != f.home {
+					t.Errorf("suite %s belongs to %s", suite.ID, suite.CompanyID)
+				}
+				seen[suite.ID] = true
+			}
+		})
+	}
+	if len(seen) != 5 {
+		t.Errorf("pages returned %d distinct suites, want 5", len(seen))
+	}
+}
+
+// TestWebhookDeliveriesByWebhookID garante que as entregas só são listadas para a empresa dona do webhook
+func TestWebhookDeliveriesByWebhookID(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	webhook := entities.NewWebhook(f.home, "https://example.com/hook", []entities.WebhookEvent{entities.WebhookEventPing}, true)
+	webhook.SetEncryptedSecret([]byte("secret"))
+	webhook, err := f.webhookRepo.Create(ctx, webhook)
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+	created, err := f.deliveryRepo.Create(ctx, entities.NewWebhookDelivery(webhook, entities.WebhookEventPing, json.RawMessage(`{}`)))
+	if err != nil {
+		t.Fatalf("create delivery: %v", err)
+	}
+	if created.CompanyID != f.home {
+		t.Errorf("created delivery company: got %s, want %s", created.CompanyID, f.home)
+	}
+
+	tests := []struct {
+		name      string
+		companyID uuid.UUID
+		want      int
+	}{
+		{name: "owner", companyID: f.home, want: 1},
+		{name: "other company", companyID: f.other, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliveries, err := f.deliveryRepo.GetByWebhookID(ctx, tt.companyID, webhook.ID, 10)
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if len(deliveries) != tt.want {
+				t.Errorf("got %d deliveries, want %d", len(deliveries), tt.want)
+			}
+		})
+	}
+}
+
+// TestUsersVisibleTo garante que cada usuário enxerga apenas a si mesmo e quem divide alguma empresa com ele
+func TestUsersVisibleTo(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		viewer  uuid.UUID
+		visible []uuid.UUID
+		hidden  []uuid.UUID
+	}{
+		{name: "member of one company", viewer: f.homeUser, visible: []uuid.UUID{f.homeUser, f.sharedUser}, hidden: []uuid.UUID{f.otherUser, f.loneUser}},
+		{name: "member of both companies", viewer: f.sharedUser, visible: []uuid.UUID{f.homeUser, f.otherUser, f.sharedUser}, hidden: []uuid.UUID{f.loneUser}},
+		{name: "member of no company", viewer: f.loneUser, visible: []uuid.UUID{f.loneUser}, hidden: []uuid.UUID{f.homeUser, f.sharedUser}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, err := f.userRepo.CountVisibleTo(ctx, tt.viewer)
+			if err != nil {
+				t.Fatalf("count: %v", err)
+			}
+			if total != int64(len(tt.visible)) {
+				t.Errorf("total: got %d, want %d", total, len(tt.visible))
+			}
+
+			users, err := f.userRepo.ListVisibleTo(ctx, tt.viewer, 10, 0)
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if len(users) != len(tt.visible) {
+				t.Errorf("got %d users, want %d", len(users), len(tt.visible))
+			}
+
+			for _, id := range tt.visible {
+				if _, err := f.userRepo.GetVisibleTo(ctx, tt.viewer, id); err != nil {
+					t.Errorf("get visible user %s: %v", id, err)
+				}
+			}
+			for _, id := range tt.hidden {
+				if _, err := f.userRepo.GetVisibleTo(ctx, tt.viewer, id); !errors.Is(err, domainErrors.ErrNotFound) {
+					t.Errorf("get hidden user %s: got %v, want not found", id, err)
+				}
+			}
+		})
+	}
+}
+
+// TestRequeueStaleByHeartbeat garante que só execuções com o heartbeat expirado voltam à fila,
+// mesmo que a execução tenha começado há mais tempo que o limite
+func TestRequeueStaleByHeartbeat(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	claim := func() uuid.UUID {
+		if _, err := f.testRunRepo.Create(ctx, entities.NewTestRun(f.home, nil, nil)); err != nil {
+			t.Fatalf("create test run: %v", err)
+		}
+		testRun, err := f.testRunRepo.ClaimNext(ctx, 10)
+		if err != nil || testRun == nil {
+			t.Fatalf("claim test run: %v", err)
+		}
+		return testRun.ID
+	}
+	recent, renewed, expired := claim(), claim(), claim()
+
+	// Simula execuções iniciadas há dez minutos; só a renovada teve o heartbeat atualizado depois
+	if _, err := f.testRunRepo.db.Exec(ctx, `UPDATE test_runs SET heartbeat_at = NOW() - interval '10 minutes', updated_at = NOW() - interval '10 minutes' WHERE id = ANY($1)`, []uuid.UUID{renewed, expired}); err != nil {
+		t.Fatalf("age test runs: %v", err)
+	}
+	if err := f.testRunRepo.Heartbeat(ctx, []uuid.UUID{renewed}); err != nil {
+		t.Fatalf("heartbeat: %v", err)
+	}
+
+	requeued, err := f.testRunRepo.RequeueStale(ctx, time.Now().Add(-5*time.Minute))
+	if err != nil {
+		t.Fatalf("requeue stale: %v", err)
+	}
+	if requeued != 1 {
+		t.Errorf("requeued %d test runs, want 1", requeued)
+	}
+
+	tests := []struct {
+		name string
+		id   uuid.UUID
+		want entities.TestRunStatus
+	}{
+		{name: "recently claimed", id: recent, want: entities.TestRunStatusRunning},
+		{name: "heartbeat renewed", id: renewed, want: entities.TestRunStatusRunning},
+		{name: "heartbeat expired", id: expired, want: entities.TestRunStatusQueued},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testRun, err := f.testRunRepo.GetByID(ctx, f.home, tt.id)
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			if testRun.Status != tt.want {
+				t.Errorf("status = %s, want %s", testRun.Status, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const scheduleColumns = `id, company_id, name, cron_expression, timezone, test_suite_ids, environment_id, enabled, next_run_at, last_run_at, created_at, updated_at`
+
+type scheduleRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewScheduleRepository cria uma nova instância do repositório de agendamentos
+func NewScheduleRepository(db *pgxpool.Pool) repositories.ScheduleRepository {
+	return &scheduleRepository{db: db}
+}
+
+// scanSchedule lê uma linha de schedules na ordem de scheduleColumns
+func scanSchedule(row pgx.Row) (*entities.Schedule, error) {
+	var schedule entities.Schedule
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.CompanyID,
+		&schedule.Name,
+		&schedule.CronExpression,
+		&schedule.Timezone,
+		&schedule.TestSuiteIDs,
+		&schedule.EnvironmentID,
+		&schedule.Enabled,
+		&schedule.NextRunAt,
+		&schedule.LastRunAt,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+func (r *scheduleRepository) Create(ctx context.Context, schedule *entities.Schedule) (*entities.Schedule, error) {
+	query := `
+		INSERT INTO schedules (id, company_id, name, cron_expression, timezone, test_suite_ids, environment_id, enabled, next_run_at, last_run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
+		RETURNING ` + scheduleColumns
+
+	created, err := scanSchedule(connFrom(ctx, r.db).QueryRow(ctx, query,
+		schedule.ID,
+		schedule.CompanyID,
+		schedule.Name,
+		schedule.CronExpression,
+		schedule.Timezone,
+		schedule.TestSuiteIDs,
+		schedule.EnvironmentID,
+		schedule.Enabled,
+		schedule.NextRunAt,
+		schedule.LastRunAt,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *scheduleRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Schedule, error) {
+	query := `
+		SELECT ` + scheduleColumns + `
+		FROM schedules
+		WHERE company_id = $1 AND id = $2`
+
+	schedule, err := scanSchedule(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("schedule %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (r *scheduleRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Schedule, error) {
+	query := `
+		SELECT ` + scheduleColumns + `
+		FROM schedules
+		WHERE company_id = $1
+		ORDER BY name ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedules by company: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []*entities.Schedule
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+func (r *scheduleRepository) Update(ctx context.Context, schedule *entities.Schedule) error {
+	query := `
+		UPDATE schedules
+		SET name = $3, cron_expression = $4, timezone = $5, test_suite_ids = $6, environment_id = $7, enabled = $8, next_run_at = $9, updated_at = NOW()
+		WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		schedule.CompanyID,
+		schedule.ID,
+		schedule.Name,
+		schedule.CronExpression,
+		schedule.Timezone,
+		schedule.TestSuiteIDs,
+		schedule.EnvironmentID,
+		schedule.Enabled,
+		schedule.NextRunAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("schedule %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *scheduleRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM schedules WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("schedule %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *scheduleRepository) ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM schedules WHERE company_id = $1 AND name = $2)`
+	var exists bool
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, companyID, name).Scan(&exists)
+	return exists, err
+}
+
+func (r *scheduleRepository) EnqueueDue(ctx context.Context, now time.Time, limit int) ([]*entities.TestRun, error) {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// As linhas ficam bloqueadas até o commit; outras instâncias pulam os agendamentos já em disparo
+	query := `
+		SELECT ` + scheduleColumns + `
+		FROM schedules
+		WHERE enabled AND next_run_at <= $1
+		ORDER BY next_run_at ASC
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due schedules: %w", err)
+	}
+
+	var schedules []*entities.Schedule
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan schedules: %w", err)
+	}
+
+	testRuns := make([]*entities.TestRun, 0, len(schedules))
+	for _, schedule := range schedules {
+		testRun, err := schedule.NewTestRun(now)
+		if err != nil {
+			// Expressão que deixou de ser válida (ex.: fuso removido): desativar em vez de tentar a cada ciclo
+			log.Printf("⚠️  [WARN] Disabling schedule %s: %v", schedule.ID, err)
+			schedule.Enabled = false
+			schedule.NextRunAt = nil
+		} else {
+			created, err := insertTestRun(ctx, tx, testRun)
+			if err != nil {
+				return nil, err
+			}
+			testRuns = append(testRuns, created)
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE schedules
+			SET enabled = $2, next_run_at = $3, last_run_at = $4, updated_at = NOW()
+			WHERE id = $1`,
+			schedule.ID, schedule.Enabled, schedule.NextRunAt, schedule.LastRunAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance schedule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit scheduled test runs: %w", err)
+	}
+
+	return testRuns, nil
+}
@@ -0,0 +1,149 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const secretColumns = `id, company_id, name, encrypted_value, created_at, updated_at`
+
+type secretRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewSecretRepository cria uma nova instância do repositório de segredos
+func NewSecretRepository(db *pgxpool.Pool) repositories.SecretRepository {
+	return &secretRepository{db: db}
+}
+
+// scanSecret lê uma linha de secrets na ordem de secretColumns
+func scanSecret(row pgx.Row) (*entities.Secret, error) {
+	var secret entities.Secret
+	err := row.Scan(
+		&secret.ID,
+		&secret.CompanyID,
+		&secret.Name,
+		&secret.EncryptedValue,
+		&secret.CreatedAt,
+		&secret.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+func (r *secretRepository) Create(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
+	query := `
+		INSERT INTO secrets (id, company_id, name, encrypted_value, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING ` + secretColumns
+
+	created, err := scanSecret(connFrom(ctx, r.db).QueryRow(ctx, query,
+		secret.ID,
+		secret.CompanyID,
+		secret.Name,
+		secret.EncryptedValue,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *secretRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Secret, error) {
+	query := `
+		SELECT ` + secretColumns + `
+		FROM secrets
+		WHERE company_id = $1 AND id = $2`
+
+	secret, err := scanSecret(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("secret %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+func (r *secretRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Secret, error) {
+	query := `
+		SELECT ` + secretColumns + `
+		FROM secrets
+		WHERE company_id = $1
+		ORDER BY name ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secrets by company: %w", err)
+	}
+	defer rows.Close()
+
+	var secrets []*entities.Secret
+	for rows.Next() {
+		secret, err := scanSecret(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan secret: %w", err)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, rows.Err()
+}
+
+func (r *secretRepository) Update(ctx context.Context, secret *entities.Secret) error {
+	query := `
+		UPDATE secrets
+		SET name = $3, encrypted_value = $4, updated_at = NOW()
+		WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		secret.CompanyID,
+		secret.ID,
+		secret.Name,
+		secret.EncryptedValue,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update secret: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("secret %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *secretRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM secrets WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("secret %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *secretRepository) ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM secrets WHERE company_id = $1 AND name = $2)`
+	var exists bool
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, companyID, name).Scan(&exists)
+	return exists, err
+}
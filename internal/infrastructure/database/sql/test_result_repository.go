@@ -0,0 +1,224 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const testResultColumns = `id, test_run_id, endpoint_test_id, test_suite_name, request, status, response_status, response_headers, response_body, response_body_truncated, response_time_ms, error_message, assertion_results, schema_violations, created_at, updated_at`
+
+// companyTestRuns restringe os resultados às execuções da empresa informada em $1
+const companyTestRuns = `test_run_id IN (SELECT id FROM test_runs WHERE company_id = $1)`
+
+type testResultRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewTestResultRepository cria uma nova instância do repositório de resultados de teste
+func NewTestResultRepository(db *pgxpool.Pool) repositories.TestResultRepository {
+	return &testResultRepository{db: db}
+}
+
+// scanTestResult lê uma linha de test_results na ordem de testResultColumns
+func scanTestResult(row pgx.Row) (*entities.TestResult, error) {
+	var testResult entities.TestResult
+	err := row.Scan(
+		&testResult.ID,
+		&testResult.TestRunID,
+		&testResult.TestSuiteID,
+		&testResult.TestSuiteName,
+		&testResult.Request,
+		&testResult.Status,
+		&testResult.ResponseStatus,
+		&testResult.ResponseHeaders,
+		&testResult.ResponseBody,
+		&testResult.ResponseBodyTruncated,
+		&testResult.ResponseTimeMS,
+		&testResult.ErrorMessage,
+		&testResult.AssertionResults,
+		&testResult.SchemaViolations,
+		&testResult.CreatedAt,
+		&testResult.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &testResult, nil
+}
+
+func (r *testResultRepository) Create(ctx context.Context, testResult *entities.TestResult) (*entities.TestResult, error) {
+	query := `
+		INSERT INTO test_results (id, test_run_id, endpoint_test_id, test_suite_name, request, status, response_status, response_headers, response_body, response_body_truncated, response_time_ms, error_message, assertion_results, schema_violations, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
+		RETURNING ` + testResultColumns
+
+	created, err := scanTestResult(connFrom(ctx, r.db).QueryRow(ctx, query,
+		testResult.ID,
+		testResult.TestRunID,
+		testResult.TestSuiteID,
+		testResult.TestSuiteName,
+		testResult.Request,
+		testResult.Status,
+		testResult.ResponseStatus,
+		responseHeadersOrEmpty(testResult.ResponseHeaders),
+		testResult.ResponseBody,
+		testResult.ResponseBodyTruncated,
+		testResult.ResponseTimeMS,
+		testResult.ErrorMessage,
+		assertionResultsOrEmpty(testResult.AssertionResults),
+		schemaViolationsOrEmpty(testResult.SchemaViolations),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test result: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *testResultRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestResult, error) {
+	query := `
+		SELECT ` + testResultColumns + `
+		FROM test_results
+		WHERE ` + companyTestRuns + ` AND id = $2`
+
+	testResult, err := scanTestResult(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("test result %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get test result: %w", err)
+	}
+
+	return testResult, nil
+}
+
+func (r *testResultRepository) GetByTestRunID(ctx context.Context, companyID, testRunID uuid.UUID) ([]*entities.TestResult, error) {
+	query := `
+		SELECT ` + testResultColumns + `
+		FROM test_results
+		WHERE ` + companyTestRuns + ` AND test_run_id = $2
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID, testRunID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test results by run: %w", err)
+	}
+	defer rows.Close()
+
+	var testResults []*entities.TestResult
+	for rows.Next() {
+		testResult, err := scanTestResult(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan test result: %w", err)
+		}
+		testResults = append(testResults, testResult)
+	}
+
+	return testResults, rows.Err()
+}
+
+func (r *testResultRepository) GetLatestByTestSuiteID(ctx context.Context, companyID, testSuiteID uuid.UUID) (*entities.TestResult, error) {
+	query := `
+		SELECT ` + testResultColumns + `
+		FROM test_results
+		WHERE ` + companyTestRuns + ` AND endpoint_test_id = $2 AND status <> 'skipped'
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	testResult, err := scanTestResult(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, testSuiteID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest test result: %w", err)
+	}
+
+	return testResult, nil
+}
+
+func (r *testResultRepository) Update(ctx context.Context, testResult *entities.TestResult) error {
+	query := `
+		UPDATE test_results
+		SET status = $2, response_status = $3, response_headers = $4, response_body = $5, response_body_truncated = $6, response_time_ms = $7, error_message = $8, assertion_results = $9, schema_violations = $10, updated_at = NOW()
+		WHERE id = $1`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		testResult.ID,
+		testResult.Status,
+		testResult.ResponseStatus,
+		responseHeadersOrEmpty(testResult.ResponseHeaders),
+		testResult.ResponseBody,
+		testResult.ResponseBodyTruncated,
+		testResult.ResponseTimeMS,
+		testResult.ErrorMessage,
+		assertionResultsOrEmpty(testResult.AssertionResults),
+		schemaViolationsOrEmpty(testResult.SchemaViolations),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update test result: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("test result %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *testResultRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM test_results WHERE ` + companyTestRuns + ` AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete test result: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("test result %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *testResultRepository) DeleteByTestRunID(ctx context.Context, companyID, testRunID uuid.UUID) error {
+	query := `DELETE FROM test_results WHERE ` + companyTestRuns + ` AND test_run_id = $2`
+
+	if _, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, testRunID); err != nil {
+		return fmt.Errorf("failed to delete test results: %w", err)
+	}
+
+	return nil
+}
+
+// assertionResultsOrEmpty evita gravar NULL na coluna jsonb quando não há asserções
+func assertionResultsOrEmpty(results []entities.AssertionResult) []entities.AssertionResult {
+	if results == nil {
+		return []entities.AssertionResult{}
+	}
+	return results
+}
+
+// schemaViolationsOrEmpty evita gravar NULL na coluna jsonb quando não há violações
+func schemaViolationsOrEmpty(violations []entities.SchemaViolation) []entities.SchemaViolation {
+	if violations == nil {
+		return []entities.SchemaViolation{}
+	}
+	return violations
+}
+
+// responseHeadersOrEmpty evita gravar NULL na coluna jsonb quando não há cabeçalhos
+func responseHeadersOrEmpty(headers map[string][]string) map[string][]string {
+	if headers == nil {
+		return map[string][]string{}
+	}
+	return headers
+}
@@ -0,0 +1,304 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const testRunColumns = `id, company_id, test_suite_ids, environment_id, schedule_id, status, started_at, finished_at, total_tests, passed_tests, failed_tests, skipped_tests, created_at, updated_at`
+
+type testRunRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewTestRunRepository cria uma nova instância do repositório de execuções de teste
+func NewTestRunRepository(db *pgxpool.Pool) repositories.TestRunRepository {
+	return &testRunRepository{db: db}
+}
+
+// scanTestRun lê uma linha de test_runs na ordem de testRunColumns
+func scanTestRun(row pgx.Row) (*entities.TestRun, error) {
+	var testRun entities.TestRun
+	err := row.Scan(
+		&testRun.ID,
+		&testRun.CompanyID,
+		&testRun.TestSuiteIDs,
+		&testRun.EnvironmentID,
+		&testRun.ScheduleID,
+		&testRun.Status,
+		&testRun.StartedAt,
+		&testRun.FinishedAt,
+		&testRun.TotalTests,
+		&testRun.PassedTests,
+		&testRun.FailedTests,
+		&testRun.SkippedTests,
+		&testRun.CreatedAt,
+		&testRun.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &testRun, nil
+}
+
+func (r *testRunRepository) Create(ctx context.Context, testRun *entities.TestRun) (*entities.TestRun, error) {
+	return insertTestRun(ctx, connFrom(ctx, r.db), testRun)
+}
+
+// insertTestRun grava uma nova execução usando o pool ou a transação informada
+func insertTestRun(ctx context.Context, db queryer, testRun *entities.TestRun) (*entities.TestRun, error) {
+	query := `
+		INSERT INTO test_runs (id, company_id, test_suite_ids, environment_id, schedule_id, status, started_at, finished_at, total_tests, passed_tests, failed_tests, skipped_tests, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING ` + testRunColumns
+
+	created, err := scanTestRun(db.QueryRow(ctx, query,
+		testRun.ID,
+		testRun.CompanyID,
+		testRun.TestSuiteIDs,
+		testRun.EnvironmentID,
+		testRun.ScheduleID,
+		testRun.Status,
+		testRun.StartedAt,
+		testRun.FinishedAt,
+		testRun.TotalTests,
+		testRun.PassedTests,
+		testRun.FailedTests,
+		testRun.SkippedTests,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test run: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *testRunRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error) {
+	query := `
+		SELECT ` + testRunColumns + `
+		FROM test_runs
+		WHERE company_id = $1 AND id = $2`
+
+	testRun, err := scanTestRun(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("test run %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get test run: %w", err)
+	}
+
+	return testRun, nil
+}
+
+func (r *testRunRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestRun, error) {
+	query := `
+		SELECT ` + testRunColumns + `
+		FROM test_runs
+		WHERE company_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test runs by company: %w", err)
+	}
+	defer rows.Close()
+
+	var testRuns []*entities.TestRun
+	for rows.Next() {
+		testRun, err := scanTestRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan test run: %w", err)
+		}
+		testRuns = append(testRuns, testRun)
+	}
+
+	return testRuns, rows.Err()
+}
+
+func (r *testRunRepository) Update(ctx context.Context, testRun *entities.TestRun) error {
+	query := `
+		UPDATE test_runs
+		SET status = $2, started_at = $3, finished_at = $4, total_tests = $5, passed_tests = $6, failed_tests = $7, skipped_tests = $8, updated_at = NOW()
+		WHERE id = $1 AND company_id = $9`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		testRun.ID,
+		testRun.Status,
+		testRun.StartedAt,
+		testRun.FinishedAt,
+		testRun.TotalTests,
+		testRun.PassedTests,
+		testRun.FailedTests,
+		testRun.SkippedTests,
+		testRun.CompanyID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update test run: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("test run %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *testRunRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM test_runs WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete test run: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("test run %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+// claimLockKey identifica o advisory lock que serializa a retirada de execuções da fila,
+// garantindo o limite de concorrência por empresa mesmo com várias instâncias da API
+const claimLockKey = 7310001
+
+func (r *testRunRepository) ClaimNext(ctx context.Context, companyConcurrency int) (*entities.TestRun, error) {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, claimLockKey); err != nil {
+		return nil, fmt.Errorf("failed to acquire queue lock: %w", err)
+	}
+
+	query := `
+		UPDATE test_runs
+		SET status = 'running', started_at = NOW(), heartbeat_at = NOW(), updated_at = NOW()
+		WHERE id = (
+			SELECT q.id
+			FROM test_runs q
+			WHERE q.status = 'queued'
+			  AND (
+				SELECT COUNT(*)
+				FROM test_runs r
+				WHERE r.company_id = q.company_id AND r.status = 'running'
+			  ) < $1
+			ORDER BY q.created_at ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + testRunColumns
+
+	testRun, err := scanTestRun(tx.QueryRow(ctx, query, companyConcurrency))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim test run: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit claim: %w", err)
+	}
+
+	return testRun, nil
+}
+
+func (r *testRunRepository) UpdateIfStatus(ctx context.Context, testRun *entities.TestRun, expected entities.TestRunStatus) (bool, error) {
+	query := `
+		UPDATE test_runs
+		SET status = $2, started_at = $3, finished_at = $4, total_tests = $5, passed_tests = $6, failed_tests = $7, skipped_tests = $8, updated_at = NOW()
+		WHERE id = $1 AND status = $9 AND company_id = $10`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		testRun.ID,
+		testRun.Status,
+		testRun.StartedAt,
+		testRun.FinishedAt,
+		testRun.TotalTests,
+		testRun.PassedTests,
+		testRun.FailedTests,
+		testRun.SkippedTests,
+		expected,
+		testRun.CompanyID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to update test run: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *testRunRepository) Heartbeat(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE test_runs
+		SET heartbeat_at = NOW()
+		WHERE id = ANY($1) AND status = 'running'`
+
+	if _, err := connFrom(ctx, r.db).Exec(ctx, query, ids); err != nil {
+		return fmt.Errorf("failed to record test run heartbeat: %w", err)
+	}
+
+	return nil
+}
+
+func (r *testRunRepository) RequeueStale(ctx context.Context, heartbeatBefore time.Time) (int64, error) {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Execuções sem heartbeat vêm de versões anteriores à coluna e usam a última atualização
+	rows, err := tx.Query(ctx, `
+		UPDATE test_runs
+		SET status = 'queued', started_at = NULL, heartbeat_at = NULL, total_tests = 0, passed_tests = 0, failed_tests = 0, skipped_tests = 0, updated_at = NOW()
+		WHERE status = 'running' AND COALESCE(heartbeat_at, updated_at) < $1
+		RETURNING id`, heartbeatBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue stale test runs: %w", err)
+	}
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan requeued test run: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to requeue stale test runs: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Resultados parciais serão gerados novamente quando a execução for retomada
+	if _, err := tx.Exec(ctx, `DELETE FROM test_results WHERE test_run_id = ANY($1)`, ids); err != nil {
+		return 0, fmt.Errorf("failed to delete partial test results: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit requeue: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
@@ -0,0 +1,97 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const assertionColumns = `id, test_suite_id, type, target, expected, position, created_at, updated_at`
+
+// queryer é satisfeito tanto pelo pool quanto por uma transação
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// loadAssertions carrega em uma única consulta as asserções das suítes informadas
+func loadAssertions(ctx context.Context, db queryer, testSuites ...*entities.TestSuite) error {
+	if len(testSuites) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(testSuites))
+	byID := make(map[uuid.UUID]*entities.TestSuite, len(testSuites))
+	for i, testSuite := range testSuites {
+		ids[i] = testSuite.ID
+		byID[testSuite.ID] = testSuite
+		testSuite.Assertions = []*entities.Assertion{}
+	}
+
+	query := `
+		SELECT ` + assertionColumns + `
+		FROM test_suite_assertions
+		WHERE test_suite_id = ANY($1)
+		ORDER BY test_suite_id, position ASC`
+
+	rows, err := db.Query(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get assertions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var assertion entities.Assertion
+		err := rows.Scan(
+			&assertion.ID,
+			&assertion.TestSuiteID,
+			&assertion.Type,
+			&assertion.Target,
+			&assertion.Expected,
+			&assertion.Position,
+			&assertion.CreatedAt,
+			&assertion.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan assertion: %w", err)
+		}
+		if testSuite, ok := byID[assertion.TestSuiteID]; ok {
+			testSuite.Assertions = append(testSuite.Assertions, &assertion)
+		}
+	}
+
+	return rows.Err()
+}
+
+// replaceAssertions substitui as asserções gravadas de uma suíte pelas informadas
+func replaceAssertions(ctx context.Context, db queryer, testSuiteID uuid.UUID, assertions []*entities.Assertion) error {
+	if _, err := db.Exec(ctx, `DELETE FROM test_suite_assertions WHERE test_suite_id = $1`, testSuiteID); err != nil {
+		return fmt.Errorf("failed to delete assertions: %w", err)
+	}
+
+	query := `
+		INSERT INTO test_suite_assertions (id, test_suite_id, type, target, expected, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())`
+
+	for _, assertion := range assertions {
+		_, err := db.Exec(ctx, query,
+			assertion.ID,
+			testSuiteID,
+			assertion.Type,
+			assertion.Target,
+			assertion.Expected,
+			assertion.Position,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create assertion: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+const extractionColumns = `id, test_suite_id, name, source, expression, position, created_at, updated_at`
+
+// loadExtractions carrega em uma única consulta as regras de extração das suítes informadas
+func loadExtractions(ctx context.Context, db queryer, testSuites ...*entities.TestSuite) error {
+	if len(testSuites) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(testSuites))
+	byID := make(map[uuid.UUID]*entities.TestSuite, len(testSuites))
+	for i, testSuite := range testSuites {
+		ids[i] = testSuite.ID
+		byID[testSuite.ID] = testSuite
+		testSuite.Extractions = []*entities.Extraction{}
+	}
+
+	query := `
+		SELECT ` + extractionColumns + `
+		FROM test_suite_extractions
+		WHERE test_suite_id = ANY($1)
+		ORDER BY test_suite_id, position ASC`
+
+	rows, err := db.Query(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get extractions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var extraction entities.Extraction
+		err := rows.Scan(
+			&extraction.ID,
+			&extraction.TestSuiteID,
+			&extraction.Name,
+			&extraction.Source,
+			&extraction.Expression,
+			&extraction.Position,
+			&extraction.CreatedAt,
+			&extraction.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan extraction: %w", err)
+		}
+		if testSuite, ok := byID[extraction.TestSuiteID]; ok {
+			testSuite.Extractions = append(testSuite.Extractions, &extraction)
+		}
+	}
+
+	return rows.Err()
+}
+
+// replaceExtractions substitui as regras de extração gravadas de uma suíte pelas informadas
+func replaceExtractions(ctx context.Context, db queryer, testSuiteID uuid.UUID, extractions []*entities.Extraction) error {
+	if _, err := db.Exec(ctx, `DELETE FROM test_suite_extractions WHERE test_suite_id = $1`, testSuiteID); err != nil {
+		return fmt.Errorf("failed to delete extractions: %w", err)
+	}
+
+	query := `
+		INSERT INTO test_suite_extractions (id, test_suite_id, name, source, expression, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())`
+
+	for _, extraction := range extractions {
+		_, err := db.Exec(ctx, query,
+			extraction.ID,
+			testSuiteID,
+			extraction.Name,
+			extraction.Source,
+			extraction.Expression,
+			extraction.Position,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create extraction: %w", err)
+		}
+	}
+
+	return nil
+}
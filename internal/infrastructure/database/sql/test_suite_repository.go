@@ -2,12 +2,14 @@ package sql
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 )
 
@@ -21,32 +23,54 @@ func NewTestSuiteRepository(db *pgxpool.Pool) repositories.TestSuiteRepository {
 }
 
 func (r *testSuiteRepository) Create(ctx context.Context, testSuite *entities.TestSuite) (*entities.TestSuite, error) {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
-		INSERT INTO test_suites (id, company_id, name, method, url, headers, expected_status, expected_body, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
-		RETURNING id, company_id, name, method, url, headers, expected_status, expected_body, created_at, updated_at`
+		INSERT INTO test_suites (id, company_id, name, method, url, headers, query_params, body_type, body, form_fields, expected_status, expected_body, response_schema, response_schema_id, depends_on, import_key, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NOW(), NOW())
+		RETURNING id, company_id, name, method, url, headers, query_params, body_type, body, form_fields, expected_status, expected_body, response_schema, response_schema_id, depends_on, import_key, created_at, updated_at`
 
-	row := r.db.QueryRow(ctx, query,
+	row := tx.QueryRow(ctx, query,
 		testSuite.ID,
 		testSuite.CompanyID,
 		testSuite.Name,
 		testSuite.Method,
 		testSuite.URL,
 		testSuite.Headers,
+		testSuite.QueryParams,
+		testSuite.BodyType,
+		testSuite.Body,
+		testSuite.FormFields,
 		testSuite.ExpectedStatus,
 		testSuite.ExpectedBody,
+		testSuite.ResponseSchema,
+		testSuite.ResponseSchemaID,
+		testSuite.DependsOn,
+		testSuite.ImportKey,
 	)
 
 	var created entities.TestSuite
-	err := row.Scan(
+	err = row.Scan(
 		&created.ID,
 		&created.CompanyID,
 		&created.Name,
 		&created.Method,
 		&created.URL,
 		&created.Headers,
+		&created.QueryParams,
+		&created.BodyType,
+		&created.Body,
+		&created.FormFields,
 		&created.ExpectedStatus,
 		&created.ExpectedBody,
+		&created.ResponseSchema,
+		&created.ResponseSchemaID,
+		&created.DependsOn,
+		&created.ImportKey,
 		&created.CreatedAt,
 		&created.UpdatedAt,
 	)
@@ -54,16 +78,32 @@ func (r *testSuiteRepository) Create(ctx context.Context, testSuite *entities.Te
 		return nil, fmt.Errorf("failed to create test suite: %w", err)
 	}
 
+	if err := replaceAssertions(ctx, tx, created.ID, testSuite.Assertions); err != nil {
+		return nil, err
+	}
+
+	if err := replaceExtractions(ctx, tx, created.ID, testSuite.Extractions); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit test suite: %w", err)
+	}
+
+	if err := loadRelations(ctx, connFrom(ctx, r.db), &created); err != nil {
+		return nil, err
+	}
+
 	return &created, nil
 }
 
-func (r *testSuiteRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.TestSuite, error) {
+func (r *testSuiteRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestSuite, error) {
 	query := `
-		SELECT id, company_id, name, method, url, headers, expected_status, expected_body, created_at, updated_at
+		SELECT id, company_id, name, method, url, headers, query_params, body_type, body, form_fields, expected_status, expected_body, response_schema, response_schema_id, depends_on, import_key, created_at, updated_at
 		FROM test_suites
-		WHERE id = $1`
+		WHERE company_id = $1 AND id = $2`
 
-	row := r.db.QueryRow(ctx, query, id)
+	row := connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id)
 
 	var testSuite entities.TestSuite
 	err := row.Scan(
@@ -73,32 +113,78 @@ func (r *testSuiteRepository) GetByID(ctx context.Context, id uuid.UUID) (*entit
 		&testSuite.Method,
 		&testSuite.URL,
 		&testSuite.Headers,
+		&testSuite.QueryParams,
+		&testSuite.BodyType,
+		&testSuite.Body,
+		&testSuite.FormFields,
 		&testSuite.ExpectedStatus,
 		&testSuite.ExpectedBody,
+		&testSuite.ResponseSchema,
+		&testSuite.ResponseSchemaID,
+		&testSuite.DependsOn,
+		&testSuite.ImportKey,
 		&testSuite.CreatedAt,
 		&testSuite.UpdatedAt,
 	)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("test suite not found")
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("test suite %w", domainErrors.ErrNotFound)
 		}
 		return nil, fmt.Errorf("failed to get test suite: %w", err)
 	}
 
+	if err := loadRelations(ctx, connFrom(ctx, r.db), &testSuite); err != nil {
+		return nil, err
+	}
+
 	return &testSuite, nil
 }
 
 func (r *testSuiteRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestSuite, error) {
 	query := `
-		SELECT id, company_id, name, method, url, headers, expected_status, expected_body, created_at, updated_at
+		SELECT id, company_id, name, method, url, headers, query_params, body_type, body, form_fields, expected_status, expected_body, response_schema, response_schema_id, depends_on, import_key, created_at, updated_at
 		FROM test_suites
 		WHERE company_id = $1
 		ORDER BY created_at DESC`
 
-	rows, err := r.db.Query(ctx, query, companyID)
+	testSuites, err := r.selectSuites(ctx, query, companyID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get test suites by company: %w", err)
 	}
+	return testSuites, nil
+}
+
+func (r *testSuiteRepository) ListByCompanyID(ctx context.Context, companyID uuid.UUID, limit, offset int) ([]*entities.TestSuite, error) {
+	query := `
+		SELECT id, company_id, name, method, url, headers, query_params, body_type, body, form_fields, expected_status, expected_body, response_schema, response_schema_id, depends_on, import_key, created_at, updated_at
+		FROM test_suites
+		WHERE company_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	testSuites, err := r.selectSuites(ctx, query, companyID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list test suites: %w", err)
+	}
+	return testSuites, nil
+}
+
+func (r *testSuiteRepository) CountByCompanyID(ctx context.Context, companyID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM test_suites WHERE company_id = $1`
+
+	var total int64
+	if err := connFrom(ctx, r.db).QueryRow(ctx, query, companyID).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count test suites: %w", err)
+	}
+	return total, nil
+}
+
+// selectSuites executa uma consulta que seleciona as colunas completas de test_suites e carrega as relações
+func (r *testSuiteRepository) selectSuites(ctx context.Context, query string, args ...any) ([]*entities.TestSuite, error) {
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
 	var testSuites []*entities.TestSuite
@@ -111,8 +197,16 @@ func (r *testSuiteRepository) GetByCompanyID(ctx context.Context, companyID uuid
 			&testSuite.Method,
 			&testSuite.URL,
 			&testSuite.Headers,
+			&testSuite.QueryParams,
+			&testSuite.BodyType,
+			&testSuite.Body,
+			&testSuite.FormFields,
 			&testSuite.ExpectedStatus,
 			&testSuite.ExpectedBody,
+			&testSuite.ResponseSchema,
+			&testSuite.ResponseSchemaID,
+			&testSuite.DependsOn,
+			&testSuite.ImportKey,
 			&testSuite.CreatedAt,
 			&testSuite.UpdatedAt,
 		)
@@ -121,24 +215,46 @@ func (r *testSuiteRepository) GetByCompanyID(ctx context.Context, companyID uuid
 		}
 		testSuites = append(testSuites, &testSuite)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan test suites: %w", err)
+	}
+
+	if err := loadRelations(ctx, connFrom(ctx, r.db), testSuites...); err != nil {
+		return nil, err
+	}
 
 	return testSuites, nil
 }
 
 func (r *testSuiteRepository) Update(ctx context.Context, testSuite *entities.TestSuite) error {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `
 		UPDATE test_suites
-		SET name = $2, method = $3, url = $4, headers = $5, expected_status = $6, expected_body = $7, updated_at = NOW()
-		WHERE id = $1`
+		SET name = $2, method = $3, url = $4, headers = $5, query_params = $6, body_type = $7, body = $8, form_fields = $9, expected_status = $10, expected_body = $11, response_schema = $12, response_schema_id = $13, depends_on = $14, import_key = $15, updated_at = NOW()
+		WHERE id = $1 AND company_id = $16`
 
-	result, err := r.db.Exec(ctx, query,
+	result, err := tx.Exec(ctx, query,
 		testSuite.ID,
 		testSuite.Name,
 		testSuite.Method,
 		testSuite.URL,
 		testSuite.Headers,
+		testSuite.QueryParams,
+		testSuite.BodyType,
+		testSuite.Body,
+		testSuite.FormFields,
 		testSuite.ExpectedStatus,
 		testSuite.ExpectedBody,
+		testSuite.ResponseSchema,
+		testSuite.ResponseSchemaID,
+		testSuite.DependsOn,
+		testSuite.ImportKey,
+		testSuite.CompanyID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update test suite: %w", err)
@@ -146,38 +262,73 @@ func (r *testSuiteRepository) Update(ctx context.Context, testSuite *entities.Te
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return fmt.Errorf("test suite not found")
+		return fmt.Errorf("test suite %w", domainErrors.ErrNotFound)
+	}
+
+	if err := replaceAssertions(ctx, tx, testSuite.ID, testSuite.Assertions); err != nil {
+		return err
+	}
+
+	if err := replaceExtractions(ctx, tx, testSuite.ID, testSuite.Extractions); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit test suite: %w", err)
 	}
 
 	return nil
 }
 
-func (r *testSuiteRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM test_suites WHERE id = $1`
+func (r *testSuiteRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	tx, err := connFrom(ctx, r.db).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `DELETE FROM test_suites WHERE company_id = $1 AND id = $2`
 
-	result, err := r.db.Exec(ctx, query, id)
+	result, err := tx.Exec(ctx, query, companyID, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete test suite: %w", err)
 	}
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return fmt.Errorf("test suite not found")
+		return fmt.Errorf("test suite %w", domainErrors.ErrNotFound)
+	}
+
+	// Remover a suíte das dependências das demais
+	_, err = tx.Exec(ctx, `UPDATE test_suites SET depends_on = array_remove(depends_on, $1) WHERE company_id = $2 AND $1 = ANY(depends_on)`, id, companyID)
+	if err != nil {
+		return fmt.Errorf("failed to remove test suite dependencies: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit test suite deletion: %w", err)
 	}
 
 	return nil
 }
 
-func (r *testSuiteRepository) List(ctx context.Context, limit, offset int) ([]*entities.TestSuite, error) {
+// loadRelations carrega as asserções e as regras de extração das suítes informadas
+func loadRelations(ctx context.Context, db queryer, testSuites ...*entities.TestSuite) error {
+	if err := loadAssertions(ctx, db, testSuites...); err != nil {
+		return err
+	}
+	return loadExtractions(ctx, db, testSuites...)
+}
+
+func (r *testSuiteRepository) GetByImportKeys(ctx context.Context, companyID uuid.UUID, keys []string) ([]*entities.TestSuite, error) {
 	query := `
-		SELECT id, company_id, name, method, url, headers, expected_status, expected_body, created_at, updated_at
+		SELECT id, company_id, name, method, url, headers, query_params, body_type, body, form_fields, expected_status, expected_body, response_schema, response_schema_id, depends_on, import_key, created_at, updated_at
 		FROM test_suites
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2`
+		WHERE company_id = $1 AND import_key = ANY($2)`
 
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID, keys)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list test suites: %w", err)
+		return nil, fmt.Errorf("failed to get test suites by import key: %w", err)
 	}
 	defer rows.Close()
 
@@ -191,8 +342,16 @@ func (r *testSuiteRepository) List(ctx context.Context, limit, offset int) ([]*e
 			&testSuite.Method,
 			&testSuite.URL,
 			&testSuite.Headers,
+			&testSuite.QueryParams,
+			&testSuite.BodyType,
+			&testSuite.Body,
+			&testSuite.FormFields,
 			&testSuite.ExpectedStatus,
 			&testSuite.ExpectedBody,
+			&testSuite.ResponseSchema,
+			&testSuite.ResponseSchemaID,
+			&testSuite.DependsOn,
+			&testSuite.ImportKey,
 			&testSuite.CreatedAt,
 			&testSuite.UpdatedAt,
 		)
@@ -201,6 +360,13 @@ func (r *testSuiteRepository) List(ctx context.Context, limit, offset int) ([]*e
 		}
 		testSuites = append(testSuites, &testSuite)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan test suites: %w", err)
+	}
+
+	if err := loadRelations(ctx, connFrom(ctx, r.db), testSuites...); err != nil {
+		return nil, err
+	}
 
 	return testSuites, nil
 }
\ No newline at end of file
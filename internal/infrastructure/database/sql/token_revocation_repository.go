@@ -0,0 +1,98 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const tokenRevocationColumns = `id, user_id, token_id, session_id, expires_at, created_at`
+
+type tokenRevocationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewTokenRevocationRepository cria uma nova instância do repositório de revogações de tokens
+func NewTokenRevocationRepository(db *pgxpool.Pool) repositories.TokenRevocationRepository {
+	return &tokenRevocationRepository{db: db}
+}
+
+// scanTokenRevocation lê uma linha de token_revocations na ordem de tokenRevocationColumns
+func scanTokenRevocation(row pgx.Row) (*entities.TokenRevocation, error) {
+	var revocation entities.TokenRevocation
+	err := row.Scan(
+		&revocation.ID,
+		&revocation.UserID,
+		&revocation.TokenID,
+		&revocation.SessionID,
+		&revocation.ExpiresAt,
+		&revocation.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &revocation, nil
+}
+
+func (r *tokenRevocationRepository) Create(ctx context.Context, revocation *entities.TokenRevocation) (*entities.TokenRevocation, error) {
+	query := `
+		INSERT INTO token_revocations (id, user_id, token_id, session_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + tokenRevocationColumns
+
+	created, err := scanTokenRevocation(connFrom(ctx, r.db).QueryRow(ctx, query,
+		revocation.ID,
+		revocation.UserID,
+		revocation.TokenID,
+		revocation.SessionID,
+		revocation.ExpiresAt.UTC(),
+		revocation.CreatedAt.UTC(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token revocation: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *tokenRevocationRepository) GetActiveSince(ctx context.Context, since time.Time) ([]*entities.TokenRevocation, error) {
+	query := `
+		SELECT ` + tokenRevocationColumns + `
+		FROM token_revocations
+		WHERE created_at >= $1 AND expires_at > NOW()
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, since.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token revocations: %w", err)
+	}
+	defer rows.Close()
+
+	var revocations []*entities.TokenRevocation
+	for rows.Next() {
+		revocation, err := scanTokenRevocation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan token revocation: %w", err)
+		}
+		revocations = append(revocations, revocation)
+	}
+
+	return revocations, rows.Err()
+}
+
+func (r *tokenRevocationRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	query := `DELETE FROM token_revocations WHERE expires_at <= NOW()`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired token revocations: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
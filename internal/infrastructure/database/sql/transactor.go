@@ -0,0 +1,60 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"TestGO/internal/domain/repositories"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// txKey identifica a transação em andamento no contexto
+type txKey struct{}
+
+// conn é satisfeito tanto pelo pool quanto por uma transação e permite abrir
+// transações aninhadas (savepoints, quando já há uma transação em andamento)
+type conn interface {
+	queryer
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// connFrom retorna a transação em andamento no contexto ou, se não houver, o pool
+func connFrom(ctx context.Context, db *pgxpool.Pool) conn {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+type transactor struct {
+	db *pgxpool.Pool
+}
+
+// NewTransactor cria um executor de transações sobre o pool informado
+func NewTransactor(db *pgxpool.Pool) repositories.Transactor {
+	return &transactor{db: db}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Uma transação já em andamento é reaproveitada; o commit fica com quem a abriu
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
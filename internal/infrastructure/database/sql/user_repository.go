@@ -3,12 +3,15 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/domain/repositories"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -23,19 +26,20 @@ func NewUserRepository(db *pgxpool.Pool) repositories.UserRepository {
 
 func (r *userRepository) Create(ctx context.Context, user *entities.User) (*entities.User, error) {
 	query := `
-		INSERT INTO users (id, username, email, password, name, company_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		RETURNING id, username, email, password, name, company_id, created_at, updated_at
+		INSERT INTO users (id, username, email, password, name, company_id, email_alerts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, username, email, password, name, company_id, email_alerts, created_at, updated_at
 	`
 
 	createdUser := &entities.User{}
-	err := r.db.QueryRow(ctx, query,
+	err := connFrom(ctx, r.db).QueryRow(ctx, query,
 		user.ID,
 		user.Username,
 		user.Email,
 		user.Password,
 		user.Name,
 		user.CompanyID,
+		user.EmailAlerts,
 		user.CreatedAt,
 		user.UpdatedAt,
 	).Scan(
@@ -45,6 +49,7 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) (*enti
 		&createdUser.Password,
 		&createdUser.Name,
 		&createdUser.CompanyID,
+		&createdUser.EmailAlerts,
 		&createdUser.CreatedAt,
 		&createdUser.UpdatedAt,
 	)
@@ -58,26 +63,27 @@ func (r *userRepository) Create(ctx context.Context, user *entities.User) (*enti
 
 func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.User, error) {
 	query := `
-		SELECT id, username, email, password, name, company_id, created_at, updated_at
+		SELECT id, username, email, password, name, company_id, email_alerts, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
 
 	user := &entities.User{}
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, id).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
 		&user.Password,
 		&user.Name,
 		&user.CompanyID,
+		&user.EmailAlerts,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("user not found")
+			return nil, fmt.Errorf("user %w", domainErrors.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -87,26 +93,27 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.U
 
 func (r *userRepository) GetByUsername(ctx context.Context, username string) (*entities.User, error) {
 	query := `
-		SELECT id, username, email, password, name, company_id, created_at, updated_at
+		SELECT id, username, email, password, name, company_id, email_alerts, created_at, updated_at
 		FROM users
 		WHERE username = $1
 	`
 
 	user := &entities.User{}
-	err := r.db.QueryRow(ctx, query, username).Scan(
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, username).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
 		&user.Password,
 		&user.Name,
 		&user.CompanyID,
+		&user.EmailAlerts,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("user not found")
+			return nil, fmt.Errorf("user %w", domainErrors.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -116,26 +123,27 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*e
 
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entities.User, error) {
 	query := `
-		SELECT id, username, email, password, name, company_id, created_at, updated_at
+		SELECT id, username, email, password, name, company_id, email_alerts, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
 
 	user := &entities.User{}
-	err := r.db.QueryRow(ctx, query, email).Scan(
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, email).Scan(
 		&user.ID,
 		&user.Username,
 		&user.Email,
 		&user.Password,
 		&user.Name,
 		&user.CompanyID,
+		&user.EmailAlerts,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("user not found")
+			return nil, fmt.Errorf("user %w", domainErrors.ErrNotFound)
 		}
 		return nil, err
 	}
@@ -146,17 +154,18 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entitie
 func (r *userRepository) Update(ctx context.Context, user *entities.User) error {
 	query := `
 		UPDATE users
-		SET username = $2, email = $3, password = $4, name = $5, company_id = $6, updated_at = $7
+		SET username = $2, email = $3, password = $4, name = $5, company_id = $6, email_alerts = $7, updated_at = $8
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	_, err := connFrom(ctx, r.db).Exec(ctx, query,
 		user.ID,
 		user.Username,
 		user.Email,
 		user.Password,
 		user.Name,
 		user.CompanyID,
+		user.EmailAlerts,
 		user.UpdatedAt,
 	)
 
@@ -165,19 +174,103 @@ func (r *userRepository) Update(ctx context.Context, user *entities.User) error
 
 func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM users WHERE id = $1`
-	_, err := r.db.Exec(ctx, query, id)
+	_, err := connFrom(ctx, r.db).Exec(ctx, query, id)
 	return err
 }
 
-func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
+// visibleToCondition restringe users (alias u) ao viewer ($1) e a quem compartilha uma empresa com ele
+const visibleToCondition = `
+	(u.id = $1 OR EXISTS (
+		SELECT 1
+		FROM company_members mine
+		JOIN company_members theirs ON theirs.company_id = mine.company_id
+		WHERE mine.user_id = $1 AND theirs.user_id = u.id
+	))
+`
+
+func (r *userRepository) ListVisibleTo(ctx context.Context, viewerID uuid.UUID, limit, offset int) ([]*entities.User, error) {
+	query := `
+		SELECT u.id, u.username, u.email, u.password, u.name, u.company_id, u.email_alerts, u.created_at, u.updated_at
+		FROM users u
+		WHERE ` + visibleToCondition + `
+		ORDER BY u.created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, viewerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		user := &entities.User{}
+		err := rows.Scan(
+			&user.ID,
+			&user.Username,
+			&user.Email,
+			&user.Password,
+			&user.Name,
+			&user.CompanyID,
+			&user.EmailAlerts,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+func (r *userRepository) CountVisibleTo(ctx context.Context, viewerID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM users u WHERE ` + visibleToCondition
+
+	var total int64
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, viewerID).Scan(&total)
+	return total, err
+}
+
+func (r *userRepository) GetVisibleTo(ctx context.Context, viewerID, id uuid.UUID) (*entities.User, error) {
+	query := `
+		SELECT u.id, u.username, u.email, u.password, u.name, u.company_id, u.email_alerts, u.created_at, u.updated_at
+		FROM users u
+		WHERE u.id = $2 AND ` + visibleToCondition
+
+	user := &entities.User{}
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, viewerID, id).Scan(
+		&user.ID,
+		&user.Username,
+		&user.Email,
+		&user.Password,
+		&user.Name,
+		&user.CompanyID,
+		&user.EmailAlerts,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("user %w", domainErrors.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.User, error) {
 	query := `
-		SELECT id, username, email, password, name, company_id, created_at, updated_at
+		SELECT id, username, email, password, name, company_id, email_alerts, created_at, updated_at
 		FROM users
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
+		WHERE company_id = $1
+		ORDER BY created_at ASC
 	`
 
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +286,7 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entiti
 			&user.Password,
 			&user.Name,
 			&user.CompanyID,
+			&user.EmailAlerts,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -202,19 +296,19 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]*entiti
 		users = append(users, user)
 	}
 
-	return users, nil
+	return users, rows.Err()
 }
 
 func (r *userRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE username = $1)`
 	var exists bool
-	err := r.db.QueryRow(ctx, query, username).Scan(&exists)
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, username).Scan(&exists)
 	return exists, err
 }
 
 func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE email = $1)`
 	var exists bool
-	err := r.db.QueryRow(ctx, query, email).Scan(&exists)
+	err := connFrom(ctx, r.db).QueryRow(ctx, query, email).Scan(&exists)
 	return exists, err
 }
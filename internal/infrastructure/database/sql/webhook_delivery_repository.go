@@ -0,0 +1,165 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// A empresa vem do webhook dono da entrega; webhook_deliveries não guarda company_id
+const webhookDeliveryColumns = `id, webhook_id,
+	(SELECT company_id FROM webhooks WHERE webhooks.id = webhook_deliveries.webhook_id),
+	event, payload, status, attempts, next_attempt_at, delivered_at, created_at, updated_at`
+
+type webhookDeliveryRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewWebhookDeliveryRepository cria uma nova instância do repositório de entregas de webhooks
+func NewWebhookDeliveryRepository(db *pgxpool.Pool) repositories.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+// scanWebhookDelivery lê uma linha de webhook_deliveries na ordem de webhookDeliveryColumns
+func scanWebhookDelivery(row pgx.Row) (*entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.CompanyID,
+		&delivery.Event,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.DeliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// collectWebhookDeliveries lê todas as linhas de uma consulta de entregas
+func collectWebhookDeliveries(rows pgx.Rows) ([]*entities.WebhookDelivery, error) {
+	defer rows.Close()
+
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *entities.WebhookDelivery) (*entities.WebhookDelivery, error) {
+	query := `
+		INSERT INTO webhook_deliveries (id, webhook_id, event, payload, status, attempts, next_attempt_at, delivered_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING ` + webhookDeliveryColumns
+
+	created, err := scanWebhookDelivery(connFrom(ctx, r.db).QueryRow(ctx, query,
+		delivery.ID,
+		delivery.WebhookID,
+		delivery.Event,
+		delivery.Payload,
+		delivery.Status,
+		attemptsOrEmpty(delivery.Attempts),
+		delivery.NextAttemptAt,
+		delivery.DeliveredAt,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *webhookDeliveryRepository) GetByWebhookID(ctx context.Context, companyID, webhookID uuid.UUID, limit int) ([]*entities.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id IN (
+			SELECT id
+			FROM webhooks
+			WHERE company_id = $1 AND id = $2
+		)
+		ORDER BY created_at DESC
+		LIMIT $3`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	return collectWebhookDeliveries(rows)
+}
+
+func (r *webhookDeliveryRepository) Update(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, delivered_at = $5, updated_at = NOW()
+		WHERE id = $1`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		delivery.ID,
+		delivery.Status,
+		attemptsOrEmpty(delivery.Attempts),
+		delivery.NextAttemptAt,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook delivery %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *webhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, error) {
+	// O envio acontece fora da transação; a reserva expira se a instância parar no meio da entrega
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = $2, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	return collectWebhookDeliveries(rows)
+}
+
+// attemptsOrEmpty garante que a coluna jsonb receba [] em vez de NULL
+func attemptsOrEmpty(attempts []entities.WebhookAttempt) []entities.WebhookAttempt {
+	if attempts == nil {
+		return []entities.WebhookAttempt{}
+	}
+	return attempts
+}
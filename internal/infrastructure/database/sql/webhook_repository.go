@@ -0,0 +1,169 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const webhookColumns = `id, company_id, url, events, enabled, encrypted_secret, created_at, updated_at`
+
+type webhookRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewWebhookRepository cria uma nova instância do repositório de webhooks
+func NewWebhookRepository(db *pgxpool.Pool) repositories.WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+// scanWebhook lê uma linha de webhooks na ordem de webhookColumns
+func scanWebhook(row pgx.Row) (*entities.Webhook, error) {
+	var webhook entities.Webhook
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.CompanyID,
+		&webhook.URL,
+		&webhook.Events,
+		&webhook.Enabled,
+		&webhook.EncryptedSecret,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// collectWebhooks lê todas as linhas de uma consulta de webhooks
+func collectWebhooks(rows pgx.Rows) ([]*entities.Webhook, error) {
+	defer rows.Close()
+
+	var webhooks []*entities.Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error) {
+	query := `
+		INSERT INTO webhooks (id, company_id, url, events, enabled, encrypted_secret, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING ` + webhookColumns
+
+	created, err := scanWebhook(connFrom(ctx, r.db).QueryRow(ctx, query,
+		webhook.ID,
+		webhook.CompanyID,
+		webhook.URL,
+		webhook.Events,
+		webhook.Enabled,
+		webhook.EncryptedSecret,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *webhookRepository) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE company_id = $1 AND id = $2`
+
+	webhook, err := scanWebhook(connFrom(ctx, r.db).QueryRow(ctx, query, companyID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("webhook %w", domainErrors.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *webhookRepository) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE company_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks by company: %w", err)
+	}
+
+	return collectWebhooks(rows)
+}
+
+func (r *webhookRepository) GetSubscribed(ctx context.Context, companyID uuid.UUID, event entities.WebhookEvent) ([]*entities.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE company_id = $1 AND enabled AND $2 = ANY(events)
+		ORDER BY created_at ASC`
+
+	rows, err := connFrom(ctx, r.db).Query(ctx, query, companyID, string(event))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscribed webhooks: %w", err)
+	}
+
+	return collectWebhooks(rows)
+}
+
+func (r *webhookRepository) Update(ctx context.Context, webhook *entities.Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET url = $3, events = $4, enabled = $5, encrypted_secret = $6, updated_at = NOW()
+		WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query,
+		webhook.CompanyID,
+		webhook.ID,
+		webhook.URL,
+		webhook.Events,
+		webhook.Enabled,
+		webhook.EncryptedSecret,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	query := `DELETE FROM webhooks WHERE company_id = $1 AND id = $2`
+
+	result, err := connFrom(ctx, r.db).Exec(ctx, query, companyID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("webhook %w", domainErrors.ErrNotFound)
+	}
+
+	return nil
+}
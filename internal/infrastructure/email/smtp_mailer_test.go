@@ -0,0 +1,297 @@
+package email
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer aceita uma única sessão SMTP sem TLS e registra o envelope e o conteúdo recebidos
+type fakeSMTPServer struct {
+	listener   net.Listener
+	rejectAuth bool
+	rejectRcpt string
+
+	done       chan struct{}
+	auth       string
+	from       string
+	recipients []string
+	data       []byte
+}
+
+func newFakeSMTPServer(t *testing.T, rejectAuth bool, rejectRcpt string) *fakeSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := &fakeSMTPServer{listener: listener, rejectAuth: rejectAuth, rejectRcpt: rejectRcpt, done: make(chan struct{})}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		defer close(server.done)
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		server.serve(conn)
+	}()
+	return server
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// wait aguarda o fim da sessão, para que o registro possa ser lido sem concorrência
+func (s *fakeSMTPServer) wait(t *testing.T) {
+	t.Helper()
+	s.listener.Close()
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("smtp session did not finish")
+	}
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost ESMTP fake")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250-localhost")
+			tp.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = arg
+			if s.rejectAuth {
+				tp.PrintfLine("535 5.7.8 authentication credentials invalid")
+			} else {
+				tp.PrintfLine("235 2.7.0 authentication successful")
+			}
+		case "MAIL":
+			s.from = arg
+			tp.PrintfLine("250 2.1.0 ok")
+		case "RCPT":
+			if s.rejectRcpt != "" && strings.Contains(arg, s.rejectRcpt) {
+				tp.PrintfLine("550 5.1.1 mailbox unavailable")
+				continue
+			}
+			s.recipients = append(s.recipients, arg)
+			tp.PrintfLine("250 2.1.5 ok")
+		case "DATA":
+			tp.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			s.data, err = tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			tp.PrintfLine("250 2.0.0 queued")
+		case "QUIT":
+			tp.PrintfLine("221 2.0.0 bye")
+			return
+		default:
+			tp.PrintfLine("502 5.5.2 command not implemented")
+		}
+	}
+}
+
+func TestNewSMTPMailer(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  SMTPConfig
+		wantErr string
+	}{
+		{name: "valid", config: SMTPConfig{Host: "smtp.example.com", From: "EzTest <alerts@example.com>"}},
+		{name: "missing host", config: SMTPConfig{From: "alerts@example.com"}, wantErr: "smtp host is required"},
+		{name: "invalid from", config: SMTPConfig{Host: "smtp.example.com", From: "alerts"}, wantErr: "invalid smtp from address: mail: missing '@' or angle-addr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer, err := NewSMTPMailer(tt.config)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mailer.config.Port != 587 || mailer.config.Timeout != 10*time.Second {
+				t.Errorf("defaults = port %d timeout %s, want 587 and 10s", mailer.config.Port, mailer.config.Timeout)
+			}
+		})
+	}
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	msg := Message{
+		To:      []string{"dev@example.com", "ops@example.com"},
+		Subject: "[EzTest] Acme: execução failed",
+		Text:    "Suite failed: expected status 200, got 500. " + strings.Repeat("long line ", 10),
+		HTML:    `<p style="color: #b00020;">Suite failed</p>`,
+	}
+
+	tests := []struct {
+		name           string
+		username       string
+		rejectAuth     bool
+		rejectRcpt     string
+		to             []string
+		wantErr        string
+		wantAuth       string
+		wantRecipients []string
+		wantData       bool
+	}{
+		{
+			name:           "delivers multipart message",
+			to:             msg.To,
+			wantRecipients: []string{"TO:<dev@example.com>", "TO:<ops@example.com>"},
+			wantData:       true,
+		},
+		{
+			name:           "authenticates with plain auth",
+			username:       "alerts",
+			to:             msg.To[:1],
+			wantAuth:       "PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00alerts\x00secret")),
+			wantRecipients: []string{"TO:<dev@example.com>"},
+			wantData:       true,
+		},
+		{
+			name:       "authentication rejected",
+			username:   "alerts",
+			rejectAuth: true,
+			to:         msg.To,
+			wantErr:    `smtp authentication failed: 535 "5.7.8 authentication credentials invalid"`,
+			wantAuth:   "PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00alerts\x00secret")),
+		},
+		{
+			name:           "recipient rejected",
+			rejectRcpt:     "ops@",
+			to:             msg.To,
+			wantErr:        `smtp recipient ops@example.com rejected: 550 "5.1.1 mailbox unavailable"`,
+			wantRecipients: []string{"TO:<dev@example.com>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeSMTPServer(t, tt.rejectAuth, tt.rejectRcpt)
+			mailer, err := NewSMTPMailer(SMTPConfig{
+				Host:     "127.0.0.1",
+				Port:     server.port(),
+				Username: tt.username,
+				Password: "secret",
+				From:     "EzTest <alerts@example.com>",
+				Timeout:  5 * time.Second,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			message := msg
+			message.To = tt.to
+			err = mailer.Send(context.Background(), message)
+			server.wait(t)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if server.auth != tt.wantAuth {
+				t.Errorf("auth = %q, want %q", server.auth, tt.wantAuth)
+			}
+			if tt.wantErr == "" && server.from != "FROM:<alerts@example.com>" {
+				t.Errorf("mail from = %q, want FROM:<alerts@example.com>", server.from)
+			}
+			if !reflect.DeepEqual(server.recipients, tt.wantRecipients) {
+				t.Errorf("recipients = %q, want %q", server.recipients, tt.wantRecipients)
+			}
+			if !tt.wantData {
+				if server.data != nil {
+					t.Errorf("data sent after failure: %q", server.data)
+				}
+				return
+			}
+			checkMessage(t, server.data, message)
+		})
+	}
+}
+
+func TestSMTPMailerSendWithoutRecipients(t *testing.T) {
+	mailer, err := NewSMTPMailer(SMTPConfig{Host: "127.0.0.1", Port: 1, From: "alerts@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mailer.Send(context.Background(), Message{Subject: "x"}); err == nil || err.Error() != "email has no recipients" {
+		t.Fatalf("error = %v, want email has no recipients", err)
+	}
+}
+
+// checkMessage decodifica a mensagem recebida e confere os cabeçalhos e as partes em texto e HTML
+func checkMessage(t *testing.T, data []byte, want Message) {
+	t.Helper()
+	received, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("read message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(received.Header.Get("Subject"))
+	if err != nil || subject != want.Subject {
+		t.Errorf("subject = %q (%v), want %q", subject, err, want.Subject)
+	}
+	if to := received.Header.Get("To"); to != strings.Join(want.To, ", ") {
+		t.Errorf("to = %q, want %q", to, strings.Join(want.To, ", "))
+	}
+
+	mediaType, params, err := mime.ParseMediaType(received.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("content type = %q (%v), want multipart/alternative", received.Header.Get("Content-Type"), err)
+	}
+	parts := multipart.NewReader(received.Body, params["boundary"])
+	for _, wantPart := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", want.Text},
+		{"text/html; charset=UTF-8", want.HTML},
+	} {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+		// O leitor de multipart já decodifica o quoted-printable
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		if contentType := part.Header.Get("Content-Type"); contentType != wantPart.contentType {
+			t.Errorf("part content type = %q, want %q", contentType, wantPart.contentType)
+		}
+		if strings.ReplaceAll(string(content), "\r\n", "\n") != wantPart.content {
+			t.Errorf("part content = %q, want %q", content, wantPart.content)
+		}
+	}
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("extra part: %v", err)
+	}
+}
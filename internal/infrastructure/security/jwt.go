@@ -23,26 +23,34 @@ func NewJWTService(secretKey string, accessExpiry, refreshExpiry time.Duration)
 	}
 }
 
-// GenerateAccessToken gera um token de acesso
-func (j *JWTService) GenerateAccessToken(userID uuid.UUID, username, email string, companyID *uuid.UUID) (string, error) {
+// GenerateAccessToken gera um token de acesso. O jti identifica o token para revogação no logout
+// e o sid identifica a sessão (família de refresh tokens) em que ele foi emitido.
+// O iat_ms guarda a emissão em milissegundos, já que o iat só tem precisão de segundos.
+func (j *JWTService) GenerateAccessToken(userID uuid.UUID, username, email string, companyID *uuid.UUID, sessionID uuid.UUID) (string, error) {
+	now := time.Now()
 	claims := jwt.MapClaims{
 		"user_id":    userID.String(),
 		"username":   username,
 		"email":      email,
 		"company_id": companyID,
+		"jti":        uuid.New().String(),
+		"sid":        sessionID.String(),
 		"type":       "access",
-		"exp":        time.Now().Add(j.accessExpiry).Unix(),
-		"iat":        time.Now().Unix(),
+		"exp":        now.Add(j.accessExpiry).Unix(),
+		"iat":        now.Unix(),
+		"iat_ms":     now.UnixMilli(),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(j.secretKey))
 }
 
-// GenerateRefreshToken gera um token de refresh
+// GenerateRefreshToken gera um token de refresh. O jti torna cada token único, já que o
+// servidor identifica o token pelo seu hash.
 func (j *JWTService) GenerateRefreshToken(userID uuid.UUID) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID.String(),
+		"jti":     uuid.New().String(),
 		"type":    "refresh",
 		"exp":     time.Now().Add(j.refreshExpiry).Unix(),
 		"iat":     time.Now().Unix(),
@@ -52,9 +60,9 @@ func (j *JWTService) GenerateRefreshToken(userID uuid.UUID) (string, error) {
 	return token.SignedString([]byte(j.secretKey))
 }
 
-// GenerateTokenPair gera um par de tokens (access e refresh)
-func (j *JWTService) GenerateTokenPair(userID uuid.UUID, username, email string, companyID *uuid.UUID) (*TokenPair, error) {
-	accessToken, err := j.GenerateAccessToken(userID, username, email, companyID)
+// GenerateTokenPair gera um par de tokens (access e refresh) da sessão informada
+func (j *JWTService) GenerateTokenPair(userID uuid.UUID, username, email string, companyID *uuid.UUID, sessionID uuid.UUID) (*TokenPair, error) {
+	accessToken, err := j.GenerateAccessToken(userID, username, email, companyID, sessionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
@@ -124,17 +132,45 @@ func (j *JWTService) ValidateToken(tokenString string) (*TokenClaims, error) {
 		return nil, fmt.Errorf("invalid exp claim")
 	}
 
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("invalid iat claim")
+	}
+
+	// Tokens emitidos antes do iat_ms só têm a emissão em segundos
+	issuedAtMillis := int64(iat) * 1000
+	if iatMillis, ok := claims["iat_ms"].(float64); ok {
+		issuedAtMillis = int64(iatMillis)
+	}
+
+	tokenID, ok := claims["jti"].(string)
+	if !ok || tokenID == "" {
+		return nil, fmt.Errorf("invalid jti claim")
+	}
+
+	var sessionID uuid.UUID
+	if sessionIDStr, ok := claims["sid"].(string); ok {
+		if parsed, err := uuid.Parse(sessionIDStr); err == nil {
+			sessionID = parsed
+		}
+	}
+
 	return &TokenClaims{
-		UserID:    userID,
-		Username:  username,
-		Email:     email,
-		CompanyID: companyID,
-		ExpiresAt: int64(exp),
+		UserID:         userID,
+		Username:       username,
+		Email:          email,
+		CompanyID:      companyID,
+		TokenID:        tokenID,
+		SessionID:      sessionID,
+		IssuedAt:       int64(iat),
+		IssuedAtMillis: issuedAtMillis,
+		ExpiresAt:      int64(exp),
 	}, nil
 }
 
-// RefreshToken gera um novo access token a partir de um refresh token válido
-func (j *JWTService) RefreshToken(refreshTokenString string) (*TokenPair, error) {
+// ValidateRefreshToken valida a assinatura, a validade e o tipo de um refresh token e retorna o ID do usuário.
+// A verificação de uso e revogação é feita no servidor, pelo hash do token.
+func (j *JWTService) ValidateRefreshToken(refreshTokenString string) (uuid.UUID, error) {
 	token, err := jwt.Parse(refreshTokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -143,55 +179,43 @@ func (j *JWTService) RefreshToken(refreshTokenString string) (*TokenPair, error)
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse refresh token: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to parse refresh token: %w", err)
 	}
 
 	if !token.Valid {
-		return nil, fmt.Errorf("invalid refresh token")
+		return uuid.Nil, fmt.Errorf("invalid refresh token")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return nil, fmt.Errorf("invalid token claims")
+		return uuid.Nil, fmt.Errorf("invalid token claims")
 	}
 
 	// Verificar se é um refresh token
 	tokenType, ok := claims["type"].(string)
 	if !ok || tokenType != "refresh" {
-		return nil, fmt.Errorf("invalid token type")
+		return uuid.Nil, fmt.Errorf("invalid token type")
 	}
 
 	userIDStr, ok := claims["user_id"].(string)
 	if !ok {
-		return nil, fmt.Errorf("invalid user_id claim")
+		return uuid.Nil, fmt.Errorf("invalid user_id claim")
 	}
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user_id format: %w", err)
+		return uuid.Nil, fmt.Errorf("invalid user_id format: %w", err)
 	}
 
-	// TODO: Em produção, buscar dados atualizados do usuário no banco
-	// Por enquanto, mantemos as claims básicas para funcionalidade
-	// IMPORTANTE: Este é um ponto de melhoria - implementar busca no repositório
-	
-	accessClaims := jwt.MapClaims{
-		"user_id": userID.String(),
-		"type":    "access",
-		"exp":     time.Now().Add(j.accessExpiry).Unix(),
-		"iat":     time.Now().Unix(),
-		// TODO: Adicionar username, email, company_id buscando do banco
-	}
+	return userID, nil
+}
 
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessTokenString, err := accessToken.SignedString([]byte(j.secretKey))
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate access token: %w", err)
-	}
+// AccessExpiry retorna a validade dos access tokens emitidos
+func (j *JWTService) AccessExpiry() time.Duration {
+	return j.accessExpiry
+}
 
-	return &TokenPair{
-		AccessToken:  accessTokenString,
-		RefreshToken: refreshTokenString, // Mantém o mesmo refresh token
-		ExpiresIn:    int64(j.accessExpiry.Seconds()),
-	}, nil
-}
\ No newline at end of file
+// RefreshExpiry retorna a validade dos refresh tokens emitidos
+func (j *JWTService) RefreshExpiry() time.Duration {
+	return j.refreshExpiry
+}
@@ -0,0 +1,73 @@
+package security
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func TestValidateTokenIssuedAtMillis(t *testing.T) {
+	const secret = "test-secret"
+	service := NewJWTService(secret, time.Minute, time.Hour)
+	issuedAt := time.Now().Add(-time.Second)
+
+	tests := []struct {
+		name       string
+		withMillis bool
+		want       int64
+	}{
+		{name: "millisecond claim", withMillis: true, want: issuedAt.UnixMilli()},
+		{name: "token issued before the millisecond claim", want: issuedAt.Unix() * 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{
+				"user_id": uuid.New().String(),
+				"jti":     uuid.New().String(),
+				"sid":     uuid.New().String(),
+				"type":    "access",
+				"exp":     issuedAt.Add(time.Minute).Unix(),
+				"iat":     issuedAt.Unix(),
+			}
+			if tt.withMillis {
+				claims["iat_ms"] = issuedAt.UnixMilli()
+			}
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parsed, err := service.ValidateToken(token)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if parsed.IssuedAtMillis != tt.want {
+				t.Errorf("issued at millis = %d, want %d", parsed.IssuedAtMillis, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateAccessTokenIssuedAtMillis(t *testing.T) {
+	service := NewJWTService("test-secret", time.Minute, time.Hour)
+	before := time.Now().UnixMilli()
+	token, err := service.GenerateAccessToken(uuid.New(), "admin", "admin@example.com", nil, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := time.Now().UnixMilli()
+
+	claims, err := service.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claims.IssuedAtMillis < before || claims.IssuedAtMillis > after {
+		t.Errorf("issued at millis = %d, want between %d and %d", claims.IssuedAtMillis, before, after)
+	}
+	if claims.IssuedAt != claims.IssuedAtMillis/1000 {
+		t.Errorf("iat = %d, want %d", claims.IssuedAt, claims.IssuedAtMillis/1000)
+	}
+}
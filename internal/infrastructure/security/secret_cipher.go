@@ -0,0 +1,61 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// SecretCipher cifra e decifra segredos com AES-256-GCM.
+// O texto cifrado gravado é nonce || ciphertext || tag.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher cria uma nova instância do cifrador a partir da chave mestra.
+// A chave AES de 256 bits é derivada da chave mestra com SHA-256.
+func NewSecretCipher(masterKey string) (*SecretCipher, error) {
+	if masterKey == "" {
+		return nil, errors.New("secrets master key is required")
+	}
+
+	key := sha256.Sum256([]byte(masterKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return &SecretCipher{aead: aead}, nil
+}
+
+// Encrypt cifra o valor; additionalData vincula o texto cifrado ao seu dono (ex.: ID do segredo)
+func (s *SecretCipher) Encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt decifra um valor produzido por Encrypt com o mesmo additionalData
+func (s *SecretCipher) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	nonceSize := s.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	plaintext, err := s.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,12 @@
+package security
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashToken retorna o hash SHA-256 do token, usado para gravar tokens sem guardar o valor original
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
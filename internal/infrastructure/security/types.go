@@ -8,7 +8,12 @@ type TokenClaims struct {
 	Username  string     `json:"username"`
 	Email     string     `json:"email"`
 	CompanyID *uuid.UUID `json:"company_id"`
-	ExpiresAt int64      `json:"exp"`
+	TokenID   string     `json:"jti"`
+	SessionID uuid.UUID  `json:"sid"`
+	IssuedAt  int64      `json:"iat"`
+	// IssuedAtMillis é a emissão em milissegundos, usada para comparar com revogações do mesmo segundo
+	IssuedAtMillis int64 `json:"iat_ms"`
+	ExpiresAt      int64 `json:"exp"`
 }
 
 // TokenPair representa um par de tokens (access e refresh)
@@ -0,0 +1,38 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+// webhookSecretPrefix identifica os segredos de assinatura de webhooks
+const webhookSecretPrefix = "whsec_"
+
+// GenerateWebhookSecret gera um segredo aleatório de 256 bits para assinar os eventos
+func GenerateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
+
+// SignWebhookPayload assina "timestamp.payload" com HMAC-SHA256 e retorna "sha256=<hex>".
+// Incluir o timestamp permite ao receptor recusar eventos reenviados por terceiros.
+func SignWebhookPayload(secret []byte, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature confere, em tempo constante, a assinatura produzida por SignWebhookPayload
+func VerifyWebhookSignature(secret []byte, timestamp int64, payload []byte, signature string) bool {
+	expected := SignWebhookPayload(secret, timestamp, payload)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
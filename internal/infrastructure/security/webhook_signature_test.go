@@ -0,0 +1,54 @@
+package security
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	secret := []byte("whsec_test")
+	payload := []byte(`{"event":"test_run.finished"}`)
+	const timestamp = 1700000000
+	signature := SignWebhookPayload(secret, timestamp, payload)
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		timestamp int64
+		payload   []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, timestamp: timestamp, payload: payload, signature: signature, want: true},
+		{name: "other secret", secret: []byte("whsec_other"), timestamp: timestamp, payload: payload, signature: signature},
+		{name: "replayed with new timestamp", secret: secret, timestamp: timestamp + 60, payload: payload, signature: signature},
+		{name: "tampered payload", secret: secret, timestamp: timestamp, payload: []byte(`{"event":"ping"}`), signature: signature},
+		{name: "missing prefix", secret: secret, timestamp: timestamp, payload: payload, signature: strings.TrimPrefix(signature, "sha256=")},
+		{name: "empty signature", secret: secret, timestamp: timestamp, payload: payload},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyWebhookSignature(tt.secret, tt.timestamp, tt.payload, tt.signature); got != tt.want {
+				t.Errorf("VerifyWebhookSignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateWebhookSecret(t *testing.T) {
+	first, err := GenerateWebhookSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := GenerateWebhookSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(first, webhookSecretPrefix) || len(first) != len(webhookSecretPrefix)+64 {
+		t.Errorf("secret = %q, want %s followed by 64 hex digits", first, webhookSecretPrefix)
+	}
+	if first == second {
+		t.Error("generated secrets are equal")
+	}
+}
@@ -1,14 +1,17 @@
 package handlers
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"strings"
 
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/interfaces/services"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 )
 
 type AuthHandler struct {
@@ -80,7 +83,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	user, err := h.authService.Register(c.Request.Context(), registerReq)
 	if err != nil {
 		log.Printf("❌ [ERROR] AuthService.Register failed: %v", err)
-		if strings.Contains(err.Error(), "already exists") {
+		if errors.Is(err, domainErrors.ErrAlreadyExists) {
 			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 			return
 		}
@@ -101,7 +104,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 // Login godoc
 // @Summary Fazer login
-// @Description Autentica um usuário e retorna tokens JWT
+// @Description Autentica um usuário e retorna o access token JWT e o refresh token usado para renová-lo
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -124,13 +127,15 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	loginReq := &services.LoginRequest{
-		Username: req.Username,
-		Password: req.Password,
+		Username:  req.Username,
+		Password:  req.Password,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
 	}
 
 	result, err := h.authService.Login(c.Request.Context(), loginReq)
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid credentials") {
+		if errors.Is(err, domainErrors.ErrUnauthenticated) {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid username or password"})
 			return
 		}
@@ -139,37 +144,119 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"message":      "Login successful",
-		"access_token": result.Token,
-		"expires_at":   result.ExpiresAt,
+		"message":       "Login successful",
+		"access_token":  result.Token,
+		"refresh_token": result.RefreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    result.ExpiresIn,
+		"expires_at":    result.ExpiresAt,
 	})
 }
 
 // Logout godoc
 // @Summary Fazer logout
-// @Description Realiza logout do usuário (remove token no frontend)
+// @Description Revoga o access token usado na requisição e os refresh tokens da mesma sessão
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Success 200 {object} map[string]interface{} "Logout realizado com sucesso"
+// @Failure 401 {object} map[string]interface{} "Token inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /auth/logout [post]
 func (h *AuthHandler) Logout(c *gin.Context) {
-	// Na Clean Architecture, logout pode ser implementado no frontend
-	// removendo o token do storage local
+	// O middleware de autenticação já validou o formato "Bearer <token>"
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+
+	if err := h.authService.Logout(c.Request.Context(), token); err != nil {
+		if errors.Is(err, domainErrors.ErrUnauthenticated) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{"message": "Logout successful"})
 }
 
+// LogoutAll godoc
+// @Summary Sair de todas as sessões
+// @Description Revoga todos os access tokens e refresh tokens do usuário, encerrando as sessões em todos os dispositivos
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "Sessões encerradas com sucesso"
+// @Failure 401 {object} map[string]interface{} "Usuário não autenticado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+
+	id, ok := userID.(*uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if err := h.authService.LogoutAll(c.Request.Context(), *id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to logout from all sessions"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out from all sessions"})
+}
+
 // RefreshToken godoc
 // @Summary Renovar token de acesso
-// @Description Renova o token de acesso usando refresh token
+// @Description Troca o refresh token por um novo par de tokens, com as claims atualizadas do usuário.
+// @Description Cada refresh token só pode ser usado uma vez; reutilizar um token já usado revoga todos os tokens daquele login
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body RefreshTokenRequest true "Refresh token"
 // @Success 200 {object} map[string]interface{} "Token renovado com sucesso"
-// @Failure 501 {object} map[string]interface{} "Funcionalidade não implementada"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 401 {object} map[string]interface{} "Refresh token inválido, expirado ou revogado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{"error": "Refresh token not implemented"})
+	var req RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.authService.RefreshToken(c.Request.Context(), &services.RefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+		UserAgent:    c.Request.UserAgent(),
+		IPAddress:    c.ClientIP(),
+	})
+	if err != nil {
+		if errors.Is(err, domainErrors.ErrUnauthenticated) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to refresh token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Token refreshed successfully",
+		"access_token":  result.Token,
+		"refresh_token": result.RefreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    result.ExpiresIn,
+		"expires_at":    result.ExpiresAt,
+	})
 }
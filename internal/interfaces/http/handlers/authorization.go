@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	domainErrors "TestGO/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// companyFromContext retorna a empresa resolvida pelo middleware de permissões. Sem ela o handler
+// não sabe em qual empresa buscar o recurso, então a requisição é recusada.
+func companyFromContext(c *gin.Context) (uuid.UUID, bool) {
+	value, _ := c.Get("company_id")
+	companyID, ok := value.(uuid.UUID)
+	if !ok || companyID == uuid.Nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Company not resolved for this request"})
+		return uuid.Nil, false
+	}
+	return companyID, true
+}
+
+// respondAuthorizationError responde as recusas do serviço por falta de permissão no papel do
+// usuário (403). Quem não é membro da empresa recebe errors.ErrNotFound, tratado pelo handler como
+// qualquer recurso inexistente, para não revelar que o recurso existe em outra empresa.
+func respondAuthorizationError(c *gin.Context, err error) bool {
+	if !errors.Is(err, domainErrors.ErrForbidden) {
+		return false
+	}
+	c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient permissions"})
+	return true
+}
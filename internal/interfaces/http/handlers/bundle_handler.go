@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+type BundleHandler struct {
+	bundleService services.BundleService
+}
+
+func NewBundleHandler(bundleService services.BundleService) *BundleHandler {
+	return &BundleHandler{
+		bundleService: bundleService,
+	}
+}
+
+// Export godoc
+// @Summary Exportar suítes da empresa
+// @Description Gera um pacote determinístico (YAML ou JSON) com as suítes, asserções, extrações e ambientes da empresa,
+// @Description pronto para ser versionado em git. Suítes e ambientes são ordenados e identificados pelo nome;
+// @Description segredos não são exportados e schemas armazenados são referenciados pelo nome
+// @Tags test-suites
+// @Produce application/yaml
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param format query string false "Formato do pacote" Enums(yaml, json) default(yaml)
+// @Success 200 {object} services.TestSuiteBundle "Pacote das suítes"
+// @Failure 400 {object} map[string]interface{} "Parâmetros inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/test-suites/export [get]
+func (h *BundleHandler) Export(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	format := strings.ToLower(c.DefaultQuery("format", "yaml"))
+	if format != "yaml" && format != "json" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format, use yaml or json"})
+		return
+	}
+
+	bundle, err := h.bundleService.Export(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export test suites"})
+		return
+	}
+
+	data, contentType, err := encodeBundle(bundle, format)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export test suites"})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="test-suites.%s"`, format))
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// Import godoc
+// @Summary Importar pacote de suítes
+// @Description Sincroniza as suítes e os ambientes da empresa com um pacote exportado (YAML ou JSON): itens são
+// @Description comparados pelo nome, criados ou atualizados conforme o pacote, e os ausentes do pacote são removidos.
+// @Description Importar o mesmo pacote novamente não altera nada. Com dry_run=true apenas as diferenças são devolvidas
+// @Tags test-suites
+// @Accept application/yaml
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param dry_run query bool false "Apenas calcular as diferenças, sem gravar"
+// @Param bundle body services.TestSuiteBundle true "Pacote das suítes"
+// @Success 200 {object} services.BundleDiff "Diferenças aplicadas (ou que seriam aplicadas, em dry run)"
+// @Failure 400 {object} map[string]interface{} "Pacote inválido"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 413 {object} map[string]interface{} "Arquivo muito grande"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/test-suites/import [post]
+func (h *BundleHandler) Import(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	dryRun := false
+	if value := c.Query("dry_run"); value != "" {
+		dryRun, err = strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dry_run parameter"})
+			return
+		}
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Import file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	bundle, err := decodeBundle(data)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	diff, err := h.bundleService.Import(c.Request.Context(), &services.ImportBundleRequest{
+		CompanyID: companyID,
+		Bundle:    bundle,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import test suites"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// encodeBundle serializa o pacote no formato pedido e retorna o Content-Type correspondente
+func encodeBundle(bundle *services.TestSuiteBundle, format string) ([]byte, string, error) {
+	if format == "json" {
+		data, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return nil, "", err
+		}
+		return append(data, '\n'), "application/json", nil
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(bundle); err != nil {
+		return nil, "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "application/yaml", nil
+}
+
+// decodeBundle lê o pacote em JSON ou YAML, rejeitando campos desconhecidos
+func decodeBundle(data []byte) (*services.TestSuiteBundle, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("invalid bundle: file is empty")
+	}
+
+	var bundle services.TestSuiteBundle
+	if data[0] == '{' {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&bundle); err != nil {
+			return nil, fmt.Errorf("invalid bundle: %w", err)
+		}
+		return &bundle, nil
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&bundle); err != nil {
+		return nil, fmt.Errorf("invalid bundle: %w", err)
+	}
+	return &bundle, nil
+}
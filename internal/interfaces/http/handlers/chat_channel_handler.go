@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type ChatChannelHandler struct {
+	chatChannelService services.ChatChannelService
+	validator          *validator.Validate
+}
+
+func NewChatChannelHandler(chatChannelService services.ChatChannelService) *ChatChannelHandler {
+	return &ChatChannelHandler{
+		chatChannelService: chatChannelService,
+		validator:          validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateChatChannelRequest struct {
+	CompanyID    string `json:"company_id" validate:"required,uuid"`
+	Name         string `json:"name" validate:"required,min=1,max=100" example:"#qa-alerts"`
+	Provider     string `json:"provider" validate:"required,oneof=slack teams" example:"slack"`
+	WebhookURL   string `json:"webhook_url" validate:"required,url" example:"https://hooks.slack.com/services/T000/B000/XXXX"`
+	OnlyFailures bool   `json:"only_failures" example:"true"`
+	Enabled      *bool  `json:"enabled" validate:"omitempty"`
+}
+
+type UpdateChatChannelRequest struct {
+	Name         string `json:"name" validate:"omitempty,min=1,max=100"`
+	WebhookURL   string `json:"webhook_url" validate:"omitempty,url"`
+	OnlyFailures *bool  `json:"only_failures" validate:"omitempty"`
+	Enabled      *bool  `json:"enabled" validate:"omitempty"`
+}
+
+// Create godoc
+// @Summary Criar canal de chat
+// @Description Cadastra um webhook de entrada do Slack (Block Kit) ou do Microsoft Teams (Adaptive Card) que recebe
+// @Description o resumo das execuções encerradas. A URL do webhook é gravada cifrada e não é devolvida pela API
+// @Tags chat-channels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateChatChannelRequest true "Dados do canal"
+// @Success 201 {object} entities.ChatChannel "Canal criado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /chat-channels [post]
+func (h *ChatChannelHandler) Create(c *gin.Context) {
+	var req CreateChatChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	companyID, err := uuid.Parse(req.CompanyID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	createReq := &services.CreateChatChannelRequest{
+		CompanyID:    companyID,
+		Name:         req.Name,
+		Provider:     entities.ChatProvider(req.Provider),
+		WebhookURL:   req.WebhookURL,
+		OnlyFailures: req.OnlyFailures,
+		Enabled:      req.Enabled,
+	}
+
+	channel, err := h.chatChannelService.Create(c.Request.Context(), createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create chat channel"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, channel)
+}
+
+// GetByID godoc
+// @Summary Obter canal de chat por ID
+// @Description Retorna um canal de chat; a URL do webhook não é devolvida
+// @Tags chat-channels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do canal"
+// @Success 200 {object} entities.ChatChannel "Canal encontrado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Canal não encontrado"
+// @Router /chat-channels/{id} [get]
+func (h *ChatChannelHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid chat channel ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	channel, err := h.chatChannelService.GetByID(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Chat channel not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, channel)
+}
+
+// Update godoc
+// @Summary Atualizar canal de chat
+// @Description Atualiza o nome, a URL do webhook, o filtro de falhas ou o estado de um canal de chat
+// @Tags chat-channels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do canal"
+// @Param request body UpdateChatChannelRequest true "Dados para atualização"
+// @Success 200 {object} entities.ChatChannel "Canal atualizado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Canal não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /chat-channels/{id} [put]
+func (h *ChatChannelHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid chat channel ID"})
+		return
+	}
+
+	var req UpdateChatChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &services.UpdateChatChannelRequest{
+		Name:         req.Name,
+		WebhookURL:   req.WebhookURL,
+		OnlyFailures: req.OnlyFailures,
+		Enabled:      req.Enabled,
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	channel, err := h.chatChannelService.Update(c.Request.Context(), companyID, id, updateReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Chat channel not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update chat channel"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, channel)
+}
+
+// Delete godoc
+// @Summary Deletar canal de chat
+// @Description Remove um canal de chat
+// @Tags chat-channels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do canal"
+// @Success 200 {object} map[string]interface{} "Canal deletado com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Canal não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /chat-channels/{id} [delete]
+func (h *ChatChannelHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid chat channel ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.chatChannelService.Delete(c.Request.Context(), companyID, id); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Chat channel not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete chat channel"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Chat channel deleted successfully"})
+}
+
+// GetByCompanyID godoc
+// @Summary Listar canais de chat da empresa
+// @Description Retorna os canais de chat cadastrados pela empresa
+// @Tags chat-channels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param companyId path string true "ID da empresa"
+// @Success 200 {array} entities.ChatChannel "Canais da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /chat-channels/company/{companyId} [get]
+func (h *ChatChannelHandler) GetByCompanyID(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("companyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	channels, err := h.chatChannelService.GetByCompanyID(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get chat channels"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"chat_channels": channels,
+		"count":         len(channels),
+	})
+}
+
+// SendTestMessage godoc
+// @Summary Enviar mensagem de teste
+// @Description Publica uma mensagem de teste no canal, mesmo que esteja desativado
+// @Tags chat-channels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do canal"
+// @Success 200 {object} map[string]interface{} "Mensagem de teste enviada"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Canal não encontrado"
+// @Failure 502 {object} map[string]interface{} "O chat recusou a mensagem"
+// @Router /chat-channels/{id}/test [post]
+func (h *ChatChannelHandler) SendTestMessage(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid chat channel ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.chatChannelService.SendTestMessage(c.Request.Context(), companyID, id); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Chat channel not found"})
+			return
+		}
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Test message sent successfully"})
+}
@@ -39,7 +39,7 @@ type UpdateCompanyRequest struct {
 
 // Create godoc
 // @Summary Criar nova empresa
-// @Description Cria uma nova empresa no sistema
+// @Description Cria uma nova empresa no sistema; o usuário autenticado se torna o owner
 // @Tags companies
 // @Accept json
 // @Produce json
@@ -96,7 +96,7 @@ func (h *CompanyHandler) Create(c *gin.Context) {
 // @Param id path string true "ID da empresa"
 // @Success 200 {object} map[string]interface{} "Dados da empresa"
 // @Failure 400 {object} map[string]interface{} "ID inválido"
-// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada ou usuário não é membro dela"
 // @Router /companies/{id} [get]
 func (h *CompanyHandler) GetByID(c *gin.Context) {
 	idParam := c.Param("id")
@@ -108,6 +108,9 @@ func (h *CompanyHandler) GetByID(c *gin.Context) {
 
 	company, err := h.companyService.GetByID(c.Request.Context(), id)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
 		return
 	}
@@ -134,6 +137,7 @@ func (h *CompanyHandler) GetByID(c *gin.Context) {
 // @Param request body UpdateCompanyRequest true "Dados para atualização"
 // @Success 200 {object} map[string]interface{} "Empresa atualizada com sucesso"
 // @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 403 {object} map[string]interface{} "Papel sem permissão para editar a empresa"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /companies/{id} [put]
 func (h *CompanyHandler) Update(c *gin.Context) {
@@ -164,6 +168,9 @@ func (h *CompanyHandler) Update(c *gin.Context) {
 
 	company, err := h.companyService.Update(c.Request.Context(), id, updateReq)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update company"})
 		return
 	}
@@ -182,7 +189,7 @@ func (h *CompanyHandler) Update(c *gin.Context) {
 
 // Delete godoc
 // @Summary Deletar empresa
-// @Description Remove uma empresa do sistema
+// @Description Remove uma empresa do sistema. Apenas owners podem excluir a empresa
 // @Tags companies
 // @Accept json
 // @Produce json
@@ -190,6 +197,7 @@ func (h *CompanyHandler) Update(c *gin.Context) {
 // @Param id path string true "ID da empresa"
 // @Success 200 {object} map[string]interface{} "Empresa deletada com sucesso"
 // @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 403 {object} map[string]interface{} "Apenas owners podem excluir a empresa"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /companies/{id} [delete]
 func (h *CompanyHandler) Delete(c *gin.Context) {
@@ -202,6 +210,9 @@ func (h *CompanyHandler) Delete(c *gin.Context) {
 
 	err = h.companyService.Delete(c.Request.Context(), id)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete company"})
 		return
 	}
@@ -211,7 +222,7 @@ func (h *CompanyHandler) Delete(c *gin.Context) {
 
 // List godoc
 // @Summary Listar empresas
-// @Description Retorna uma lista paginada de empresas
+// @Description Retorna uma lista paginada das empresas das quais o usuário autenticado é membro
 // @Tags companies
 // @Accept json
 // @Produce json
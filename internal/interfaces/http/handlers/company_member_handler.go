@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type CompanyMemberHandler struct {
+	companyMemberService services.CompanyMemberService
+	validator            *validator.Validate
+}
+
+func NewCompanyMemberHandler(companyMemberService services.CompanyMemberService) *CompanyMemberHandler {
+	return &CompanyMemberHandler{
+		companyMemberService: companyMemberService,
+		validator:            validator.New(),
+	}
+}
+
+// Request structs para o handler
+type AddCompanyMemberRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+	Role   string `json:"role" validate:"required,oneof=owner admin editor viewer"`
+}
+
+type UpdateCompanyMemberRequest struct {
+	Role string `json:"role" validate:"required,oneof=owner admin editor viewer"`
+}
+
+// parseMemberParams lê os IDs de empresa e usuário da rota
+func parseMemberParams(c *gin.Context) (companyID, userID uuid.UUID, ok bool) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err = uuid.Parse(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return companyID, userID, true
+}
+
+// List godoc
+// @Summary Listar membros da empresa
+// @Description Lista os usuários vinculados à empresa e o papel de cada um (owner, admin, editor ou viewer)
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Success 200 {object} map[string]interface{} "Membros da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada ou usuário não é membro dela"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/members [get]
+func (h *CompanyMemberHandler) List(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	members, err := h.companyMemberService.List(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list company members"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"members": members,
+		"count":   len(members),
+	})
+}
+
+// Add godoc
+// @Summary Adicionar membro à empresa
+// @Description Vincula um usuário existente à empresa com o papel informado. Apenas owners concedem o papel de owner
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param request body AddCompanyMemberRequest true "Usuário e papel"
+// @Success 201 {object} entities.CompanyMember "Membro adicionado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 403 {object} map[string]interface{} "Papel sem permissão para gerenciar membros"
+// @Failure 404 {object} map[string]interface{} "Usuário não encontrado"
+// @Failure 409 {object} map[string]interface{} "Usuário já é membro da empresa"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/members [post]
+func (h *CompanyMemberHandler) Add(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	var req AddCompanyMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	addReq := &services.AddCompanyMemberRequest{
+		UserID: userID,
+		Role:   entities.CompanyRole(req.Role),
+	}
+
+	member, err := h.companyMemberService.Add(c.Request.Context(), companyID, addReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "User is already a member of the company"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add company member"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, member)
+}
+
+// UpdateRole godoc
+// @Summary Alterar papel de um membro
+// @Description Altera o papel de um membro da empresa. Apenas owners concedem ou retiram o papel de owner,
+// @Description e a empresa não pode ficar sem owner
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param userId path string true "ID do usuário"
+// @Param request body UpdateCompanyMemberRequest true "Novo papel"
+// @Success 200 {object} entities.CompanyMember "Papel alterado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 403 {object} map[string]interface{} "Papel sem permissão para gerenciar membros"
+// @Failure 404 {object} map[string]interface{} "Membro não encontrado"
+// @Failure 409 {object} map[string]interface{} "A empresa ficaria sem owner"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/members/{userId} [put]
+func (h *CompanyMemberHandler) UpdateRole(c *gin.Context) {
+	companyID, userID, ok := parseMemberParams(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateCompanyMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member, err := h.companyMemberService.UpdateRole(c.Request.Context(), companyID, userID, entities.CompanyRole(req.Role))
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		respondMemberError(c, err, "Failed to update company member")
+		return
+	}
+
+	c.JSON(http.StatusOK, member)
+}
+
+// Remove godoc
+// @Summary Remover membro da empresa
+// @Description Desvincula o usuário da empresa. Apenas owners removem outro owner, e a empresa não pode ficar sem owner
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param userId path string true "ID do usuário"
+// @Success 200 {object} map[string]interface{} "Membro removido com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 403 {object} map[string]interface{} "Papel sem permissão para gerenciar membros"
+// @Failure 404 {object} map[string]interface{} "Membro não encontrado"
+// @Failure 409 {object} map[string]interface{} "A empresa ficaria sem owner"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/members/{userId} [delete]
+func (h *CompanyMemberHandler) Remove(c *gin.Context) {
+	companyID, userID, ok := parseMemberParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.companyMemberService.Remove(c.Request.Context(), companyID, userID); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		respondMemberError(c, err, "Failed to remove company member")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Company member removed successfully"})
+}
+
+// respondMemberError responde os erros da alteração e da remoção de membros
+func respondMemberError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, domainErrors.ErrInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, domainErrors.ErrConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": "Company must have at least one owner"})
+	case errors.Is(err, domainErrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Company member not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type EnvironmentHandler struct {
+	environmentService services.EnvironmentService
+	validator          *validator.Validate
+}
+
+func NewEnvironmentHandler(environmentService services.EnvironmentService) *EnvironmentHandler {
+	return &EnvironmentHandler{
+		environmentService: environmentService,
+		validator:          validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateEnvironmentRequest struct {
+	Name      string            `json:"name" validate:"required,min=1,max=100"`
+	Variables map[string]string `json:"variables" validate:"omitempty,dive,keys,required,endkeys"`
+}
+
+type UpdateEnvironmentRequest struct {
+	Name      string            `json:"name" validate:"omitempty,min=1,max=100"`
+	Variables map[string]string `json:"variables" validate:"omitempty,dive,keys,required,endkeys"`
+}
+
+// parseEnvironmentParams lê os IDs de empresa e ambiente da rota
+func parseEnvironmentParams(c *gin.Context) (companyID, environmentID uuid.UUID, ok bool) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	environmentID, err = uuid.Parse(c.Param("environmentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid environment ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return companyID, environmentID, true
+}
+
+// Create godoc
+// @Summary Criar ambiente
+// @Description Cria um ambiente da empresa com as variáveis usadas em {{variável}} nas suítes de teste
+// @Tags environments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param request body CreateEnvironmentRequest true "Dados do ambiente"
+// @Success 201 {object} entities.Environment "Ambiente criado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 409 {object} map[string]interface{} "Nome de ambiente já existe"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/environments [post]
+func (h *EnvironmentHandler) Create(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	var req CreateEnvironmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createReq := &services.CreateEnvironmentRequest{
+		Name:      req.Name,
+		Variables: req.Variables,
+	}
+
+	environment, err := h.environmentService.Create(c.Request.Context(), companyID, createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Environment name already exists"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create environment"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, environment)
+}
+
+// GetByID godoc
+// @Summary Obter ambiente por ID
+// @Description Retorna um ambiente da empresa com suas variáveis
+// @Tags environments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param environmentId path string true "ID do ambiente"
+// @Success 200 {object} entities.Environment "Ambiente encontrado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Ambiente não encontrado"
+// @Router /companies/{id}/environments/{environmentId} [get]
+func (h *EnvironmentHandler) GetByID(c *gin.Context) {
+	companyID, environmentID, ok := parseEnvironmentParams(c)
+	if !ok {
+		return
+	}
+
+	environment, err := h.environmentService.GetByID(c.Request.Context(), companyID, environmentID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Environment not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, environment)
+}
+
+// Update godoc
+// @Summary Atualizar ambiente
+// @Description Atualiza o nome e/ou substitui as variáveis de um ambiente
+// @Tags environments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param environmentId path string true "ID do ambiente"
+// @Param request body UpdateEnvironmentRequest true "Dados para atualização"
+// @Success 200 {object} entities.Environment "Ambiente atualizado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Ambiente não encontrado"
+// @Failure 409 {object} map[string]interface{} "Nome de ambiente já existe"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/environments/{environmentId} [put]
+func (h *EnvironmentHandler) Update(c *gin.Context) {
+	companyID, environmentID, ok := parseEnvironmentParams(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateEnvironmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &services.UpdateEnvironmentRequest{
+		Name:      req.Name,
+		Variables: req.Variables,
+	}
+
+	environment, err := h.environmentService.Update(c.Request.Context(), companyID, environmentID, updateReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Environment name already exists"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Environment not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update environment"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, environment)
+}
+
+// Delete godoc
+// @Summary Deletar ambiente
+// @Description Remove um ambiente da empresa
+// @Tags environments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param environmentId path string true "ID do ambiente"
+// @Success 200 {object} map[string]interface{} "Ambiente deletado com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Ambiente não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/environments/{environmentId} [delete]
+func (h *EnvironmentHandler) Delete(c *gin.Context) {
+	companyID, environmentID, ok := parseEnvironmentParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.environmentService.Delete(c.Request.Context(), companyID, environmentID); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Environment not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete environment"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Environment deleted successfully"})
+}
+
+// List godoc
+// @Summary Listar ambientes da empresa
+// @Description Retorna os ambientes da empresa ordenados por nome
+// @Tags environments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Success 200 {array} entities.Environment "Ambientes da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/environments [get]
+func (h *EnvironmentHandler) List(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	environments, err := h.environmentService.GetByCompanyID(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get environments"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"environments": environments,
+		"count":        len(environments),
+	})
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Tamanho máximo aceito para o arquivo importado
+const maxImportSize = 10 << 20
+
+type ImportHandler struct {
+	importService services.ImportService
+}
+
+func NewImportHandler(importService services.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// ImportPostman godoc
+// @Summary Importar coleção do Postman
+// @Description Converte uma coleção Postman v2.1 (JSON exportado) em suítes de teste da empresa. Cada requisição vira
+// @Description uma suíte com o caminho das pastas no nome; as variáveis da coleção são gravadas no ambiente informado
+// @Description (criado se não existir, sem sobrescrever variáveis existentes). Scripts, arquivos e autenticações não
+// @Description suportadas são listados em "skipped"
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param environment query string false "Nome do ambiente que recebe as variáveis (padrão: nome da coleção)"
+// @Param collection body object true "Coleção Postman v2.1"
+// @Success 201 {object} services.ImportReport "Relatório da importação"
+// @Failure 400 {object} map[string]interface{} "Coleção inválida"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 413 {object} map[string]interface{} "Arquivo muito grande"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/imports/postman [post]
+func (h *ImportHandler) ImportPostman(c *gin.Context) {
+	h.handleImport(c, h.importService.ImportPostman)
+}
+
+// ImportOpenAPI godoc
+// @Summary Importar documento OpenAPI
+// @Description Gera uma suíte de teste por operação de um documento OpenAPI 3.0/3.1 (JSON ou YAML): método e URL do
+// @Description caminho, corpo a partir dos exemplos (ou gerado pelo schema), status esperado da primeira resposta 2xx
+// @Description e schema da resposta. A URL do primeiro servidor é gravada como baseUrl no ambiente informado.
+// @Description Reimportar o documento atualiza as suítes geradas anteriormente, identificadas pelo operationId,
+// @Description mantendo as asserções, extrações e dependências configuradas
+// @Tags imports
+// @Accept json
+// @Accept application/yaml
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param environment query string false "Nome do ambiente que recebe as variáveis (padrão: título do documento)"
+// @Param document body object true "Documento OpenAPI 3.0/3.1"
+// @Success 201 {object} services.ImportReport "Relatório da importação"
+// @Failure 400 {object} map[string]interface{} "Documento inválido"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 413 {object} map[string]interface{} "Arquivo muito grande"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/imports/openapi [post]
+func (h *ImportHandler) ImportOpenAPI(c *gin.Context) {
+	h.handleImport(c, h.importService.ImportOpenAPI)
+}
+
+// ImportHAR godoc
+// @Summary Importar gravação HAR
+// @Description Converte as requisições de um arquivo HAR 1.2 (gravação do navegador) em suítes de teste da empresa,
+// @Description com método, URL, cabeçalhos, cookies e corpo; o status gravado na resposta vira o status esperado.
+// @Description Recursos estáticos da página (imagens, scripts, estilos, fontes) e requisições repetidas são ignorados
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param har body object true "Arquivo HAR 1.2"
+// @Success 201 {object} services.ImportReport "Relatório da importação"
+// @Failure 400 {object} map[string]interface{} "Arquivo inválido"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 413 {object} map[string]interface{} "Arquivo muito grande"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/imports/har [post]
+func (h *ImportHandler) ImportHAR(c *gin.Context) {
+	h.handleImport(c, h.importService.ImportHAR)
+}
+
+// ImportCurl godoc
+// @Summary Importar comandos curl
+// @Description Converte um ou mais comandos curl colados como texto (inclusive o "Copy as cURL" dos navegadores) em
+// @Description suítes de teste da empresa, com método, URL, cabeçalhos, cookies, autenticação básica e corpo
+// @Tags imports
+// @Accept plain
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param command body string true "Comandos curl"
+// @Success 201 {object} services.ImportReport "Relatório da importação"
+// @Failure 400 {object} map[string]interface{} "Comando inválido"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 413 {object} map[string]interface{} "Arquivo muito grande"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/imports/curl [post]
+func (h *ImportHandler) ImportCurl(c *gin.Context) {
+	h.handleImport(c, h.importService.ImportCurl)
+}
+
+// handleImport lê o arquivo enviado no corpo e executa a importação
+func (h *ImportHandler) handleImport(c *gin.Context, importFn func(ctx context.Context, req *services.ImportRequest) (*services.ImportReport, error)) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Import file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+	if len(data) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Import file is empty"})
+		return
+	}
+
+	report, err := importFn(c.Request.Context(), &services.ImportRequest{
+		CompanyID:       companyID,
+		Data:            data,
+		EnvironmentName: strings.TrimSpace(c.Query("environment")),
+	})
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import file"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, report)
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type JSONSchemaHandler struct {
+	jsonSchemaService services.JSONSchemaService
+	validator         *validator.Validate
+}
+
+func NewJSONSchemaHandler(jsonSchemaService services.JSONSchemaService) *JSONSchemaHandler {
+	return &JSONSchemaHandler{
+		jsonSchemaService: jsonSchemaService,
+		validator:         validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateJSONSchemaRequest struct {
+	CompanyID   string          `json:"company_id" validate:"required,uuid"`
+	Name        string          `json:"name" validate:"required,min=3,max=100"`
+	Description string          `json:"description" validate:"omitempty,max=500"`
+	Schema      json.RawMessage `json:"schema" validate:"required" swaggertype:"object"`
+}
+
+type UpdateJSONSchemaRequest struct {
+	Name        string          `json:"name" validate:"omitempty,min=3,max=100"`
+	Description string          `json:"description" validate:"omitempty,max=500"`
+	Schema      json.RawMessage `json:"schema" swaggertype:"object"`
+}
+
+// Create godoc
+// @Summary Criar JSON Schema
+// @Description Armazena um JSON Schema (draft 2020-12) da empresa para validar respostas das suítes de teste
+// @Tags json-schemas
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateJSONSchemaRequest true "Dados do schema"
+// @Success 201 {object} entities.JSONSchema "Schema criado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos ou schema inválido"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /json-schemas [post]
+func (h *JSONSchemaHandler) Create(c *gin.Context) {
+	var req CreateJSONSchemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Converter string para UUID
+	companyID, err := uuid.Parse(req.CompanyID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	createReq := &services.CreateJSONSchemaRequest{
+		CompanyID:   companyID,
+		Name:        req.Name,
+		Description: req.Description,
+		Schema:      req.Schema,
+	}
+
+	schema, err := h.jsonSchemaService.Create(c.Request.Context(), createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create json schema"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, schema)
+}
+
+// GetByID godoc
+// @Summary Obter JSON Schema por ID
+// @Description Retorna um JSON Schema armazenado
+// @Tags json-schemas
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do schema"
+// @Success 200 {object} entities.JSONSchema "Schema encontrado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Schema não encontrado"
+// @Router /json-schemas/{id} [get]
+func (h *JSONSchemaHandler) GetByID(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid json schema ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	schema, err := h.jsonSchemaService.GetByID(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "JSON schema not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, schema)
+}
+
+// Update godoc
+// @Summary Atualizar JSON Schema
+// @Description Atualiza nome, descrição ou conteúdo de um JSON Schema armazenado
+// @Tags json-schemas
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do schema"
+// @Param request body UpdateJSONSchemaRequest true "Dados para atualização"
+// @Success 200 {object} entities.JSONSchema "Schema atualizado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos ou schema inválido"
+// @Failure 404 {object} map[string]interface{} "Schema não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /json-schemas/{id} [put]
+func (h *JSONSchemaHandler) Update(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid json schema ID"})
+		return
+	}
+
+	var req UpdateJSONSchemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &services.UpdateJSONSchemaRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Schema:      req.Schema,
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	schema, err := h.jsonSchemaService.Update(c.Request.Context(), companyID, id, updateReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "JSON schema not found"})
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update json schema"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, schema)
+}
+
+// Delete godoc
+// @Summary Deletar JSON Schema
+// @Description Remove um JSON Schema que não esteja referenciado por suítes de teste
+// @Tags json-schemas
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do schema"
+// @Success 200 {object} map[string]interface{} "Schema deletado com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Schema não encontrado"
+// @Failure 409 {object} map[string]interface{} "Schema em uso por suítes de teste"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /json-schemas/{id} [delete]
+func (h *JSONSchemaHandler) Delete(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid json schema ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.jsonSchemaService.Delete(c.Request.Context(), companyID, id); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "JSON schema not found"})
+		case errors.Is(err, domainErrors.ErrConflict):
+			c.JSON(http.StatusConflict, gin.H{"error": "JSON schema is in use by test suites"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete json schema"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "JSON schema deleted successfully"})
+}
+
+// List godoc
+// @Summary Listar JSON Schemas da empresa
+// @Description Retorna os JSON Schemas armazenados pela empresa
+// @Tags json-schemas
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param company_id query string true "ID da empresa"
+// @Success 200 {array} entities.JSONSchema "Schemas da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /json-schemas [get]
+func (h *JSONSchemaHandler) List(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Query("company_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	schemas, err := h.jsonSchemaService.GetByCompanyID(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get json schemas"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"json_schemas": schemas,
+		"count":        len(schemas),
+	})
+}
@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type ScheduleHandler struct {
+	scheduleService services.ScheduleService
+	validator       *validator.Validate
+}
+
+func NewScheduleHandler(scheduleService services.ScheduleService) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduleService: scheduleService,
+		validator:       validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateScheduleRequest struct {
+	Name           string   `json:"name" validate:"required,min=1,max=100" example:"Monitoramento de produção"`
+	CronExpression string   `json:"cron_expression" validate:"required" example:"*/5 * * * *"`
+	Timezone       string   `json:"timezone" validate:"omitempty" example:"America/Sao_Paulo"`
+	TestSuiteIDs   []string `json:"test_suite_ids" validate:"omitempty,dive,uuid"`
+	EnvironmentID  string   `json:"environment_id" validate:"omitempty,uuid"`
+	Enabled        *bool    `json:"enabled" validate:"omitempty"`
+}
+
+// UpdateScheduleRequest mantém os campos ausentes; environment_id "" remove o ambiente
+// e test_suite_ids [] passa a executar todas as suítes da empresa
+type UpdateScheduleRequest struct {
+	Name           string    `json:"name" validate:"omitempty,min=1,max=100"`
+	CronExpression string    `json:"cron_expression" validate:"omitempty"`
+	Timezone       string    `json:"timezone" validate:"omitempty"`
+	TestSuiteIDs   *[]string `json:"test_suite_ids" validate:"omitempty"`
+	EnvironmentID  *string   `json:"environment_id" validate:"omitempty"`
+	Enabled        *bool     `json:"enabled" validate:"omitempty"`
+}
+
+// parseScheduleParams lê os IDs de empresa e agendamento da rota
+func parseScheduleParams(c *gin.Context) (companyID, scheduleID uuid.UUID, ok bool) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	scheduleID, err = uuid.Parse(c.Param("scheduleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid schedule ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return companyID, scheduleID, true
+}
+
+// Create godoc
+// @Summary Criar agendamento
+// @Description Cria um agendamento cron que enfileira execuções das suítes selecionadas (ou de todas) da empresa.
+// @Description A expressão usa cinco campos (minuto hora dia mês dia-da-semana) ou atalhos como @hourly e @every 10m,
+// @Description avaliada no fuso horário informado (padrão UTC)
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param request body CreateScheduleRequest true "Dados do agendamento"
+// @Success 201 {object} entities.Schedule "Agendamento criado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa, suíte ou ambiente não encontrado"
+// @Failure 409 {object} map[string]interface{} "Nome de agendamento já existe"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/schedules [post]
+func (h *ScheduleHandler) Create(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	var req CreateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	testSuiteIDs, err := parseUUIDs(req.TestSuiteIDs)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test suite ID"})
+		return
+	}
+
+	var environmentID *uuid.UUID
+	if req.EnvironmentID != "" {
+		id, err := uuid.Parse(req.EnvironmentID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid environment ID"})
+			return
+		}
+		environmentID = &id
+	}
+
+	createReq := &services.CreateScheduleRequest{
+		Name:           req.Name,
+		CronExpression: req.CronExpression,
+		Timezone:       req.Timezone,
+		TestSuiteIDs:   testSuiteIDs,
+		EnvironmentID:  environmentID,
+		Enabled:        req.Enabled,
+	}
+
+	schedule, err := h.scheduleService.Create(c.Request.Context(), companyID, createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Schedule name already exists"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create schedule"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, schedule)
+}
+
+// GetByID godoc
+// @Summary Obter agendamento por ID
+// @Description Retorna um agendamento da empresa com o próximo disparo previsto
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param scheduleId path string true "ID do agendamento"
+// @Success 200 {object} entities.Schedule "Agendamento encontrado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Agendamento não encontrado"
+// @Router /companies/{id}/schedules/{scheduleId} [get]
+func (h *ScheduleHandler) GetByID(c *gin.Context) {
+	companyID, scheduleID, ok := parseScheduleParams(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.GetByID(c.Request.Context(), companyID, scheduleID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Schedule not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+// Update godoc
+// @Summary Atualizar agendamento
+// @Description Atualiza a expressão, o fuso, as suítes, o ambiente ou o estado de um agendamento;
+// @Description o próximo disparo é recalculado
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param scheduleId path string true "ID do agendamento"
+// @Param request body UpdateScheduleRequest true "Dados para atualização"
+// @Success 200 {object} entities.Schedule "Agendamento atualizado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Agendamento, suíte ou ambiente não encontrado"
+// @Failure 409 {object} map[string]interface{} "Nome de agendamento já existe"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/schedules/{scheduleId} [put]
+func (h *ScheduleHandler) Update(c *gin.Context) {
+	companyID, scheduleID, ok := parseScheduleParams(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &services.UpdateScheduleRequest{
+		Name:           req.Name,
+		CronExpression: req.CronExpression,
+		Timezone:       req.Timezone,
+		Enabled:        req.Enabled,
+	}
+
+	if req.TestSuiteIDs != nil {
+		testSuiteIDs, err := parseUUIDs(*req.TestSuiteIDs)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test suite ID"})
+			return
+		}
+		updateReq.TestSuiteIDs = &testSuiteIDs
+	}
+
+	if req.EnvironmentID != nil {
+		environmentID := uuid.Nil
+		if *req.EnvironmentID != "" {
+			id, err := uuid.Parse(*req.EnvironmentID)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid environment ID"})
+				return
+			}
+			environmentID = id
+		}
+		updateReq.EnvironmentID = &environmentID
+	}
+
+	schedule, err := h.scheduleService.Update(c.Request.Context(), companyID, scheduleID, updateReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Schedule name already exists"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update schedule"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+// Delete godoc
+// @Summary Deletar agendamento
+// @Description Remove um agendamento da empresa; as execuções já disparadas são mantidas
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param scheduleId path string true "ID do agendamento"
+// @Success 200 {object} map[string]interface{} "Agendamento deletado com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Agendamento não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/schedules/{scheduleId} [delete]
+func (h *ScheduleHandler) Delete(c *gin.Context) {
+	companyID, scheduleID, ok := parseScheduleParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.scheduleService.Delete(c.Request.Context(), companyID, scheduleID); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Schedule not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete schedule"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Schedule deleted successfully"})
+}
+
+// List godoc
+// @Summary Listar agendamentos da empresa
+// @Description Retorna os agendamentos da empresa ordenados por nome
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Success 200 {array} entities.Schedule "Agendamentos da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/schedules [get]
+func (h *ScheduleHandler) List(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	schedules, err := h.scheduleService.GetByCompanyID(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get schedules"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schedules": schedules,
+		"count":     len(schedules),
+	})
+}
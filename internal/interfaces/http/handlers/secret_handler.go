@@ -0,0 +1,271 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type SecretHandler struct {
+	secretService services.SecretService
+	validator     *validator.Validate
+}
+
+func NewSecretHandler(secretService services.SecretService) *SecretHandler {
+	return &SecretHandler{
+		secretService: secretService,
+		validator:     validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateSecretRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=100"`
+	Value string `json:"value" validate:"required"`
+}
+
+type UpdateSecretRequest struct {
+	Name  string  `json:"name" validate:"omitempty,min=1,max=100"`
+	Value *string `json:"value" validate:"omitempty"`
+}
+
+// parseSecretParams lê os IDs de empresa e segredo da rota
+func parseSecretParams(c *gin.Context) (companyID, secretID uuid.UUID, ok bool) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	secretID, err = uuid.Parse(c.Param("secretId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid secret ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return companyID, secretID, true
+}
+
+// Create godoc
+// @Summary Criar segredo
+// @Description Grava um segredo da empresa cifrado, referenciado nas suítes como {{secret.NOME}}. O valor nunca é devolvido pela API
+// @Tags secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param request body CreateSecretRequest true "Nome e valor do segredo"
+// @Success 201 {object} entities.Secret "Segredo criado com sucesso (sem o valor)"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 409 {object} map[string]interface{} "Nome de segredo já existe"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/secrets [post]
+func (h *SecretHandler) Create(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	var req CreateSecretRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	createReq := &services.CreateSecretRequest{
+		Name:  req.Name,
+		Value: req.Value,
+	}
+
+	secret, err := h.secretService.Create(c.Request.Context(), companyID, createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Secret name already exists"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create secret"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, secret)
+}
+
+// GetByID godoc
+// @Summary Obter segredo por ID
+// @Description Retorna os metadados de um segredo da empresa, sem o valor
+// @Tags secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param secretId path string true "ID do segredo"
+// @Success 200 {object} entities.Secret "Segredo encontrado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Segredo não encontrado"
+// @Router /companies/{id}/secrets/{secretId} [get]
+func (h *SecretHandler) GetByID(c *gin.Context) {
+	companyID, secretID, ok := parseSecretParams(c)
+	if !ok {
+		return
+	}
+
+	secret, err := h.secretService.GetByID(c.Request.Context(), companyID, secretID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Secret not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, secret)
+}
+
+// Update godoc
+// @Summary Atualizar segredo
+// @Description Renomeia o segredo e/ou substitui (rotaciona) o seu valor
+// @Tags secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param secretId path string true "ID do segredo"
+// @Param request body UpdateSecretRequest true "Dados para atualização"
+// @Success 200 {object} entities.Secret "Segredo atualizado com sucesso (sem o valor)"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Segredo não encontrado"
+// @Failure 409 {object} map[string]interface{} "Nome de segredo já existe"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/secrets/{secretId} [put]
+func (h *SecretHandler) Update(c *gin.Context) {
+	companyID, secretID, ok := parseSecretParams(c)
+	if !ok {
+		return
+	}
+
+	var req UpdateSecretRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &services.UpdateSecretRequest{
+		Name:  req.Name,
+		Value: req.Value,
+	}
+
+	secret, err := h.secretService.Update(c.Request.Context(), companyID, secretID, updateReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Secret name already exists"})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Secret not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update secret"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, secret)
+}
+
+// Delete godoc
+// @Summary Deletar segredo
+// @Description Remove um segredo da empresa
+// @Tags secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Param secretId path string true "ID do segredo"
+// @Success 200 {object} map[string]interface{} "Segredo deletado com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Segredo não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/secrets/{secretId} [delete]
+func (h *SecretHandler) Delete(c *gin.Context) {
+	companyID, secretID, ok := parseSecretParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.secretService.Delete(c.Request.Context(), companyID, secretID); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Secret not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete secret"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Secret deleted successfully"})
+}
+
+// List godoc
+// @Summary Listar segredos da empresa
+// @Description Retorna os metadados dos segredos da empresa ordenados por nome, sem os valores
+// @Tags secrets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da empresa"
+// @Success 200 {array} entities.Secret "Segredos da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /companies/{id}/secrets [get]
+func (h *SecretHandler) List(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	secrets, err := h.secretService.GetByCompanyID(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get secrets"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"secrets": secrets,
+		"count":   len(secrets),
+	})
+}
@@ -0,0 +1,399 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type TestRunHandler struct {
+	testRunService services.TestRunService
+	validator      *validator.Validate
+}
+
+func NewTestRunHandler(testRunService services.TestRunService) *TestRunHandler {
+	return &TestRunHandler{
+		testRunService: testRunService,
+		validator:      validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateTestRunRequest struct {
+	CompanyID     string   `json:"company_id" validate:"required,uuid"`
+	TestSuiteIDs  []string `json:"test_suite_ids" validate:"omitempty,dive,uuid"`
+	EnvironmentID string   `json:"environment_id" validate:"omitempty,uuid"`
+}
+
+// Create godoc
+// @Summary Enfileirar execução de suítes de teste
+// @Description Cria uma execução na fila para a empresa; as suítes selecionadas (ou todas) são executadas em background,
+// @Description com as variáveis {{variável}} substituídas pelas do ambiente informado. Suítes cujas dependências não
+// @Description foram selecionadas são puladas
+// @Tags test-runs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateTestRunRequest true "Empresa e suítes a executar"
+// @Success 202 {object} entities.TestRun "Execução enfileirada"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa, suíte ou ambiente não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /test-runs [post]
+func (h *TestRunHandler) Create(c *gin.Context) {
+	var req CreateTestRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Converter strings para UUID
+	companyID, err := uuid.Parse(req.CompanyID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	testSuiteIDs := make([]uuid.UUID, 0, len(req.TestSuiteIDs))
+	for _, idStr := range req.TestSuiteIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test suite ID"})
+			return
+		}
+		testSuiteIDs = append(testSuiteIDs, id)
+	}
+
+	var environmentID *uuid.UUID
+	if req.EnvironmentID != "" {
+		id, err := uuid.Parse(req.EnvironmentID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid environment ID"})
+			return
+		}
+		environmentID = &id
+	}
+
+	createReq := &services.CreateTestRunRequest{
+		CompanyID:     companyID,
+		TestSuiteIDs:  testSuiteIDs,
+		EnvironmentID: environmentID,
+	}
+
+	testRun, err := h.testRunService.Create(c.Request.Context(), createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create test run"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, testRun)
+}
+
+// GetByID godoc
+// @Summary Obter execução de teste por ID
+// @Description Retorna o estado e os totais de uma execução de teste
+// @Tags test-runs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da execução"
+// @Success 200 {object} entities.TestRun "Execução encontrada"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Execução não encontrada ou de outra empresa"
+// @Router /test-runs/{id} [get]
+func (h *TestRunHandler) GetByID(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test run ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	testRun, err := h.testRunService.GetByID(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Test run not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, testRun)
+}
+
+// GetResults godoc
+// @Summary Obter resultados de uma execução
+// @Description Retorna o resultado de cada suíte executada na execução
+// @Tags test-runs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da execução"
+// @Success 200 {array} entities.TestResult "Resultados da execução"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Execução não encontrada ou de outra empresa"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /test-runs/{id}/results [get]
+func (h *TestRunHandler) GetResults(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test run ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	testResults, err := h.testRunService.GetResults(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Test run not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test results"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"test_results": testResults,
+		"count":        len(testResults),
+	})
+}
+
+// Report godoc
+// @Summary Obter relatório de uma execução
+// @Description Gera o relatório da execução a partir dos resultados gravados, com um caso de teste por suíte:
+// @Description as falhas trazem as mensagens das asserções e um trecho do corpo da resposta, e o tempo vem da
+// @Description duração da requisição. Os formatos JUnit e TAP podem ser lidos pelos relatórios do Jenkins e do GitLab
+// @Tags test-runs
+// @Produce application/xml
+// @Produce plain
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da execução"
+// @Param format query string false "Formato do relatório" Enums(junit, tap, json) default(junit)
+// @Param download query bool false "Entregar o relatório como anexo para download"
+// @Success 200 {object} services.TestRunReport "Relatório da execução"
+// @Failure 400 {object} map[string]interface{} "Parâmetros inválidos"
+// @Failure 404 {object} map[string]interface{} "Execução não encontrada ou de outra empresa"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /test-runs/{id}/report [get]
+func (h *TestRunHandler) Report(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test run ID"})
+		return
+	}
+
+	format := strings.ToLower(c.DefaultQuery("format", "junit"))
+	if format != "junit" && format != "tap" && format != "json" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format, use junit, tap or json"})
+		return
+	}
+
+	download, ok := downloadParam(c)
+	if !ok {
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.testRunService.GetReport(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Test run not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test run report"})
+		return
+	}
+
+	switch format {
+	case "json":
+		c.JSON(http.StatusOK, report)
+	case "tap":
+		data, err := encodeTAP(report)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test run report"})
+			return
+		}
+		c.Header("Content-Disposition", reportDisposition(download, id, "tap"))
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", data)
+	default:
+		data, err := encodeJUnit(report)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test run report"})
+			return
+		}
+		c.Header("Content-Disposition", reportDisposition(download, id, "xml"))
+		c.Data(http.StatusOK, "application/xml; charset=utf-8", data)
+	}
+}
+
+// ReportHTML godoc
+// @Summary Obter relatório HTML de uma execução
+// @Description Gera o relatório da execução em um único arquivo HTML, sem recursos externos, para compartilhar com quem
+// @Description não tem acesso à plataforma: resumo dos totais, seções expansíveis por suíte com a requisição, os cabeçalhos
+// @Description e o corpo da resposta e as asserções, e barras com a latência de cada requisição
+// @Tags test-runs
+// @Produce html
+// @Security BearerAuth
+// @Param id path string true "ID da execução"
+// @Param download query bool false "Entregar o relatório como anexo para download em vez de exibi-lo no navegador"
+// @Success 200 {string} string "Relatório HTML da execução"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Execução não encontrada ou de outra empresa"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /test-runs/{id}/report.html [get]
+func (h *TestRunHandler) ReportHTML(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test run ID"})
+		return
+	}
+
+	download, ok := downloadParam(c)
+	if !ok {
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	report, err := h.testRunService.GetReport(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Test run not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test run report"})
+		return
+	}
+
+	data, err := encodeHTML(report)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test run report"})
+		return
+	}
+
+	c.Header("Content-Disposition", reportDisposition(download, id, "html"))
+	c.Data(http.StatusOK, "text/html; charset=utf-8", data)
+}
+
+// downloadParam lê o parâmetro download dos relatórios; responde 400 quando o valor é inválido
+func downloadParam(c *gin.Context) (bool, bool) {
+	value := c.Query("download")
+	if value == "" {
+		return false, true
+	}
+	download, err := strconv.ParseBool(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid download parameter"})
+		return false, false
+	}
+	return download, true
+}
+
+// reportDisposition monta o Content-Disposition do relatório: anexo quando pedido o download, senão
+// exibido no navegador; em ambos o nome do arquivo é sugerido ao salvar
+func reportDisposition(download bool, id uuid.UUID, extension string) string {
+	disposition := "inline"
+	if download {
+		disposition = "attachment"
+	}
+	return fmt.Sprintf(`%s; filename="test-run-%s.%s"`, disposition, id, extension)
+}
+
+// Cancel godoc
+// @Summary Cancelar execução de teste
+// @Description Cancela uma execução na fila ou em andamento, interrompendo as requisições HTTP em curso
+// @Tags test-runs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID da execução"
+// @Success 200 {object} entities.TestRun "Execução cancelada"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Execução não encontrada ou de outra empresa"
+// @Failure 409 {object} map[string]interface{} "Execução já finalizada"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /test-runs/{id}/cancel [post]
+func (h *TestRunHandler) Cancel(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid test run ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	testRun, err := h.testRunService.Cancel(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Test run not found"})
+		case errors.Is(err, domainErrors.ErrConflict):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to cancel test run"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, testRun)
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/interfaces/services"
+
+	"gopkg.in/yaml.v3"
+)
+
+// junitTestSuites é a raiz do relatório JUnit, no formato lido pelo Jenkins e pelo GitLab
+type junitTestSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Errors   int          `xml:"errors,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name      string          `xml:"name,attr"`
+	ID        string          `xml:"id,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitProblem `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// encodeJUnit gera o relatório no formato JUnit XML, com um testcase por suíte
+func encodeJUnit(report *services.TestRunReport) ([]byte, error) {
+	run := report.TestRun
+	suite := junitSuite{
+		Name:      "Test run " + run.ID.String(),
+		ID:        run.ID.String(),
+		Tests:     len(report.TestCases),
+		Time:      junitSeconds(report.TimeMS),
+		TestCases: make([]junitTestCase, 0, len(report.TestCases)),
+	}
+	if run.StartedAt != nil {
+		suite.Timestamp = run.StartedAt.UTC().Format("2006-01-02T15:04:05")
+	}
+
+	for _, testCase := range report.TestCases {
+		junitCase := junitTestCase{
+			Name:      testCase.Name,
+			ClassName: testCaseClassName(testCase),
+			Time:      junitSeconds(testCase.TimeMS),
+		}
+		switch testCase.Status {
+		case entities.TestResultStatusFailed:
+			suite.Failures++
+			junitCase.Failure = &junitProblem{
+				Message: firstFailure(testCase, "test failed"),
+				Type:    "AssertionFailure",
+				Text:    failureDetails(testCase),
+			}
+		case entities.TestResultStatusErrored:
+			suite.Errors++
+			junitCase.Error = &junitProblem{
+				Message: firstFailure(testCase, "test errored"),
+				Type:    "RequestError",
+				Text:    failureDetails(testCase),
+			}
+		case entities.TestResultStatusSkipped:
+			suite.Skipped++
+			junitCase.Skipped = &junitProblem{Message: firstFailure(testCase, "dependency did not pass")}
+		default:
+			junitCase.SystemOut = testCase.ResponseSnippet
+		}
+		suite.TestCases = append(suite.TestCases, junitCase)
+	}
+
+	root := junitTestSuites{
+		Name:     suite.Name,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Skipped:  suite.Skipped,
+		Time:     suite.Time,
+		Suites:   []junitSuite{suite},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// tapDiagnostic é o bloco YAML que acompanha os testes reprovados no relatório TAP
+type tapDiagnostic struct {
+	Status         entities.TestResultStatus `yaml:"status"`
+	Method         string                    `yaml:"method,omitempty"`
+	URL            string                    `yaml:"url,omitempty"`
+	ResponseStatus int                       `yaml:"response_status"`
+	DurationMS     int                       `yaml:"duration_ms"`
+	Failures       []string                  `yaml:"failures,omitempty"`
+	Response       string                    `yaml:"response,omitempty"`
+}
+
+// encodeTAP gera o relatório no formato TAP versão 13, com diagnóstico YAML nos testes reprovados
+func encodeTAP(report *services.TestRunReport) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("TAP version 13\n")
+	fmt.Fprintf(&buf, "1..%d\n", len(report.TestCases))
+
+	for i, testCase := range report.TestCases {
+		// # inicia uma diretiva no TAP e precisa ser escapado na descrição
+		name := strings.ReplaceAll(testCase.Name, "#", `\#`)
+		switch testCase.Status {
+		case entities.TestResultStatusPassed:
+			fmt.Fprintf(&buf, "ok %d - %s\n", i+1, name)
+			continue
+		case entities.TestResultStatusSkipped:
+			fmt.Fprintf(&buf, "ok %d - %s # SKIP %s\n", i+1, name, firstFailure(testCase, "dependency did not pass"))
+			continue
+		}
+
+		fmt.Fprintf(&buf, "not ok %d - %s\n", i+1, name)
+		var diagnostic bytes.Buffer
+		encoder := yaml.NewEncoder(&diagnostic)
+		encoder.SetIndent(2)
+		err := encoder.Encode(tapDiagnostic{
+			Status:         testCase.Status,
+			Method:         testCase.Method,
+			URL:            testCase.URL,
+			ResponseStatus: testCase.ResponseStatus,
+			DurationMS:     testCase.TimeMS,
+			Failures:       testCase.Failures,
+			Response:       testCase.ResponseSnippet,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("  ---\n")
+		for _, line := range strings.SplitAfter(strings.TrimSuffix(diagnostic.String(), "\n"), "\n") {
+			buf.WriteString("  " + line)
+		}
+		buf.WriteString("\n  ...\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// junitSeconds converte milissegundos para os segundos usados no atributo time do JUnit
+func junitSeconds(ms int) string {
+	return fmt.Sprintf("%.3f", float64(ms)/1000)
+}
+
+// testCaseClassName agrupa os casos de teste pela requisição executada
+func testCaseClassName(testCase services.TestCaseReport) string {
+	if testCase.Method == "" {
+		return testCase.Name
+	}
+	return testCase.Method + " " + testCase.URL
+}
+
+// firstFailure retorna a primeira mensagem de falha do caso de teste, ou a mensagem padrão
+func firstFailure(testCase services.TestCaseReport, fallback string) string {
+	if len(testCase.Failures) == 0 {
+		return fallback
+	}
+	return testCase.Failures[0]
+}
+
+// failureDetails lista todas as falhas seguidas do status e do trecho do corpo da resposta
+func failureDetails(testCase services.TestCaseReport) string {
+	var details strings.Builder
+	for _, failure := range testCase.Failures {
+		details.WriteString(failure + "\n")
+	}
+	if testCase.ResponseStatus > 0 {
+		fmt.Fprintf(&details, "\nResponse status: %d\n", testCase.ResponseStatus)
+	}
+	if testCase.ResponseSnippet != "" {
+		details.WriteString("Response body:\n" + testCase.ResponseSnippet + "\n")
+	}
+	return details.String()
+}
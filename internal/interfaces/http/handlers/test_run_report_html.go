@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/interfaces/services"
+)
+
+// htmlReport é o modelo do relatório HTML de uma execução
+type htmlReport struct {
+	Run         *entities.TestRun
+	Duration    string
+	GeneratedAt string
+	TestCases   []htmlTestCase
+}
+
+// htmlTestCase é uma suíte do relatório HTML, com a largura da barra de latência
+// proporcional à requisição mais lenta da execução
+type htmlTestCase struct {
+	services.TestCaseReport
+	LatencyPercent int
+	// Open expande as suítes reprovadas por padrão
+	Open bool
+}
+
+// htmlHeader é um cabeçalho da requisição ou da resposta, em ordem alfabética
+type htmlHeader struct {
+	Name  string
+	Value string
+}
+
+// reportTemplate é o relatório em um único arquivo, com o CSS embutido e sem recursos externos,
+// para que possa ser baixado e compartilhado com quem não tem acesso à plataforma
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"headers":      sortedHeaders,
+	"multiHeaders": sortedMultiHeaders,
+	"formatTime":   formatReportTime,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Test run {{.Run.ID}}</title>
+<style>
+body{margin:0;padding:24px;font-family:-apple-system,BlinkMacSystemFont,"Segoe UI",Roboto,Helvetica,Arial,sans-serif;font-size:14px;color:#1f2328;background:#f6f8fa}
+h1{font-size:20px;margin:0 0 4px}
+h2{font-size:13px;text-transform:uppercase;letter-spacing:.04em;color:#59636e;margin:16px 0 6px}
+.meta{color:#59636e;margin-bottom:16px}
+.cards{display:flex;flex-wrap:wrap;gap:12px;margin-bottom:24px}
+.card{background:#fff;border:1px solid #d1d9e0;border-radius:6px;padding:12px 16px;min-width:110px}
+.card .value{font-size:24px;font-weight:600}
+.card .label{color:#59636e}
+.passed{color:#1a7f37}.failed,.errored{color:#d1242f}.skipped,.cancelled{color:#9a6700}.queued,.running{color:#0969da}
+details{background:#fff;border:1px solid #d1d9e0;border-radius:6px;margin-bottom:8px}
+summary{display:flex;align-items:center;gap:12px;padding:10px 16px;cursor:pointer;list-style:none}
+summary::-webkit-details-marker{display:none}
+summary .name{flex:1;font-weight:600;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}
+.badge{font-size:12px;font-weight:600;text-transform:uppercase;min-width:64px}
+.latency{width:220px;display:flex;align-items:center;gap:8px}
+.bar{flex:1;height:8px;background:#eff2f5;border-radius:4px;overflow:hidden}
+.bar span{display:block;height:100%;background:#0969da}
+.latency .ms{width:64px;text-align:right;color:#59636e;font-variant-numeric:tabular-nums}
+.content{padding:0 16px 16px;border-top:1px solid #d1d9e0}
+pre{margin:0;padding:8px 12px;background:#f6f8fa;border-radius:6px;overflow:auto;max-height:320px;white-space:pre-wrap;word-break:break-all;font-size:12px}
+table{border-collapse:collapse;width:100%;font-size:12px}
+th,td{text-align:left;padding:4px 8px;border-bottom:1px solid #eff2f5;vertical-align:top;word-break:break-all}
+th{color:#59636e;font-weight:600}
+ul.failures{margin:0;padding-left:20px;color:#d1242f}
+.empty{color:#59636e}
+</style>
+</head>
+<body>
+<h1>Test run {{.Run.ID}}</h1>
+<div class="meta">Status <strong class="{{.Run.Status}}">{{.Run.Status}}</strong>{{if .Run.StartedAt}} · started {{formatTime .Run.StartedAt}}{{end}}{{if .Run.FinishedAt}} · finished {{formatTime .Run.FinishedAt}}{{end}}{{if .Duration}} · {{.Duration}}{{end}} · generated {{.GeneratedAt}}</div>
+<div class="cards">
+<div class="card"><div class="value">{{.Run.TotalTests}}</div><div class="label">Total</div></div>
+<div class="card"><div class="value passed">{{.Run.PassedTests}}</div><div class="label">Passed</div></div>
+<div class="card"><div class="value failed">{{.Run.FailedTests}}</div><div class="label">Failed</div></div>
+<div class="card"><div class="value skipped">{{.Run.SkippedTests}}</div><div class="label">Skipped</div></div>
+</div>
+{{range .TestCases}}<details{{if .Open}} open{{end}}>
+<summary><span class="badge {{.Status}}">{{.Status}}</span><span class="name">{{.Name}}</span><span class="latency"><span class="bar"><span style="width:{{.LatencyPercent}}%"></span></span><span class="ms">{{.TimeMS}} ms</span></span></summary>
+<div class="content">
+{{if .Failures}}<h2>Failures</h2>
+<ul class="failures">{{range .Failures}}<li>{{.}}</li>{{end}}</ul>
+{{end}}<h2>Request</h2>
+{{if .Method}}<pre>{{.Method}} {{.URL}}</pre>
+{{with headers .QueryParams}}<table><tr><th>Query parameter</th><th>Value</th></tr>{{range .}}<tr><td>{{.Name}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+{{end}}{{with headers .RequestHeaders}}<table><tr><th>Header</th><th>Value</th></tr>{{range .}}<tr><td>{{.Name}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+{{end}}{{with headers .FormFields}}<table><tr><th>Form field</th><th>Value</th></tr>{{range .}}<tr><td>{{.Name}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+{{end}}{{if .RequestBody}}<pre>{{.RequestBody}}</pre>
+{{end}}{{else}}<p class="empty">The test suite was deleted after this run.</p>
+{{end}}<h2>Response{{if .ResponseStatus}} · {{.ResponseStatus}}{{end}}</h2>
+{{with multiHeaders .ResponseHeaders}}<table><tr><th>Header</th><th>Value</th></tr>{{range .}}<tr><td>{{.Name}}</td><td>{{.Value}}</td></tr>{{end}}</table>
+{{end}}{{if .ResponseSnippet}}<pre>{{.ResponseSnippet}}</pre>
+{{else}}<p class="empty">No response body.</p>
+{{end}}{{if .Assertions}}<h2>Assertions</h2>
+<table><tr><th></th><th>Type</th><th>Target</th><th>Expected</th><th>Actual</th><th>Message</th></tr>
+{{range .Assertions}}<tr><td class="{{if .Passed}}passed{{else}}failed{{end}}">{{if .Passed}}✔{{else}}✘{{end}}</td><td>{{.Type}}</td><td>{{.Target}}</td><td>{{.Expected}}</td><td>{{.Actual}}</td><td>{{.Message}}</td></tr>
+{{end}}</table>
+{{end}}</div>
+</details>
+{{else}}<p class="empty">This run has no results.</p>
+{{end}}</body>
+</html>
+`))
+
+// encodeHTML gera o relatório HTML da execução em um único arquivo
+func encodeHTML(report *services.TestRunReport) ([]byte, error) {
+	maxTimeMS := 0
+	for _, testCase := range report.TestCases {
+		if testCase.TimeMS > maxTimeMS {
+			maxTimeMS = testCase.TimeMS
+		}
+	}
+
+	model := htmlReport{
+		Run:         report.TestRun,
+		GeneratedAt: time.Now().UTC().Format("2006-01-02 15:04:05 UTC"),
+		TestCases:   make([]htmlTestCase, 0, len(report.TestCases)),
+	}
+	if run := report.TestRun; run.StartedAt != nil && run.FinishedAt != nil {
+		model.Duration = run.FinishedAt.Sub(*run.StartedAt).Round(time.Millisecond).String()
+	}
+	for _, testCase := range report.TestCases {
+		percent := 0
+		if maxTimeMS > 0 {
+			percent = testCase.TimeMS * 100 / maxTimeMS
+		}
+		model.TestCases = append(model.TestCases, htmlTestCase{
+			TestCaseReport: testCase,
+			LatencyPercent: percent,
+			Open:           testCase.Status == entities.TestResultStatusFailed || testCase.Status == entities.TestResultStatusErrored,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := reportTemplate.Execute(&buf, model); err != nil {
+		return nil, fmt.Errorf("failed to render report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// sortedHeaders ordena os pares pelo nome para uma exibição estável
+func sortedHeaders(values map[string]string) []htmlHeader {
+	headers := make([]htmlHeader, 0, len(values))
+	for name, value := range values {
+		headers = append(headers, htmlHeader{Name: name, Value: value})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
+
+// sortedMultiHeaders ordena os cabeçalhos da resposta, juntando os valores repetidos
+func sortedMultiHeaders(values map[string][]string) []htmlHeader {
+	headers := make([]htmlHeader, 0, len(values))
+	for name, value := range values {
+		headers = append(headers, htmlHeader{Name: name, Value: strings.Join(value, ", ")})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
+
+// formatReportTime formata os horários da execução em UTC
+func formatReportTime(t *time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05 UTC")
+}
@@ -1,9 +1,15 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/value_objects"
 	"TestGO/internal/interfaces/services"
 
 	"github.com/gin-gonic/gin"
@@ -17,30 +23,74 @@ type TestSuiteHandler struct {
 }
 
 func NewTestSuiteHandler(testSuiteService services.TestSuiteService) *TestSuiteHandler {
+	v := validator.New()
+
+	// URLs com {{variável}} (ex.: {{base_url}}/login) só são completas após a substituição, na execução
+	v.RegisterValidation("url_template", func(fl validator.FieldLevel) bool {
+		value := fl.Field().String()
+		return value_objects.HasTemplateVariables(value) || v.Var(value, "url") == nil
+	})
+
 	return &TestSuiteHandler{
 		testSuiteService: testSuiteService,
-		validator:        validator.New(),
+		validator:        v,
 	}
 }
 
 // Request structs para o handler
 type CreateTestSuiteRequest struct {
-	CompanyID      string `json:"company_id" validate:"required,uuid"`
-	Name           string `json:"name" validate:"required,min=3,max=100"`
-	Method         string `json:"method" validate:"required,oneof=GET POST PUT DELETE PATCH"`
-	URL            string `json:"url" validate:"required,url"`
-	Headers        string `json:"headers"`
-	ExpectedStatus int    `json:"expected_status" validate:"required,min=100,max=599"`
-	ExpectedBody   string `json:"expected_body"`
+	CompanyID      string             `json:"company_id" validate:"required,uuid"`
+	Name           string             `json:"name" validate:"required,min=3,max=100"`
+	Method         string             `json:"method" validate:"required,oneof=GET POST PUT DELETE PATCH"`
+	URL            string             `json:"url" validate:"required,url_template"`
+	Headers        map[string]string  `json:"headers" validate:"omitempty,dive,keys,required,endkeys"`
+	QueryParams    map[string]string  `json:"query_params" validate:"omitempty,dive,keys,required,endkeys"`
+	BodyType       string             `json:"body_type" validate:"omitempty,oneof=none json form multipart raw"`
+	Body           string             `json:"body"`
+	FormFields     map[string]string  `json:"form_fields" validate:"omitempty,dive,keys,required,endkeys"`
+	ExpectedStatus int                `json:"expected_status" validate:"required,min=100,max=599"`
+	ExpectedBody   string             `json:"expected_body"`
+	Assertions     []AssertionRequest `json:"assertions" validate:"omitempty,dive"`
+	// Valores salvos da resposta como {{extracted.nome}} e suítes que precisam passar antes desta
+	Extractions []ExtractionRequest `json:"extractions" validate:"omitempty,dive"`
+	DependsOn   []string            `json:"depends_on" validate:"omitempty,dive,uuid"`
+	// Schema da resposta: inline (JSON Schema) ou ID de um schema armazenado
+	ResponseSchema   json.RawMessage `json:"response_schema" swaggertype:"object"`
+	ResponseSchemaID string          `json:"response_schema_id" validate:"omitempty,uuid"`
 }
 
 type UpdateTestSuiteRequest struct {
-	Name           string `json:"name" validate:"omitempty,min=3,max=100"`
-	Method         string `json:"method" validate:"omitempty,oneof=GET POST PUT DELETE PATCH"`
-	URL            string `json:"url" validate:"omitempty,url"`
-	Headers        string `json:"headers"`
-	ExpectedStatus int    `json:"expected_status" validate:"omitempty,min=100,max=599"`
-	ExpectedBody   string `json:"expected_body"`
+	Name   string `json:"name" validate:"omitempty,min=3,max=100"`
+	Method string `json:"method" validate:"omitempty,oneof=GET POST PUT DELETE PATCH"`
+	URL    string `json:"url" validate:"omitempty,url_template"`
+	// Campos omitidos mantêm os valores atuais
+	Headers        map[string]string `json:"headers" validate:"omitempty,dive,keys,required,endkeys"`
+	QueryParams    map[string]string `json:"query_params" validate:"omitempty,dive,keys,required,endkeys"`
+	BodyType       string            `json:"body_type" validate:"omitempty,oneof=none json form multipart raw"`
+	Body           *string           `json:"body"`
+	FormFields     map[string]string `json:"form_fields" validate:"omitempty,dive,keys,required,endkeys"`
+	ExpectedStatus int               `json:"expected_status" validate:"omitempty,min=100,max=599"`
+	ExpectedBody   string            `json:"expected_body"`
+	// Omitir mantém as asserções atuais; uma lista vazia remove todas
+	Assertions *[]AssertionRequest `json:"assertions" validate:"omitempty,dive"`
+	// Omitir mantém as extrações e dependências atuais; uma lista vazia remove todas
+	Extractions *[]ExtractionRequest `json:"extractions" validate:"omitempty,dive"`
+	DependsOn   *[]string            `json:"depends_on" validate:"omitempty,dive,uuid"`
+	// Omitir mantém o schema atual; null remove o schema inline e "" remove a referência
+	ResponseSchema   json.RawMessage `json:"response_schema" swaggertype:"object"`
+	ResponseSchemaID *string         `json:"response_schema_id"`
+}
+
+type AssertionRequest struct {
+	Type     string `json:"type" validate:"required,oneof=json_path_equals json_path_contains json_path_exists body_regex header_equals header_present response_time_under body_size_max body_size_min content_type"`
+	Target   string `json:"target"`
+	Expected string `json:"expected"`
+}
+
+type ExtractionRequest struct {
+	Name       string `json:"name" validate:"required"`
+	Source     string `json:"source" validate:"required,oneof=json_path header regex cookie"`
+	Expression string `json:"expression" validate:"required"`
 }
 
 // Create godoc
@@ -53,6 +103,7 @@ type UpdateTestSuiteRequest struct {
 // @Param request body CreateTestSuiteRequest true "Dados da suíte de teste"
 // @Success 201 {object} entities.TestSuite "Suíte de teste criada com sucesso"
 // @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Dependência ou schema referenciado não encontrado na empresa"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /test-suites [post]
 func (h *TestSuiteHandler) Create(c *gin.Context) {
@@ -80,27 +131,85 @@ func (h *TestSuiteHandler) Create(c *gin.Context) {
 		Method:         req.Method,
 		URL:            req.URL,
 		Headers:        req.Headers,
+		QueryParams:    req.QueryParams,
+		BodyType:       entities.RequestBodyType(req.BodyType),
+		Body:           req.Body,
+		FormFields:     req.FormFields,
 		ExpectedStatus: req.ExpectedStatus,
 		ExpectedBody:   req.ExpectedBody,
 	}
 
+	assertions, err := toAssertionRequests(req.Assertions)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	createReq.Assertions = assertions
+
+	extractions, err := toExtractionRequests(req.Extractions)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	createReq.Extractions = extractions
+
+	dependsOn, err := parseUUIDs(req.DependsOn)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid depends_on test suite ID"})
+		return
+	}
+	createReq.DependsOn = dependsOn
+
+	if len(req.ResponseSchema) > 0 {
+		createReq.ResponseSchema = req.ResponseSchema
+	}
+	if req.ResponseSchemaID != "" {
+		schemaID, err := uuid.Parse(req.ResponseSchemaID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid response schema ID"})
+			return
+		}
+		createReq.ResponseSchemaID = &schemaID
+	}
+
 	testSuite, err := h.testSuiteService.Create(c.Request.Context(), createReq)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		// Dependências e schemas referenciados que não existem na empresa
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create test suite"})
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{
-		"id":              testSuite.ID,
-		"company_id":      testSuite.CompanyID,
-		"name":            testSuite.Name,
-		"method":          testSuite.Method,
-		"url":             testSuite.URL,
-		"headers":         testSuite.Headers,
-		"expected_status": testSuite.ExpectedStatus,
-		"expected_body":   testSuite.ExpectedBody,
-		"created_at":      testSuite.CreatedAt,
-		"updated_at":      testSuite.UpdatedAt,
+		"id":                 testSuite.ID,
+		"company_id":         testSuite.CompanyID,
+		"name":               testSuite.Name,
+		"method":             testSuite.Method,
+		"url":                testSuite.URL,
+		"headers":            testSuite.Headers,
+		"query_params":       testSuite.QueryParams,
+		"body_type":          testSuite.BodyType,
+		"body":               testSuite.Body,
+		"form_fields":        testSuite.FormFields,
+		"expected_status":    testSuite.ExpectedStatus,
+		"expected_body":      testSuite.ExpectedBody,
+		"response_schema":    testSuite.ResponseSchema,
+		"response_schema_id": testSuite.ResponseSchemaID,
+		"assertions":         testSuite.Assertions,
+		"extractions":        testSuite.Extractions,
+		"depends_on":         testSuite.DependsOn,
+		"created_at":         testSuite.CreatedAt,
+		"updated_at":         testSuite.UpdatedAt,
 	})
 }
 
@@ -114,7 +223,7 @@ func (h *TestSuiteHandler) Create(c *gin.Context) {
 // @Param id path string true "ID da suíte de teste"
 // @Success 200 {object} entities.TestSuite "Suíte de teste encontrada"
 // @Failure 400 {object} map[string]interface{} "ID inválido"
-// @Failure 404 {object} map[string]interface{} "Suíte de teste não encontrada"
+// @Failure 404 {object} map[string]interface{} "Suíte de teste não encontrada ou de outra empresa"
 // @Router /test-suites/{id} [get]
 func (h *TestSuiteHandler) GetByID(c *gin.Context) {
 	idParam := c.Param("id")
@@ -124,23 +233,40 @@ func (h *TestSuiteHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	testSuite, err := h.testSuiteService.GetByID(c.Request.Context(), id)
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	testSuite, err := h.testSuiteService.GetByID(c.Request.Context(), companyID, id)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{"error": "Test suite not found"})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"id":              testSuite.ID,
-		"company_id":      testSuite.CompanyID,
-		"name":            testSuite.Name,
-		"method":          testSuite.Method,
-		"url":             testSuite.URL,
-		"headers":         testSuite.Headers,
-		"expected_status": testSuite.ExpectedStatus,
-		"expected_body":   testSuite.ExpectedBody,
-		"created_at":      testSuite.CreatedAt,
-		"updated_at":      testSuite.UpdatedAt,
+		"id":                 testSuite.ID,
+		"company_id":         testSuite.CompanyID,
+		"name":               testSuite.Name,
+		"method":             testSuite.Method,
+		"url":                testSuite.URL,
+		"headers":            testSuite.Headers,
+		"query_params":       testSuite.QueryParams,
+		"body_type":          testSuite.BodyType,
+		"body":               testSuite.Body,
+		"form_fields":        testSuite.FormFields,
+		"expected_status":    testSuite.ExpectedStatus,
+		"expected_body":      testSuite.ExpectedBody,
+		"response_schema":    testSuite.ResponseSchema,
+		"response_schema_id": testSuite.ResponseSchemaID,
+		"assertions":         testSuite.Assertions,
+		"extractions":        testSuite.Extractions,
+		"depends_on":         testSuite.DependsOn,
+		"created_at":         testSuite.CreatedAt,
+		"updated_at":         testSuite.UpdatedAt,
 	})
 }
 
@@ -155,6 +281,7 @@ func (h *TestSuiteHandler) GetByID(c *gin.Context) {
 // @Param request body UpdateTestSuiteRequest true "Dados para atualização"
 // @Success 200 {object} entities.TestSuite "Suíte de teste atualizada com sucesso"
 // @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Suíte de teste, dependência ou schema referenciado não encontrado na empresa"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /test-suites/{id} [put]
 func (h *TestSuiteHandler) Update(c *gin.Context) {
@@ -181,27 +308,97 @@ func (h *TestSuiteHandler) Update(c *gin.Context) {
 		Method:         req.Method,
 		URL:            req.URL,
 		Headers:        req.Headers,
+		QueryParams:    req.QueryParams,
+		BodyType:       entities.RequestBodyType(req.BodyType),
+		Body:           req.Body,
+		FormFields:     req.FormFields,
 		ExpectedStatus: req.ExpectedStatus,
 		ExpectedBody:   req.ExpectedBody,
 	}
 
-	testSuite, err := h.testSuiteService.Update(c.Request.Context(), id, updateReq)
+	if req.Assertions != nil {
+		assertions, err := toAssertionRequests(*req.Assertions)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		updateReq.Assertions = &assertions
+	}
+
+	if req.Extractions != nil {
+		extractions, err := toExtractionRequests(*req.Extractions)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		updateReq.Extractions = &extractions
+	}
+
+	if req.DependsOn != nil {
+		dependsOn, err := parseUUIDs(*req.DependsOn)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid depends_on test suite ID"})
+			return
+		}
+		updateReq.DependsOn = &dependsOn
+	}
+
+	updateReq.ResponseSchema = req.ResponseSchema
+	if req.ResponseSchemaID != nil {
+		schemaID := uuid.Nil
+		if *req.ResponseSchemaID != "" {
+			schemaID, err = uuid.Parse(*req.ResponseSchemaID)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid response schema ID"})
+				return
+			}
+		}
+		updateReq.ResponseSchemaID = &schemaID
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	testSuite, err := h.testSuiteService.Update(c.Request.Context(), companyID, id, updateReq)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		// A própria suíte ou uma dependência ou schema referenciado que não existe na empresa
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, domainErrors.ErrInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update test suite"})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"id":              testSuite.ID,
-		"company_id":      testSuite.CompanyID,
-		"name":            testSuite.Name,
-		"method":          testSuite.Method,
-		"url":             testSuite.URL,
-		"headers":         testSuite.Headers,
-		"expected_status": testSuite.ExpectedStatus,
-		"expected_body":   testSuite.ExpectedBody,
-		"created_at":      testSuite.CreatedAt,
-		"updated_at":      testSuite.UpdatedAt,
+		"id":                 testSuite.ID,
+		"company_id":         testSuite.CompanyID,
+		"name":               testSuite.Name,
+		"method":             testSuite.Method,
+		"url":                testSuite.URL,
+		"headers":            testSuite.Headers,
+		"query_params":       testSuite.QueryParams,
+		"body_type":          testSuite.BodyType,
+		"body":               testSuite.Body,
+		"form_fields":        testSuite.FormFields,
+		"expected_status":    testSuite.ExpectedStatus,
+		"expected_body":      testSuite.ExpectedBody,
+		"response_schema":    testSuite.ResponseSchema,
+		"response_schema_id": testSuite.ResponseSchemaID,
+		"assertions":         testSuite.Assertions,
+		"extractions":        testSuite.Extractions,
+		"depends_on":         testSuite.DependsOn,
+		"created_at":         testSuite.CreatedAt,
+		"updated_at":         testSuite.UpdatedAt,
 	})
 }
 
@@ -215,6 +412,7 @@ func (h *TestSuiteHandler) Update(c *gin.Context) {
 // @Param id path string true "ID da suíte de teste"
 // @Success 200 {object} map[string]interface{} "Suíte de teste deletada com sucesso"
 // @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Suíte de teste não encontrada ou de outra empresa"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /test-suites/{id} [delete]
 func (h *TestSuiteHandler) Delete(c *gin.Context) {
@@ -225,8 +423,20 @@ func (h *TestSuiteHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	err = h.testSuiteService.Delete(c.Request.Context(), id)
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	err = h.testSuiteService.Delete(c.Request.Context(), companyID, id)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Test suite not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete test suite"})
 		return
 	}
@@ -236,12 +446,12 @@ func (h *TestSuiteHandler) Delete(c *gin.Context) {
 
 // List godoc
 // @Summary Listar suítes de teste
-// @Description Retorna uma lista paginada de suítes de teste
+// @Description Retorna uma lista paginada das suítes de teste de uma empresa da qual o usuário é membro
 // @Tags test-suites
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param company_id query string false "ID da empresa para filtrar"
+// @Param company_id query string true "ID da empresa"
 // @Param limit query int false "Limite de resultados" default(10)
 // @Param offset query int false "Offset para paginação" default(0)
 // @Success 200 {array} entities.TestSuite "Lista de suítes de teste"
@@ -262,23 +472,24 @@ func (h *TestSuiteHandler) List(c *gin.Context) {
 		offset = 0
 	}
 
-	req := &services.ListTestSuitesRequest{
-		Limit:  limit,
-		Offset: offset,
+	// A listagem é sempre de uma empresa, cujo acesso é verificado pelo papel do usuário
+	companyID, err := uuid.Parse(companyIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
 	}
 
-	// Se company_id foi fornecido, adicionar ao filtro
-	if companyIDStr != "" {
-		companyID, err := uuid.Parse(companyIDStr)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
-			return
-		}
-		req.CompanyID = companyID
+	req := &services.ListTestSuitesRequest{
+		CompanyID: companyID,
+		Limit:     limit,
+		Offset:    offset,
 	}
 
 	response, err := h.testSuiteService.List(c.Request.Context(), req)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list test suites"})
 		return
 	}
@@ -293,13 +504,13 @@ func (h *TestSuiteHandler) List(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param company_id path string true "ID da empresa"
+// @Param companyId path string true "ID da empresa"
 // @Success 200 {array} entities.TestSuite "Suítes de teste da empresa"
 // @Failure 400 {object} map[string]interface{} "ID inválido"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
-// @Router /companies/{company_id}/test-suites [get]
+// @Router /test-suites/company/{companyId} [get]
 func (h *TestSuiteHandler) GetByCompanyID(c *gin.Context) {
-	companyIDParam := c.Param("company_id")
+	companyIDParam := c.Param("companyId")
 	companyID, err := uuid.Parse(companyIDParam)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
@@ -308,6 +519,9 @@ func (h *TestSuiteHandler) GetByCompanyID(c *gin.Context) {
 
 	testSuites, err := h.testSuiteService.GetByCompanyID(c.Request.Context(), companyID)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get test suites"})
 		return
 	}
@@ -317,3 +531,50 @@ func (h *TestSuiteHandler) GetByCompanyID(c *gin.Context) {
 		"count":       len(testSuites),
 	})
 }
+
+// toAssertionRequests valida cada asserção conforme o seu tipo antes de enviá-la ao serviço
+func toAssertionRequests(reqs []AssertionRequest) ([]services.AssertionRequest, error) {
+	assertions := make([]services.AssertionRequest, 0, len(reqs))
+	for i, req := range reqs {
+		assertion := entities.NewAssertion(uuid.Nil, entities.AssertionType(req.Type), req.Target, req.Expected, i)
+		if err := assertion.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid assertion %d (%s): %w", i, req.Type, err)
+		}
+		assertions = append(assertions, services.AssertionRequest{
+			Type:     assertion.Type,
+			Target:   assertion.Target,
+			Expected: assertion.Expected,
+		})
+	}
+	return assertions, nil
+}
+
+// toExtractionRequests valida cada regra de extração conforme a origem antes de enviá-la ao serviço
+func toExtractionRequests(reqs []ExtractionRequest) ([]services.ExtractionRequest, error) {
+	extractions := make([]services.ExtractionRequest, 0, len(reqs))
+	for i, req := range reqs {
+		extraction := entities.NewExtraction(uuid.Nil, req.Name, entities.ExtractionSource(req.Source), req.Expression, i)
+		if err := extraction.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid extraction %d (%s): %w", i, req.Name, err)
+		}
+		extractions = append(extractions, services.ExtractionRequest{
+			Name:       extraction.Name,
+			Source:     extraction.Source,
+			Expression: extraction.Expression,
+		})
+	}
+	return extractions, nil
+}
+
+// parseUUIDs converte uma lista de IDs em texto para UUIDs
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
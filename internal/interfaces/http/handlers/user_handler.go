@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	domainErrors "TestGO/internal/domain/errors"
 	"TestGO/internal/interfaces/services"
 
 	"github.com/gin-gonic/gin"
@@ -29,6 +31,8 @@ type UpdateProfileRequest struct {
 	Username string `json:"username" validate:"omitempty,min=3,max=50"`
 	Email    string `json:"email" validate:"omitempty,email"`
 	Name     string `json:"name" validate:"omitempty,min=2,max=100"`
+	// Desativa (false) ou reativa (true) os alertas por email de execuções com falha
+	EmailAlerts *bool `json:"email_alerts,omitempty"`
 }
 
 type ChangePasswordRequest struct {
@@ -71,10 +75,11 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"user": gin.H{
-			"id":        user.ID,
-			"username":  user.Username,
-			"email":     user.Email,
-			"companyId": user.CompanyID,
+			"id":           user.ID,
+			"username":     user.Username,
+			"email":        user.Email,
+			"companyId":    user.CompanyID,
+			"email_alerts": user.EmailAlerts,
 		},
 	})
 }
@@ -117,9 +122,10 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	}
 
 	updateReq := &services.UpdateUserRequest{
-		Username: req.Username,
-		Email:    req.Email,
-		Name:     req.Name,
+		Username:    req.Username,
+		Email:       req.Email,
+		Name:        req.Name,
+		EmailAlerts: req.EmailAlerts,
 	}
 
 	user, err := h.userService.Update(c.Request.Context(), id, updateReq)
@@ -131,9 +137,10 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Profile updated successfully",
 		"user": gin.H{
-			"id":       user.ID,
-			"username": user.Username,
-			"email":    user.Email,
+			"id":           user.ID,
+			"username":     user.Username,
+			"email":        user.Email,
+			"email_alerts": user.EmailAlerts,
 		},
 	})
 }
@@ -223,7 +230,7 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 
 // ListUsers godoc
 // @Summary Listar usuários
-// @Description Retorna uma lista paginada de usuários
+// @Description Retorna uma lista paginada do próprio usuário e dos usuários que compartilham alguma empresa com ele
 // @Tags users
 // @Accept json
 // @Produce json
@@ -254,6 +261,9 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 
 	response, err := h.userService.List(c.Request.Context(), req)
 	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list users"})
 		return
 	}
@@ -263,7 +273,7 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 
 // GetUserByID godoc
 // @Summary Obter usuário por ID
-// @Description Retorna os dados de um usuário específico pelo ID
+// @Description Retorna os dados de um usuário pelo ID. Só são visíveis o próprio usuário e quem compartilha alguma empresa com ele
 // @Tags users
 // @Accept json
 // @Produce json
@@ -272,6 +282,7 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 // @Success 200 {object} map[string]interface{} "Dados do usuário"
 // @Failure 400 {object} map[string]interface{} "ID inválido"
 // @Failure 404 {object} map[string]interface{} "Usuário não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /users/{id} [get]
 func (h *UserHandler) GetUserByID(c *gin.Context) {
 	idParam := c.Param("id")
@@ -281,9 +292,16 @@ func (h *UserHandler) GetUserByID(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userService.GetByID(c.Request.Context(), id)
+	user, err := h.userService.GetVisible(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user"})
 		return
 	}
 
@@ -310,7 +328,7 @@ type LinkCompanyRequest struct {
 // @Param company_id path string true "ID da empresa"
 // @Success 200 {object} map[string]interface{} "Empresa vinculada com sucesso"
 // @Failure 400 {object} map[string]interface{} "ID inválido ou usuário já vinculado"
-// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada ou usuário não é membro dela"
 // @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
 // @Router /users/link-company/{company_id} [post]
 func (h *UserHandler) LinkCompany(c *gin.Context) {
@@ -342,7 +360,7 @@ func (h *UserHandler) LinkCompany(c *gin.Context) {
 
 	userID := *userIDPtr
 
-	// Verificar se a empresa existe
+	// Verificar se a empresa existe e se o usuário é membro dela
 	company, err := h.companyService.GetByID(c.Request.Context(), companyID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
@@ -352,6 +370,10 @@ func (h *UserHandler) LinkCompany(c *gin.Context) {
 	// Vincular a empresa ao usuário
 	err = h.userService.LinkCompany(c.Request.Context(), userID, companyID)
 	if err != nil {
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,373 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type WebhookHandler struct {
+	webhookService services.WebhookService
+	validator      *validator.Validate
+}
+
+func NewWebhookHandler(webhookService services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		validator:      validator.New(),
+	}
+}
+
+// Request structs para o handler
+type CreateWebhookRequest struct {
+	CompanyID string   `json:"company_id" validate:"required,uuid"`
+	URL       string   `json:"url" validate:"required,url" example:"https://hooks.example.com/eztest"`
+	Events    []string `json:"events" validate:"omitempty,dive,oneof=test_run.finished test_suite.failing test_suite.recovered"`
+	Enabled   *bool    `json:"enabled" validate:"omitempty"`
+}
+
+type UpdateWebhookRequest struct {
+	URL     string   `json:"url" validate:"omitempty,url"`
+	Events  []string `json:"events" validate:"omitempty,dive,oneof=test_run.finished test_suite.failing test_suite.recovered"`
+	Enabled *bool    `json:"enabled" validate:"omitempty"`
+}
+
+// toWebhookEvents converte os nomes de eventos recebidos
+func toWebhookEvents(names []string) []entities.WebhookEvent {
+	if names == nil {
+		return nil
+	}
+	events := make([]entities.WebhookEvent, 0, len(names))
+	for _, name := range names {
+		events = append(events, entities.WebhookEvent(name))
+	}
+	return events
+}
+
+// Create godoc
+// @Summary Criar webhook
+// @Description Cadastra uma URL da empresa para receber eventos de execução (test_run.finished, test_suite.failing,
+// @Description test_suite.recovered). Cada entrega é assinada com HMAC-SHA256 sobre "timestamp.corpo" no cabeçalho
+// @Description X-EzTest-Signature; o segredo de assinatura é devolvido somente nesta resposta
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateWebhookRequest true "Dados do webhook"
+// @Success 201 {object} services.CreateWebhookResponse "Webhook criado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Empresa não encontrada"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	companyID, err := uuid.Parse(req.CompanyID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	createReq := &services.CreateWebhookRequest{
+		CompanyID: companyID,
+		URL:       req.URL,
+		Events:    toWebhookEvents(req.Events),
+		Enabled:   req.Enabled,
+	}
+
+	webhook, err := h.webhookService.Create(c.Request.Context(), createReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Company not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create webhook"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// GetByID godoc
+// @Summary Obter webhook por ID
+// @Description Retorna um webhook; o segredo de assinatura não é devolvido
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do webhook"
+// @Success 200 {object} entities.Webhook "Webhook encontrado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Webhook não encontrado"
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.GetByID(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// Update godoc
+// @Summary Atualizar webhook
+// @Description Atualiza a URL, os eventos assinados ou o estado de um webhook
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do webhook"
+// @Param request body UpdateWebhookRequest true "Dados para atualização"
+// @Success 200 {object} entities.Webhook "Webhook atualizado com sucesso"
+// @Failure 400 {object} map[string]interface{} "Dados inválidos"
+// @Failure 404 {object} map[string]interface{} "Webhook não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	var req UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &services.UpdateWebhookRequest{
+		URL:     req.URL,
+		Events:  toWebhookEvents(req.Events),
+		Enabled: req.Enabled,
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.Update(c.Request.Context(), companyID, id, updateReq)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErrors.ErrInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, domainErrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update webhook"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// Delete godoc
+// @Summary Deletar webhook
+// @Description Remove um webhook e o seu histórico de entregas
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do webhook"
+// @Success 200 {object} map[string]interface{} "Webhook deletado com sucesso"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Webhook não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.Delete(c.Request.Context(), companyID, id); err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete webhook"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Webhook deleted successfully"})
+}
+
+// GetByCompanyID godoc
+// @Summary Listar webhooks da empresa
+// @Description Retorna os webhooks cadastrados pela empresa
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param companyId path string true "ID da empresa"
+// @Success 200 {array} entities.Webhook "Webhooks da empresa"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /webhooks/company/{companyId} [get]
+func (h *WebhookHandler) GetByCompanyID(c *gin.Context) {
+	companyID, err := uuid.Parse(c.Param("companyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid company ID"})
+		return
+	}
+
+	webhooks, err := h.webhookService.GetByCompanyID(c.Request.Context(), companyID)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get webhooks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"webhooks": webhooks,
+		"count":    len(webhooks),
+	})
+}
+
+// GetDeliveries godoc
+// @Summary Listar entregas do webhook
+// @Description Retorna as entregas mais recentes do webhook com o histórico de tentativas
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do webhook"
+// @Param limit query int false "Quantidade de entregas (padrão 50, máximo 100)"
+// @Success 200 {array} entities.WebhookDelivery "Entregas do webhook"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Webhook não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.webhookService.GetDeliveries(c.Request.Context(), companyID, id, limit)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get webhook deliveries"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"deliveries": deliveries,
+		"count":      len(deliveries),
+	})
+}
+
+// SendTestEvent godoc
+// @Summary Enviar evento de teste
+// @Description Enfileira um evento "ping" assinado para o webhook, mesmo que esteja desativado;
+// @Description o resultado pode ser acompanhado nas entregas
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID do webhook"
+// @Success 202 {object} entities.WebhookDelivery "Evento de teste enfileirado"
+// @Failure 400 {object} map[string]interface{} "ID inválido"
+// @Failure 404 {object} map[string]interface{} "Webhook não encontrado"
+// @Failure 500 {object} map[string]interface{} "Erro interno do servidor"
+// @Router /webhooks/{id}/test [post]
+func (h *WebhookHandler) SendTestEvent(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID"})
+		return
+	}
+
+	companyID, ok := companyFromContext(c)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.SendTestEvent(c.Request.Context(), companyID, id)
+	if err != nil {
+		if respondAuthorizationError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send test event"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
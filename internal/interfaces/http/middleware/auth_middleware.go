@@ -44,8 +44,8 @@ func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 
 		token := tokenParts[1]
 
-		// Validar token
-		userID, err := m.authService.ValidateJWT(token)
+		// Validar token (assinatura, expiração e revogação por logout)
+		identity, err := m.authService.ValidateJWT(c.Request.Context(), token)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"error": "Invalid or expired token",
@@ -54,8 +54,11 @@ func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 			return
 		}
 
-		// Adicionar user_id ao contexto
-		c.Set("user_id", userID)
+		// Adicionar user_id e a sessão do token ao contexto
+		c.Set("user_id", &identity.UserID)
+		c.Set("session_id", identity.SessionID)
+		// O usuário também segue no contexto da requisição, para as verificações de permissão dos serviços
+		c.Request = c.Request.WithContext(services.WithActor(c.Request.Context(), identity.UserID))
 
 		c.Next()
 	}
@@ -81,14 +84,17 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 		token := tokenParts[1]
 
 		// Validar token
-		userID, err := m.authService.ValidateJWT(token)
+		identity, err := m.authService.ValidateJWT(c.Request.Context(), token)
 		if err != nil {
 			c.Next()
 			return
 		}
 
-		// Adicionar user_id ao contexto se válido
-		c.Set("user_id", userID)
+		// Adicionar user_id e a sessão do token ao contexto se válido
+		c.Set("user_id", &identity.UserID)
+		c.Set("session_id", identity.SessionID)
+		// O usuário também segue no contexto da requisição, para as verificações de permissão dos serviços
+		c.Request = c.Request.WithContext(services.WithActor(c.Request.Context(), identity.UserID))
 
 		c.Next()
 	}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CompanyResolver descobre a empresa sobre a qual a requisição atua. Erros que envolvem
+// errInvalidRequest respondem 400 e os que envolvem errors.ErrNotFound, 404.
+type CompanyResolver func(c *gin.Context) (uuid.UUID, error)
+
+// errInvalidRequest marca os erros de leitura da empresa na requisição (ID ou corpo inválidos)
+var errInvalidRequest = errors.New("invalid")
+
+type PermissionMiddleware struct {
+	authorizer services.Authorizer
+}
+
+// NewPermissionMiddleware cria uma nova instância do middleware de permissões por papel na empresa
+func NewPermissionMiddleware(authorizer services.Authorizer) *PermissionMiddleware {
+	return &PermissionMiddleware{
+		authorizer: authorizer,
+	}
+}
+
+// RequirePermission exige que o usuário autenticado seja membro da empresa resolvida e que o seu
+// papel conceda a permissão. Deve ser usado depois de RequireAuth. Quem não é membro recebe 404 e
+// quem não tem a permissão, 403. Em caso de sucesso, adiciona company_id e company_role ao contexto.
+func (m *PermissionMiddleware) RequirePermission(permission entities.Permission, resolve CompanyResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		companyID, err := resolve(c)
+		if err != nil {
+			switch {
+			case errors.Is(err, errInvalidRequest):
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + strings.TrimPrefix(err.Error(), "invalid ")})
+			case errors.Is(err, domainErrors.ErrNotFound):
+				c.JSON(http.StatusNotFound, gin.H{"error": "Resource not found"})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check permissions"})
+			}
+			c.Abort()
+			return
+		}
+
+		member, err := m.authorizer.Authorize(c.Request.Context(), companyID, permission)
+		if err != nil {
+			switch {
+			case errors.Is(err, domainErrors.ErrNotFound):
+				// Não revelar a existência de empresas e recursos das quais o usuário não participa
+				c.JSON(http.StatusNotFound, gin.H{"error": "Resource not found"})
+			case errors.Is(err, domainErrors.ErrForbidden):
+				c.JSON(http.StatusForbidden, gin.H{
+					"error":      "Insufficient permissions",
+					"permission": permission,
+				})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check permissions"})
+			}
+			c.Abort()
+			return
+		}
+
+		c.Set("company_id", companyID)
+		c.Set("company_role", member.Role)
+
+		c.Next()
+	}
+}
+
+// CompanyParam resolve a empresa pelo parâmetro de rota informado (ex.: /companies/:id)
+func (m *PermissionMiddleware) CompanyParam(name string) CompanyResolver {
+	return func(c *gin.Context) (uuid.UUID, error) {
+		return parseCompanyID(c.Param(name))
+	}
+}
+
+// CompanyQuery resolve a empresa pelo parâmetro de query informado, que passa a ser obrigatório
+func (m *PermissionMiddleware) CompanyQuery(name string) CompanyResolver {
+	return func(c *gin.Context) (uuid.UUID, error) {
+		return parseCompanyID(c.Query(name))
+	}
+}
+
+// CompanyInBody resolve a empresa pelo campo company_id do corpo JSON, que é restaurado para o handler
+func (m *PermissionMiddleware) CompanyInBody() CompanyResolver {
+	return func(c *gin.Context) (uuid.UUID, error) {
+		if c.Request.Body == nil {
+			return uuid.Nil, fmt.Errorf("%w company ID", errInvalidRequest)
+		}
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w request body", errInvalidRequest)
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var payload struct {
+			CompanyID string `json:"company_id"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return uuid.Nil, fmt.Errorf("%w request format", errInvalidRequest)
+		}
+		return parseCompanyID(payload.CompanyID)
+	}
+}
+
+// ResourceParam resolve a empresa dona do recurso cujo ID está no parâmetro de rota informado
+func (m *PermissionMiddleware) ResourceParam(resource entities.ResourceType, name string) CompanyResolver {
+	return func(c *gin.Context) (uuid.UUID, error) {
+		id, err := uuid.Parse(c.Param(name))
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("%w %s ID", errInvalidRequest, strings.ReplaceAll(string(resource), "_", " "))
+		}
+		return m.authorizer.ResourceCompanyID(c.Request.Context(), resource, id)
+	}
+}
+
+// parseCompanyID converte o ID da empresa informado na requisição
+func parseCompanyID(value string) (uuid.UUID, error) {
+	companyID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w company ID", errInvalidRequest)
+	}
+	return companyID, nil
+}
@@ -0,0 +1,759 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"TestGO/internal/domain/entities"
+	domainErrors "TestGO/internal/domain/errors"
+	"TestGO/internal/domain/repositories"
+
+	"github.com/google/uuid"
+)
+
+// store guarda em memória os dados dos repositórios falsos usados pelos testes de rotas.
+// Os repositórios seguem as mesmas regras de escopo por empresa das implementações SQL.
+type store struct {
+	mu sync.Mutex
+
+	users        map[uuid.UUID]*entities.User
+	companies    map[uuid.UUID]*entities.Company
+	members      map[[2]uuid.UUID]*entities.CompanyMember
+	testSuites   map[uuid.UUID]*entities.TestSuite
+	testRuns     map[uuid.UUID]*entities.TestRun
+	jsonSchemas  map[uuid.UUID]*entities.JSONSchema
+	environments map[uuid.UUID]*entities.Environment
+	secrets      map[uuid.UUID]*entities.Secret
+	schedules    map[uuid.UUID]*entities.Schedule
+	webhooks     map[uuid.UUID]*entities.Webhook
+	deliveries   map[uuid.UUID]*entities.WebhookDelivery
+	chatChannels map[uuid.UUID]*entities.ChatChannel
+}
+
+func newStore() *store {
+	return &store{
+		users:        map[uuid.UUID]*entities.User{},
+		companies:    map[uuid.UUID]*entities.Company{},
+		members:      map[[2]uuid.UUID]*entities.CompanyMember{},
+		testSuites:   map[uuid.UUID]*entities.TestSuite{},
+		testRuns:     map[uuid.UUID]*entities.TestRun{},
+		jsonSchemas:  map[uuid.UUID]*entities.JSONSchema{},
+		environments: map[uuid.UUID]*entities.Environment{},
+		secrets:      map[uuid.UUID]*entities.Secret{},
+		schedules:    map[uuid.UUID]*entities.Schedule{},
+		webhooks:     map[uuid.UUID]*entities.Webhook{},
+		deliveries:   map[uuid.UUID]*entities.WebhookDelivery{},
+		chatChannels: map[uuid.UUID]*entities.ChatChannel{},
+	}
+}
+
+// notFound reproduz o erro dos repositórios SQL quando a linha não existe
+func notFound(resource string) error {
+	return fmt.Errorf("%s %w", resource, domainErrors.ErrNotFound)
+}
+
+// byCompany filtra as linhas da empresa, em ordem estável de criação
+func byCompany[T any](rows map[uuid.UUID]*T, companyID uuid.UUID, company func(*T) uuid.UUID, created func(*T) time.Time) []*T {
+	var result []*T
+	for _, row := range rows {
+		if company(row) == companyID {
+			result = append(result, row)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return created(result[i]).Before(created(result[j])) })
+	return result
+}
+
+// getScoped busca a linha pelo ID, desde que pertença à empresa
+func getScoped[T any](rows map[uuid.UUID]*T, companyID, id uuid.UUID, company func(*T) uuid.UUID, resource string) (*T, error) {
+	row, ok := rows[id]
+	if !ok || company(row) != companyID {
+		return nil, notFound(resource)
+	}
+	return row, nil
+}
+
+// deleteScoped remove a linha pelo ID, desde que pertença à empresa
+func deleteScoped[T any](rows map[uuid.UUID]*T, companyID, id uuid.UUID, company func(*T) uuid.UUID, resource string) error {
+	if _, err := getScoped(rows, companyID, id, company, resource); err != nil {
+		return err
+	}
+	delete(rows, id)
+	return nil
+}
+
+func (s *store) isMember(companyID, userID uuid.UUID) bool {
+	_, ok := s.members[[2]uuid.UUID{companyID, userID}]
+	return ok
+}
+
+// transactor executa a função diretamente; os testes de rotas não exercitam rollback
+type transactor struct{}
+
+func (transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type userRepo struct {
+	repositories.UserRepository
+	*store
+}
+
+func (r userRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return nil, notFound("user")
+	}
+	return user, nil
+}
+
+func (r userRepo) visibleTo(viewerID, id uuid.UUID) bool {
+	if viewerID == id {
+		return true
+	}
+	for key := range r.members {
+		if key[1] == viewerID && r.isMember(key[0], id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r userRepo) ListVisibleTo(ctx context.Context, viewerID uuid.UUID, limit, offset int) ([]*entities.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var users []*entities.User
+	for id, user := range r.users {
+		if r.visibleTo(viewerID, id) {
+			users = append(users, user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+	if offset >= len(users) {
+		return nil, nil
+	}
+	return users[offset:min(offset+limit, len(users))], nil
+}
+
+func (r userRepo) CountVisibleTo(ctx context.Context, viewerID uuid.UUID) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var total int64
+	for id := range r.users {
+		if r.visibleTo(viewerID, id) {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (r userRepo) GetVisibleTo(ctx context.Context, viewerID, id uuid.UUID) (*entities.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok || !r.visibleTo(viewerID, id) {
+		return nil, notFound("user")
+	}
+	return user, nil
+}
+
+type companyRepo struct {
+	repositories.CompanyRepository
+	*store
+}
+
+func (r companyRepo) CreateWithOwner(ctx context.Context, company *entities.Company, owner *entities.CompanyMember) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.companies[company.ID] = company
+	r.members[[2]uuid.UUID{owner.CompanyID, owner.UserID}] = owner
+	return nil
+}
+
+func (r companyRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.Company, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	company, ok := r.companies[id]
+	if !ok {
+		return nil, notFound("company")
+	}
+	return company, nil
+}
+
+func (r companyRepo) Update(ctx context.Context, company *entities.Company) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.companies[company.ID] = company
+	return nil
+}
+
+func (r companyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.companies, id)
+	return nil
+}
+
+func (r companyRepo) ListByUserID(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*entities.Company, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var companies []*entities.Company
+	for id, company := range r.companies {
+		if r.isMember(id, userID) {
+			companies = append(companies, company)
+		}
+	}
+	return companies, nil
+}
+
+func (r companyRepo) ExistsByName(ctx context.Context, name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, company := range r.companies {
+		if company.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r companyRepo) ExistsByEmail(ctx context.Context, email string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, company := range r.companies {
+		if company.Email == email {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type companyMemberRepo struct {
+	repositories.CompanyMemberRepository
+	*store
+}
+
+func (r companyMemberRepo) Create(ctx context.Context, member *entities.CompanyMember) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members[[2]uuid.UUID{member.CompanyID, member.UserID}] = member
+	return nil
+}
+
+func (r companyMemberRepo) Get(ctx context.Context, companyID, userID uuid.UUID) (*entities.CompanyMember, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	member, ok := r.members[[2]uuid.UUID{companyID, userID}]
+	if !ok {
+		return nil, notFound("company member")
+	}
+	return member, nil
+}
+
+func (r companyMemberRepo) ListByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var members []*entities.CompanyMember
+	for key, member := range r.members {
+		if key[0] == companyID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (r companyMemberRepo) UpdateRole(ctx context.Context, companyID, userID uuid.UUID, role entities.CompanyRole) (*entities.CompanyMember, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	member, ok := r.members[[2]uuid.UUID{companyID, userID}]
+	if !ok {
+		return nil, notFound("company member")
+	}
+	member.Role = role
+	return member, nil
+}
+
+func (r companyMemberRepo) Delete(ctx context.Context, companyID, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.isMember(companyID, userID) {
+		return notFound("company member")
+	}
+	delete(r.members, [2]uuid.UUID{companyID, userID})
+	return nil
+}
+
+func (r companyMemberRepo) GetResourceCompanyID(ctx context.Context, userID uuid.UUID, resource entities.ResourceType, id uuid.UUID) (uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var companyID uuid.UUID
+	switch resource {
+	case entities.ResourceTestSuite:
+		if row, ok := r.testSuites[id]; ok {
+			companyID = row.CompanyID
+		}
+	case entities.ResourceTestRun:
+		if row, ok := r.testRuns[id]; ok {
+			companyID = row.CompanyID
+		}
+	case entities.ResourceJSONSchema:
+		if row, ok := r.jsonSchemas[id]; ok {
+			companyID = row.CompanyID
+		}
+	case entities.ResourceWebhook:
+		if row, ok := r.webhooks[id]; ok {
+			companyID = row.CompanyID
+		}
+	case entities.ResourceChatChannel:
+		if row, ok := r.chatChannels[id]; ok {
+			companyID = row.CompanyID
+		}
+	default:
+		return uuid.Nil, fmt.Errorf("unknown resource type %q", resource)
+	}
+	if companyID == uuid.Nil || !r.isMember(companyID, userID) {
+		return uuid.Nil, notFound(string(resource))
+	}
+	return companyID, nil
+}
+
+func testSuiteCompany(ts *entities.TestSuite) uuid.UUID   { return ts.CompanyID }
+func testSuiteCreated(ts *entities.TestSuite) time.Time   { return ts.CreatedAt }
+func testRunCompany(tr *entities.TestRun) uuid.UUID       { return tr.CompanyID }
+func jsonSchemaCompany(js *entities.JSONSchema) uuid.UUID { return js.CompanyID }
+func jsonSchemaCreated(js *entities.JSONSchema) time.Time { return js.CreatedAt }
+
+type testSuiteRepo struct {
+	repositories.TestSuiteRepository
+	*store
+}
+
+func (r testSuiteRepo) Create(ctx context.Context, testSuite *entities.TestSuite) (*entities.TestSuite, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.testSuites[testSuite.ID] = testSuite
+	return testSuite, nil
+}
+
+func (r testSuiteRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestSuite, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.testSuites, companyID, id, testSuiteCompany, "test suite")
+}
+
+func (r testSuiteRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestSuite, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.testSuites, companyID, testSuiteCompany, testSuiteCreated), nil
+}
+
+func (r testSuiteRepo) ListByCompanyID(ctx context.Context, companyID uuid.UUID, limit, offset int) ([]*entities.TestSuite, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	testSuites := byCompany(r.testSuites, companyID, testSuiteCompany, testSuiteCreated)
+	if offset >= len(testSuites) {
+		return nil, nil
+	}
+	return testSuites[offset:min(offset+limit, len(testSuites))], nil
+}
+
+func (r testSuiteRepo) CountByCompanyID(ctx context.Context, companyID uuid.UUID) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return int64(len(byCompany(r.testSuites, companyID, testSuiteCompany, testSuiteCreated))), nil
+}
+
+func (r testSuiteRepo) Update(ctx context.Context, testSuite *entities.TestSuite) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.testSuites, testSuite.CompanyID, testSuite.ID, testSuiteCompany, "test suite"); err != nil {
+		return err
+	}
+	r.testSuites[testSuite.ID] = testSuite
+	return nil
+}
+
+func (r testSuiteRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.testSuites, companyID, id, testSuiteCompany, "test suite")
+}
+
+func (r testSuiteRepo) GetByImportKeys(ctx context.Context, companyID uuid.UUID, keys []string) ([]*entities.TestSuite, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var testSuites []*entities.TestSuite
+	for _, testSuite := range byCompany(r.testSuites, companyID, testSuiteCompany, testSuiteCreated) {
+		for _, key := range keys {
+			if testSuite.ImportKey != nil && *testSuite.ImportKey == key {
+				testSuites = append(testSuites, testSuite)
+			}
+		}
+	}
+	return testSuites, nil
+}
+
+type testRunRepo struct {
+	repositories.TestRunRepository
+	*store
+}
+
+func (r testRunRepo) Create(ctx context.Context, testRun *entities.TestRun) (*entities.TestRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.testRuns[testRun.ID] = testRun
+	return testRun, nil
+}
+
+// GetByID devolve uma cópia, para que UpdateIfStatus compare com o estado gravado e não com o alterado pelo serviço
+func (r testRunRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	testRun, err := getScoped(r.testRuns, companyID, id, testRunCompany, "test run")
+	if err != nil {
+		return nil, err
+	}
+	stored := *testRun
+	return &stored, nil
+}
+
+func (r testRunRepo) UpdateIfStatus(ctx context.Context, testRun *entities.TestRun, expected entities.TestRunStatus) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current, err := getScoped(r.testRuns, testRun.CompanyID, testRun.ID, testRunCompany, "test run")
+	if err != nil {
+		return false, err
+	}
+	if current.Status != expected {
+		return false, nil
+	}
+	r.testRuns[testRun.ID] = testRun
+	return true, nil
+}
+
+// testResultRepo não guarda resultados: as execuções dos testes de rotas nunca são processadas
+type testResultRepo struct {
+	repositories.TestResultRepository
+	*store
+}
+
+func (r testResultRepo) GetByTestRunID(ctx context.Context, companyID, testRunID uuid.UUID) ([]*entities.TestResult, error) {
+	return nil, nil
+}
+
+type jsonSchemaRepo struct {
+	repositories.JSONSchemaRepository
+	*store
+}
+
+func (r jsonSchemaRepo) Create(ctx context.Context, schema *entities.JSONSchema) (*entities.JSONSchema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jsonSchemas[schema.ID] = schema
+	return schema, nil
+}
+
+func (r jsonSchemaRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.JSONSchema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.jsonSchemas, companyID, id, jsonSchemaCompany, "json schema")
+}
+
+func (r jsonSchemaRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.JSONSchema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.jsonSchemas, companyID, jsonSchemaCompany, jsonSchemaCreated), nil
+}
+
+func (r jsonSchemaRepo) Update(ctx context.Context, schema *entities.JSONSchema) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.jsonSchemas, schema.CompanyID, schema.ID, jsonSchemaCompany, "json schema"); err != nil {
+		return err
+	}
+	r.jsonSchemas[schema.ID] = schema
+	return nil
+}
+
+func (r jsonSchemaRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.jsonSchemas, companyID, id, jsonSchemaCompany, "json schema")
+}
+
+func environmentCompany(e *entities.Environment) uuid.UUID { return e.CompanyID }
+func environmentCreated(e *entities.Environment) time.Time { return e.CreatedAt }
+
+type environmentRepo struct {
+	repositories.EnvironmentRepository
+	*store
+}
+
+func (r environmentRepo) Create(ctx context.Context, environment *entities.Environment) (*entities.Environment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.environments[environment.ID] = environment
+	return environment, nil
+}
+
+func (r environmentRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Environment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.environments, companyID, id, environmentCompany, "environment")
+}
+
+func (r environmentRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Environment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.environments, companyID, environmentCompany, environmentCreated), nil
+}
+
+func (r environmentRepo) Update(ctx context.Context, environment *entities.Environment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.environments, environment.CompanyID, environment.ID, environmentCompany, "environment"); err != nil {
+		return err
+	}
+	r.environments[environment.ID] = environment
+	return nil
+}
+
+func (r environmentRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.environments, companyID, id, environmentCompany, "environment")
+}
+
+func (r environmentRepo) ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, environment := range byCompany(r.environments, companyID, environmentCompany, environmentCreated) {
+		if environment.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func secretCompany(s *entities.Secret) uuid.UUID { return s.CompanyID }
+func secretCreated(s *entities.Secret) time.Time { return s.CreatedAt }
+
+type secretRepo struct {
+	repositories.SecretRepository
+	*store
+}
+
+func (r secretRepo) Create(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.secrets[secret.ID] = secret
+	return secret, nil
+}
+
+func (r secretRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.secrets, companyID, id, secretCompany, "secret")
+}
+
+func (r secretRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.secrets, companyID, secretCompany, secretCreated), nil
+}
+
+func (r secretRepo) Update(ctx context.Context, secret *entities.Secret) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.secrets, secret.CompanyID, secret.ID, secretCompany, "secret"); err != nil {
+		return err
+	}
+	r.secrets[secret.ID] = secret
+	return nil
+}
+
+func (r secretRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.secrets, companyID, id, secretCompany, "secret")
+}
+
+func (r secretRepo) ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, secret := range byCompany(r.secrets, companyID, secretCompany, secretCreated) {
+		if secret.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func scheduleCompany(s *entities.Schedule) uuid.UUID { return s.CompanyID }
+func scheduleCreated(s *entities.Schedule) time.Time { return s.CreatedAt }
+
+type scheduleRepo struct {
+	repositories.ScheduleRepository
+	*store
+}
+
+func (r scheduleRepo) Create(ctx context.Context, schedule *entities.Schedule) (*entities.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schedules[schedule.ID] = schedule
+	return schedule, nil
+}
+
+func (r scheduleRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.schedules, companyID, id, scheduleCompany, "schedule")
+}
+
+func (r scheduleRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.schedules, companyID, scheduleCompany, scheduleCreated), nil
+}
+
+func (r scheduleRepo) Update(ctx context.Context, schedule *entities.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.schedules, schedule.CompanyID, schedule.ID, scheduleCompany, "schedule"); err != nil {
+		return err
+	}
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (r scheduleRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.schedules, companyID, id, scheduleCompany, "schedule")
+}
+
+func (r scheduleRepo) ExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, schedule := range byCompany(r.schedules, companyID, scheduleCompany, scheduleCreated) {
+		if schedule.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func webhookCompany(w *entities.Webhook) uuid.UUID { return w.CompanyID }
+func webhookCreated(w *entities.Webhook) time.Time { return w.CreatedAt }
+
+type webhookRepo struct {
+	repositories.WebhookRepository
+	*store
+}
+
+func (r webhookRepo) Create(ctx context.Context, webhook *entities.Webhook) (*entities.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.webhooks[webhook.ID] = webhook
+	return webhook, nil
+}
+
+func (r webhookRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.webhooks, companyID, id, webhookCompany, "webhook")
+}
+
+func (r webhookRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Webhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.webhooks, companyID, webhookCompany, webhookCreated), nil
+}
+
+func (r webhookRepo) Update(ctx context.Context, webhook *entities.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.webhooks, webhook.CompanyID, webhook.ID, webhookCompany, "webhook"); err != nil {
+		return err
+	}
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+func (r webhookRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.webhooks, companyID, id, webhookCompany, "webhook")
+}
+
+type webhookDeliveryRepo struct {
+	repositories.WebhookDeliveryRepository
+	*store
+}
+
+func (r webhookDeliveryRepo) Create(ctx context.Context, delivery *entities.WebhookDelivery) (*entities.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries[delivery.ID] = delivery
+	return delivery, nil
+}
+
+func (r webhookDeliveryRepo) GetByWebhookID(ctx context.Context, companyID, webhookID uuid.UUID, limit int) ([]*entities.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var deliveries []*entities.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if webhook, ok := r.webhooks[delivery.WebhookID]; ok && webhook.CompanyID == companyID && delivery.WebhookID == webhookID {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+func chatChannelCompany(c *entities.ChatChannel) uuid.UUID { return c.CompanyID }
+func chatChannelCreated(c *entities.ChatChannel) time.Time { return c.CreatedAt }
+
+type chatChannelRepo struct {
+	repositories.ChatChannelRepository
+	*store
+}
+
+func (r chatChannelRepo) Create(ctx context.Context, channel *entities.ChatChannel) (*entities.ChatChannel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chatChannels[channel.ID] = channel
+	return channel, nil
+}
+
+func (r chatChannelRepo) GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.ChatChannel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return getScoped(r.chatChannels, companyID, id, chatChannelCompany, "chat channel")
+}
+
+func (r chatChannelRepo) GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return byCompany(r.chatChannels, companyID, chatChannelCompany, chatChannelCreated), nil
+}
+
+func (r chatChannelRepo) Update(ctx context.Context, channel *entities.ChatChannel) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := getScoped(r.chatChannels, channel.CompanyID, channel.ID, chatChannelCompany, "chat channel"); err != nil {
+		return err
+	}
+	r.chatChannels[channel.ID] = channel
+	return nil
+}
+
+func (r chatChannelRepo) Delete(ctx context.Context, companyID, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return deleteScoped(r.chatChannels, companyID, id, chatChannelCompany, "chat channel")
+}
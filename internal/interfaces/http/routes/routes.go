@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"TestGO/internal/domain/entities"
 	"TestGO/internal/infrastructure/container"
 
 	"github.com/gin-gonic/gin"
@@ -14,12 +15,21 @@ func SetupRoutes(router *gin.Engine, container *container.Container) {
 		authRoutes.POST("/register", container.AuthHandler.Register)
 		authRoutes.POST("/login", container.AuthHandler.Login)
 		authRoutes.POST("/logout", container.AuthMiddleware.RequireAuth(), container.AuthHandler.Logout)
+		authRoutes.POST("/logout-all", container.AuthMiddleware.RequireAuth(), container.AuthHandler.LogoutAll)
 		authRoutes.POST("/refresh", container.AuthHandler.RefreshToken)
 	}
 
-	// Rotas protegidas
+	// Rotas protegidas. As rotas de uma empresa exigem que o usuário seja membro dela e que o seu papel
+	// conceda a permissão indicada (matriz de papéis em entities.rolePermissions)
 	api := router.Group("/api")
 	api.Use(container.AuthMiddleware.RequireAuth())
+
+	rbac := container.PermissionMiddleware
+	can := rbac.RequirePermission
+	company := rbac.CompanyParam("id")
+	companyParam := rbac.CompanyParam("companyId")
+	companyInQuery := rbac.CompanyQuery("company_id")
+	companyInBody := rbac.CompanyInBody()
 	{
 		// Rotas de usuário
 		userRoutes := api.Group("/users")
@@ -28,31 +38,119 @@ func SetupRoutes(router *gin.Engine, container *container.Container) {
 			userRoutes.PUT("/profile", container.UserHandler.UpdateProfile)
 			userRoutes.DELETE("/profile", container.UserHandler.DeleteProfile)
 			userRoutes.POST("/change-password", container.UserHandler.ChangePassword)
+			userRoutes.GET("/sessions", container.SessionHandler.List)
+			userRoutes.DELETE("/sessions/:id", container.SessionHandler.Revoke)
 			userRoutes.GET("", container.UserHandler.ListUsers)
 			userRoutes.GET("/:id", container.UserHandler.GetUserByID)
 			userRoutes.POST("/link-company", container.UserHandler.LinkCompany)
 
 		}
 
-		// Rotas de empresa
+		// Rotas de empresa. Criar e listar empresas exige apenas autenticação; quem cria vira owner
 		companyRoutes := api.Group("/companies")
 		{
 			companyRoutes.POST("", container.CompanyHandler.Create)
-			companyRoutes.GET("/:id", container.CompanyHandler.GetByID)
-			companyRoutes.PUT("/:id", container.CompanyHandler.Update)
-			companyRoutes.DELETE("/:id", container.CompanyHandler.Delete)
+			companyRoutes.GET("/:id", can(entities.PermissionCompanyRead, company), container.CompanyHandler.GetByID)
+			companyRoutes.PUT("/:id", can(entities.PermissionCompanyUpdate, company), container.CompanyHandler.Update)
+			companyRoutes.DELETE("/:id", can(entities.PermissionCompanyDelete, company), container.CompanyHandler.Delete)
 			companyRoutes.GET("", container.CompanyHandler.List)
+
+			// Membros da empresa e seus papéis
+			companyRoutes.GET("/:id/members", can(entities.PermissionMembersRead, company), container.CompanyMemberHandler.List)
+			companyRoutes.POST("/:id/members", can(entities.PermissionMembersManage, company), container.CompanyMemberHandler.Add)
+			companyRoutes.PUT("/:id/members/:userId", can(entities.PermissionMembersManage, company), container.CompanyMemberHandler.UpdateRole)
+			companyRoutes.DELETE("/:id/members/:userId", can(entities.PermissionMembersManage, company), container.CompanyMemberHandler.Remove)
+
+			// Ambientes da empresa
+			companyRoutes.POST("/:id/environments", can(entities.PermissionEnvironmentsWrite, company), container.EnvironmentHandler.Create)
+			companyRoutes.GET("/:id/environments", can(entities.PermissionEnvironmentsRead, company), container.EnvironmentHandler.List)
+			companyRoutes.GET("/:id/environments/:environmentId", can(entities.PermissionEnvironmentsRead, company), container.EnvironmentHandler.GetByID)
+			companyRoutes.PUT("/:id/environments/:environmentId", can(entities.PermissionEnvironmentsWrite, company), container.EnvironmentHandler.Update)
+			companyRoutes.DELETE("/:id/environments/:environmentId", can(entities.PermissionEnvironmentsWrite, company), container.EnvironmentHandler.Delete)
+
+			// Segredos da empresa (valores nunca são devolvidos)
+			companyRoutes.POST("/:id/secrets", can(entities.PermissionSecretsWrite, company), container.SecretHandler.Create)
+			companyRoutes.GET("/:id/secrets", can(entities.PermissionSecretsRead, company), container.SecretHandler.List)
+			companyRoutes.GET("/:id/secrets/:secretId", can(entities.PermissionSecretsRead, company), container.SecretHandler.GetByID)
+			companyRoutes.PUT("/:id/secrets/:secretId", can(entities.PermissionSecretsWrite, company), container.SecretHandler.Update)
+			companyRoutes.DELETE("/:id/secrets/:secretId", can(entities.PermissionSecretsWrite, company), container.SecretHandler.Delete)
+
+			// Agendamentos cron de execuções da empresa
+			companyRoutes.POST("/:id/schedules", can(entities.PermissionSchedulesWrite, company), container.ScheduleHandler.Create)
+			companyRoutes.GET("/:id/schedules", can(entities.PermissionSchedulesRead, company), container.ScheduleHandler.List)
+			companyRoutes.GET("/:id/schedules/:scheduleId", can(entities.PermissionSchedulesRead, company), container.ScheduleHandler.GetByID)
+			companyRoutes.PUT("/:id/schedules/:scheduleId", can(entities.PermissionSchedulesWrite, company), container.ScheduleHandler.Update)
+			companyRoutes.DELETE("/:id/schedules/:scheduleId", can(entities.PermissionSchedulesWrite, company), container.ScheduleHandler.Delete)
+
+			// Importação de coleções de outras ferramentas
+			companyRoutes.POST("/:id/imports/postman", can(entities.PermissionSuitesWrite, company), container.ImportHandler.ImportPostman)
+			companyRoutes.POST("/:id/imports/openapi", can(entities.PermissionSuitesWrite, company), container.ImportHandler.ImportOpenAPI)
+			companyRoutes.POST("/:id/imports/har", can(entities.PermissionSuitesWrite, company), container.ImportHandler.ImportHAR)
+			companyRoutes.POST("/:id/imports/curl", can(entities.PermissionSuitesWrite, company), container.ImportHandler.ImportCurl)
+
+			// Pacote portátil das suítes e ambientes, para versionamento em git
+			companyRoutes.GET("/:id/test-suites/export", can(entities.PermissionSuitesRead, company), container.BundleHandler.Export)
+			companyRoutes.POST("/:id/test-suites/import", can(entities.PermissionSuitesWrite, company), container.BundleHandler.Import)
 		}
 
 		// Rotas de test suite
+		testSuite := rbac.ResourceParam(entities.ResourceTestSuite, "id")
 		testSuiteRoutes := api.Group("/test-suites")
 		{
-			testSuiteRoutes.POST("", container.TestSuiteHandler.Create)
-			testSuiteRoutes.GET("/:id", container.TestSuiteHandler.GetByID)
-			testSuiteRoutes.PUT("/:id", container.TestSuiteHandler.Update)
-			testSuiteRoutes.DELETE("/:id", container.TestSuiteHandler.Delete)
-			testSuiteRoutes.GET("", container.TestSuiteHandler.List)
-			testSuiteRoutes.GET("/company/:companyId", container.TestSuiteHandler.GetByCompanyID)
+			testSuiteRoutes.POST("", can(entities.PermissionSuitesWrite, companyInBody), container.TestSuiteHandler.Create)
+			testSuiteRoutes.GET("/:id", can(entities.PermissionSuitesRead, testSuite), container.TestSuiteHandler.GetByID)
+			testSuiteRoutes.PUT("/:id", can(entities.PermissionSuitesWrite, testSuite), container.TestSuiteHandler.Update)
+			testSuiteRoutes.DELETE("/:id", can(entities.PermissionSuitesWrite, testSuite), container.TestSuiteHandler.Delete)
+			testSuiteRoutes.GET("", can(entities.PermissionSuitesRead, companyInQuery), container.TestSuiteHandler.List)
+			testSuiteRoutes.GET("/company/:companyId", can(entities.PermissionSuitesRead, companyParam), container.TestSuiteHandler.GetByCompanyID)
+		}
+
+		// Rotas de execução de testes
+		testRun := rbac.ResourceParam(entities.ResourceTestRun, "id")
+		testRunRoutes := api.Group("/test-runs")
+		{
+			testRunRoutes.POST("", can(entities.PermissionRunsExecute, companyInBody), container.TestRunHandler.Create)
+			testRunRoutes.GET("/:id", can(entities.PermissionRunsRead, testRun), container.TestRunHandler.GetByID)
+			testRunRoutes.GET("/:id/results", can(entities.PermissionRunsRead, testRun), container.TestRunHandler.GetResults)
+			testRunRoutes.GET("/:id/report", can(entities.PermissionRunsRead, testRun), container.TestRunHandler.Report)
+			testRunRoutes.GET("/:id/report.html", can(entities.PermissionRunsRead, testRun), container.TestRunHandler.ReportHTML)
+			testRunRoutes.POST("/:id/cancel", can(entities.PermissionRunsExecute, testRun), container.TestRunHandler.Cancel)
+		}
+
+		// Rotas de JSON Schemas (mantidos junto com as suítes que os usam)
+		jsonSchema := rbac.ResourceParam(entities.ResourceJSONSchema, "id")
+		jsonSchemaRoutes := api.Group("/json-schemas")
+		{
+			jsonSchemaRoutes.POST("", can(entities.PermissionSuitesWrite, companyInBody), container.JSONSchemaHandler.Create)
+			jsonSchemaRoutes.GET("/:id", can(entities.PermissionSuitesRead, jsonSchema), container.JSONSchemaHandler.GetByID)
+			jsonSchemaRoutes.PUT("/:id", can(entities.PermissionSuitesWrite, jsonSchema), container.JSONSchemaHandler.Update)
+			jsonSchemaRoutes.DELETE("/:id", can(entities.PermissionSuitesWrite, jsonSchema), container.JSONSchemaHandler.Delete)
+			jsonSchemaRoutes.GET("", can(entities.PermissionSuitesRead, companyInQuery), container.JSONSchemaHandler.List)
+		}
+
+		// Rotas de webhooks
+		webhook := rbac.ResourceParam(entities.ResourceWebhook, "id")
+		webhookRoutes := api.Group("/webhooks")
+		{
+			webhookRoutes.POST("", can(entities.PermissionIntegrationsWrite, companyInBody), container.WebhookHandler.Create)
+			webhookRoutes.GET("/:id", can(entities.PermissionIntegrationsRead, webhook), container.WebhookHandler.GetByID)
+			webhookRoutes.PUT("/:id", can(entities.PermissionIntegrationsWrite, webhook), container.WebhookHandler.Update)
+			webhookRoutes.DELETE("/:id", can(entities.PermissionIntegrationsWrite, webhook), container.WebhookHandler.Delete)
+			webhookRoutes.GET("/company/:companyId", can(entities.PermissionIntegrationsRead, companyParam), container.WebhookHandler.GetByCompanyID)
+			webhookRoutes.GET("/:id/deliveries", can(entities.PermissionIntegrationsRead, webhook), container.WebhookHandler.GetDeliveries)
+			webhookRoutes.POST("/:id/test", can(entities.PermissionIntegrationsWrite, webhook), container.WebhookHandler.SendTestEvent)
+		}
+
+		// Rotas de canais de chat (Slack/Teams)
+		chatChannel := rbac.ResourceParam(entities.ResourceChatChannel, "id")
+		chatChannelRoutes := api.Group("/chat-channels")
+		{
+			chatChannelRoutes.POST("", can(entities.PermissionIntegrationsWrite, companyInBody), container.ChatChannelHandler.Create)
+			chatChannelRoutes.GET("/:id", can(entities.PermissionIntegrationsRead, chatChannel), container.ChatChannelHandler.GetByID)
+			chatChannelRoutes.PUT("/:id", can(entities.PermissionIntegrationsWrite, chatChannel), container.ChatChannelHandler.Update)
+			chatChannelRoutes.DELETE("/:id", can(entities.PermissionIntegrationsWrite, chatChannel), container.ChatChannelHandler.Delete)
+			chatChannelRoutes.GET("/company/:companyId", can(entities.PermissionIntegrationsRead, companyParam), container.ChatChannelHandler.GetByCompanyID)
+			chatChannelRoutes.POST("/:id/test", can(entities.PermissionIntegrationsWrite, chatChannel), container.ChatChannelHandler.SendTestMessage)
 		}
 	}
 
@@ -0,0 +1,627 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"TestGO/internal/application/notifications"
+	"TestGO/internal/application/runner"
+	appServices "TestGO/internal/application/services"
+	"TestGO/internal/domain/entities"
+	"TestGO/internal/infrastructure/container"
+	"TestGO/internal/infrastructure/security"
+	"TestGO/internal/interfaces/http/handlers"
+	"TestGO/internal/interfaces/http/middleware"
+	"TestGO/internal/interfaces/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// tokenAuth aceita como token o próprio ID do usuário, para que os testes escolham quem faz a requisição
+type tokenAuth struct {
+	services.AuthService
+}
+
+func (tokenAuth) ValidateJWT(ctx context.Context, token string) (*services.TokenIdentity, error) {
+	userID, err := uuid.Parse(token)
+	if err != nil {
+		return nil, err
+	}
+	return &services.TokenIdentity{UserID: userID, SessionID: uuid.New()}, nil
+}
+
+// companyIDs reúne uma empresa e um recurso de cada tipo dela
+type companyIDs struct {
+	company     uuid.UUID
+	testSuite   uuid.UUID
+	testRun     uuid.UUID
+	jsonSchema  uuid.UUID
+	environment uuid.UUID
+	secret      uuid.UUID
+	schedule    uuid.UUID
+	webhook     uuid.UUID
+	chatChannel uuid.UUID
+	// viewer é o membro alvo das rotas de gerenciamento de membros
+	viewer uuid.UUID
+	// candidate é um usuário sem empresa, que pode ser adicionado como membro
+	candidate uuid.UUID
+}
+
+// fixture é a API completa sobre repositórios em memória, com duas empresas: home, com um membro de cada
+// papel, e other, da qual apenas outsider é membro (owner)
+type fixture struct {
+	router *gin.Engine
+	store  *store
+	users  map[string]uuid.UUID
+	home   companyIDs
+	other  companyIDs
+}
+
+const outsider = "outsider"
+
+var roles = []string{
+	string(entities.CompanyRoleOwner),
+	string(entities.CompanyRoleAdmin),
+	string(entities.CompanyRoleEditor),
+	string(entities.CompanyRoleViewer),
+	outsider,
+}
+
+// roleRank ordena os papéis: cada papel tem as permissões dos papéis abaixo dele
+var roleRank = map[string]int{
+	string(entities.CompanyRoleViewer): 1,
+	string(entities.CompanyRoleEditor): 2,
+	string(entities.CompanyRoleAdmin):  3,
+	string(entities.CompanyRoleOwner):  4,
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	// Os canais de chat da fixture enviam as mensagens de teste para este servidor
+	chatServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(chatServer.Close)
+
+	secretCipher, err := security.NewSecretCipher("routes-test-master-key")
+	if err != nil {
+		t.Fatalf("failed to create secret cipher: %v", err)
+	}
+
+	f := &fixture{store: newStore(), users: map[string]uuid.UUID{}}
+	for _, role := range append(roles, "candidate", "other-candidate") {
+		user := entities.NewUser(role, role+"@example.com", "hashed")
+		f.store.users[user.ID] = user
+		f.users[role] = user.ID
+	}
+
+	f.home = f.seedCompany(t, "Home", secretCipher, chatServer.URL)
+	f.other = f.seedCompany(t, "Other", secretCipher, chatServer.URL)
+	for _, role := range roles[:4] {
+		f.addMember(f.home.company, f.users[role], entities.CompanyRole(role))
+	}
+	f.addMember(f.other.company, f.users[outsider], entities.CompanyRoleOwner)
+	f.home.viewer = f.users[string(entities.CompanyRoleViewer)]
+	f.home.candidate = f.users["candidate"]
+	f.other.viewer = f.users[outsider]
+	f.other.candidate = f.users["other-candidate"]
+
+	f.router = newRouter(f.store, secretCipher)
+	return f
+}
+
+// newRouter monta as rotas reais com os serviços, handlers e middlewares da aplicação sobre o store
+func newRouter(s *store, secretCipher *security.SecretCipher) *gin.Engine {
+	users := userRepo{store: s}
+	companies := companyRepo{store: s}
+	members := companyMemberRepo{store: s}
+	testSuites := testSuiteRepo{store: s}
+	testRuns := testRunRepo{store: s}
+	testResults := testResultRepo{store: s}
+	jsonSchemas := jsonSchemaRepo{store: s}
+	environments := environmentRepo{store: s}
+	secrets := secretRepo{store: s}
+	schedules := scheduleRepo{store: s}
+	webhooks := webhookRepo{store: s}
+	deliveries := webhookDeliveryRepo{store: s}
+	chatChannels := chatChannelRepo{store: s}
+
+	// O despachante e o pool não são iniciados: as entregas e execuções ficam apenas na fila
+	dispatcher := notifications.NewWebhookDispatcher(webhooks, deliveries, secretCipher, notifications.DispatcherConfig{})
+	chatNotifier := notifications.NewChatNotifier(chatChannels, companies, testResults, testSuites, secretCipher, 5*time.Second)
+	workerPool := runner.NewWorkerPool(testRuns, testResults, testSuites, jsonSchemas, environments, secrets, secretCipher,
+		runner.NewExecutor(5*time.Second), dispatcher, notifications.NewPipeline(), runner.PoolConfig{})
+
+	auth := tokenAuth{}
+	authorizer := appServices.NewAuthorizationService(members)
+	companyService := appServices.NewCompanyService(companies, authorizer)
+
+	c := &container.Container{
+		AuthHandler:          handlers.NewAuthHandler(auth),
+		SessionHandler:       handlers.NewSessionHandler(auth),
+		UserHandler:          handlers.NewUserHandler(appServices.NewUserService(users, members, security.NewPasswordService(4)), companyService),
+		CompanyHandler:       handlers.NewCompanyHandler(companyService),
+		CompanyMemberHandler: handlers.NewCompanyMemberHandler(appServices.NewCompanyMemberService(members, users, authorizer)),
+		TestSuiteHandler:     handlers.NewTestSuiteHandler(appServices.NewTestSuiteService(testSuites, jsonSchemas, authorizer)),
+		TestRunHandler:       handlers.NewTestRunHandler(appServices.NewTestRunService(testRuns, testResults, testSuites, companies, environments, workerPool, authorizer)),
+		JSONSchemaHandler:    handlers.NewJSONSchemaHandler(appServices.NewJSONSchemaService(jsonSchemas, authorizer)),
+		EnvironmentHandler:   handlers.NewEnvironmentHandler(appServices.NewEnvironmentService(environments, authorizer)),
+		SecretHandler:        handlers.NewSecretHandler(appServices.NewSecretService(secrets, secretCipher, authorizer)),
+		ScheduleHandler:      handlers.NewScheduleHandler(appServices.NewScheduleService(schedules, testSuites, environments, authorizer)),
+		WebhookHandler:       handlers.NewWebhookHandler(appServices.NewWebhookService(webhooks, deliveries, secretCipher, dispatcher, authorizer)),
+		ChatChannelHandler:   handlers.NewChatChannelHandler(appServices.NewChatChannelService(chatChannels, secretCipher, chatNotifier, authorizer)),
+		ImportHandler:        handlers.NewImportHandler(appServices.NewImportService(transactor{}, testSuites, environments, authorizer)),
+		BundleHandler:        handlers.NewBundleHandler(appServices.NewBundleService(transactor{}, testSuites, environments, jsonSchemas, authorizer)),
+		AuthMiddleware:       middleware.NewAuthMiddleware(auth),
+		PermissionMiddleware: middleware.NewPermissionMiddleware(authorizer),
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	SetupRoutes(router, c)
+	return router
+}
+
+// seedCompany cria a empresa com um recurso de cada tipo
+func (f *fixture) seedCompany(t *testing.T, name string, secretCipher *security.SecretCipher, chatURL string) companyIDs {
+	t.Helper()
+	s := f.store
+
+	company := entities.NewCompany(name, strings.ToLower(name)+"@example.com", "", "")
+	s.companies[company.ID] = company
+
+	testSuite := entities.NewTestSuite(company.ID, name+" health", "GET", "https://api.example.com/health", nil, http.StatusOK, "")
+	s.testSuites[testSuite.ID] = testSuite
+
+	testRun := entities.NewTestRun(company.ID, nil, nil)
+	s.testRuns[testRun.ID] = testRun
+
+	jsonSchema := entities.NewJSONSchema(company.ID, name+" schema", "", json.RawMessage(`{"type":"object"}`))
+	s.jsonSchemas[jsonSchema.ID] = jsonSchema
+
+	environment := entities.NewEnvironment(company.ID, "production", map[string]string{"baseUrl": "https://api.example.com"})
+	s.environments[environment.ID] = environment
+
+	secret := entities.NewSecret(company.ID, "API_TOKEN")
+	encrypted, err := secretCipher.Encrypt([]byte("token"), secret.ID[:])
+	if err != nil {
+		t.Fatalf("failed to encrypt secret: %v", err)
+	}
+	secret.EncryptedValue = encrypted
+	s.secrets[secret.ID] = secret
+
+	schedule := entities.NewSchedule(company.ID, "nightly", "0 3 * * *", "UTC", nil, nil, true)
+	s.schedules[schedule.ID] = schedule
+
+	webhook := entities.NewWebhook(company.ID, "https://hooks.example.com/"+strings.ToLower(name), nil, true)
+	s.webhooks[webhook.ID] = webhook
+
+	chatChannel := entities.NewChatChannel(company.ID, "#qa-"+strings.ToLower(name), entities.ChatProviderSlack, false, true)
+	encrypted, err = secretCipher.Encrypt([]byte(chatURL), chatChannel.ID[:])
+	if err != nil {
+		t.Fatalf("failed to encrypt chat webhook url: %v", err)
+	}
+	chatChannel.EncryptedURL = encrypted
+	s.chatChannels[chatChannel.ID] = chatChannel
+
+	return companyIDs{
+		company:     company.ID,
+		testSuite:   testSuite.ID,
+		testRun:     testRun.ID,
+		jsonSchema:  jsonSchema.ID,
+		environment: environment.ID,
+		secret:      secret.ID,
+		schedule:    schedule.ID,
+		webhook:     webhook.ID,
+		chatChannel: chatChannel.ID,
+	}
+}
+
+func (f *fixture) addMember(companyID, userID uuid.UUID, role entities.CompanyRole) {
+	member := entities.NewCompanyMember(companyID, userID, role)
+	f.store.members[[2]uuid.UUID{companyID, userID}] = member
+}
+
+// do executa a requisição autenticada como o usuário
+func (f *fixture) do(userID uuid.UUID, req request) *httptest.ResponseRecorder {
+	httpReq := httptest.NewRequest(req.method, req.path, strings.NewReader(req.body))
+	httpReq.Header.Set("Authorization", "Bearer "+userID.String())
+	if req.body != "" {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	recorder := httptest.NewRecorder()
+	f.router.ServeHTTP(recorder, httpReq)
+	return recorder
+}
+
+type request struct {
+	method string
+	path   string
+	body   string
+}
+
+func get(format string, args ...interface{}) request {
+	return request{method: http.MethodGet, path: fmt.Sprintf(format, args...)}
+}
+
+func del(format string, args ...interface{}) request {
+	return request{method: http.MethodDelete, path: fmt.Sprintf(format, args...)}
+}
+
+func post(body, format string, args ...interface{}) request {
+	return request{method: http.MethodPost, path: fmt.Sprintf(format, args...), body: body}
+}
+
+func put(body, format string, args ...interface{}) request {
+	return request{method: http.MethodPut, path: fmt.Sprintf(format, args...), body: body}
+}
+
+// routeCase descreve uma rota de empresa: o papel mínimo que a acessa e o status esperado para ele
+type routeCase struct {
+	name    string
+	minRole entities.CompanyRole
+	status  int
+	request func(ids companyIDs) request
+}
+
+var companyRoutes = []routeCase{
+	// Empresa
+	{"get company", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s", ids.company)
+	}},
+	{"update company", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"name":"Renamed"}`, "/api/companies/%s", ids.company)
+	}},
+	{"delete company", entities.CompanyRoleOwner, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/companies/%s", ids.company)
+	}},
+
+	// Membros
+	{"list members", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/members", ids.company)
+	}},
+	{"add member", entities.CompanyRoleAdmin, http.StatusCreated, func(ids companyIDs) request {
+		return post(fmt.Sprintf(`{"user_id":"%s","role":"viewer"}`, ids.candidate), "/api/companies/%s/members", ids.company)
+	}},
+	{"update member role", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"role":"editor"}`, "/api/companies/%s/members/%s", ids.company, ids.viewer)
+	}},
+	{"remove member", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/companies/%s/members/%s", ids.company, ids.viewer)
+	}},
+
+	// Ambientes
+	{"create environment", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(`{"name":"staging","variables":{"baseUrl":"https://staging.example.com"}}`, "/api/companies/%s/environments", ids.company)
+	}},
+	{"list environments", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/environments", ids.company)
+	}},
+	{"get environment", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/environments/%s", ids.company, ids.environment)
+	}},
+	{"update environment", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"name":"production-eu"}`, "/api/companies/%s/environments/%s", ids.company, ids.environment)
+	}},
+	{"delete environment", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/companies/%s/environments/%s", ids.company, ids.environment)
+	}},
+
+	// Segredos
+	{"create secret", entities.CompanyRoleAdmin, http.StatusCreated, func(ids companyIDs) request {
+		return post(`{"name":"OTHER_TOKEN","value":"value"}`, "/api/companies/%s/secrets", ids.company)
+	}},
+	{"list secrets", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/secrets", ids.company)
+	}},
+	{"get secret", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/secrets/%s", ids.company, ids.secret)
+	}},
+	{"update secret", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"value":"rotated"}`, "/api/companies/%s/secrets/%s", ids.company, ids.secret)
+	}},
+	{"delete secret", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/companies/%s/secrets/%s", ids.company, ids.secret)
+	}},
+
+	// Agendamentos
+	{"create schedule", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(`{"name":"hourly","cron_expression":"0 * * * *"}`, "/api/companies/%s/schedules", ids.company)
+	}},
+	{"list schedules", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/schedules", ids.company)
+	}},
+	{"get schedule", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/schedules/%s", ids.company, ids.schedule)
+	}},
+	{"update schedule", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"cron_expression":"0 4 * * *"}`, "/api/companies/%s/schedules/%s", ids.company, ids.schedule)
+	}},
+	{"delete schedule", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/companies/%s/schedules/%s", ids.company, ids.schedule)
+	}},
+
+	// Importações e pacotes de suítes
+	{"import postman", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(`{"info":{"name":"API","schema":"https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},"item":[{"name":"Health","request":{"method":"GET","url":"https://api.example.com/health"}}]}`,
+			"/api/companies/%s/imports/postman", ids.company)
+	}},
+	{"import openapi", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(`{"openapi":"3.0.0","info":{"title":"API","version":"1"},"servers":[{"url":"https://api.example.com"}],"paths":{"/health":{"get":{"operationId":"health","responses":{"200":{"description":"ok"}}}}}}`,
+			"/api/companies/%s/imports/openapi", ids.company)
+	}},
+	{"import har", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(`{"log":{"version":"1.2","entries":[{"request":{"method":"GET","url":"https://api.example.com/health","headers":[]},"response":{"status":200}}]}}`,
+			"/api/companies/%s/imports/har", ids.company)
+	}},
+	{"import curl", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(`curl https://api.example.com/health`, "/api/companies/%s/imports/curl", ids.company)
+	}},
+	{"export bundle", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/companies/%s/test-suites/export", ids.company)
+	}},
+	{"import bundle", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return post(`{"version":1,"test_suites":[{"name":"Bundled health","method":"GET","url":"https://api.example.com/health","expected_status":200}]}`,
+			"/api/companies/%s/test-suites/import", ids.company)
+	}},
+
+	// Suítes de teste
+	{"create test suite", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(fmt.Sprintf(`{"company_id":"%s","name":"Create user","method":"POST","url":"https://api.example.com/users","expected_status":201}`, ids.company),
+			"/api/test-suites")
+	}},
+	{"get test suite", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-suites/%s", ids.testSuite)
+	}},
+	{"update test suite", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"expected_status":204}`, "/api/test-suites/%s", ids.testSuite)
+	}},
+	{"delete test suite", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/test-suites/%s", ids.testSuite)
+	}},
+	{"list test suites", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-suites?company_id=%s", ids.company)
+	}},
+	{"list test suites by company", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-suites/company/%s", ids.company)
+	}},
+
+	// Execuções
+	{"create test run", entities.CompanyRoleEditor, http.StatusAccepted, func(ids companyIDs) request {
+		return post(fmt.Sprintf(`{"company_id":"%s"}`, ids.company), "/api/test-runs")
+	}},
+	{"get test run", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-runs/%s", ids.testRun)
+	}},
+	{"get test run results", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-runs/%s/results", ids.testRun)
+	}},
+	{"get test run report", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-runs/%s/report", ids.testRun)
+	}},
+	{"get test run html report", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/test-runs/%s/report.html", ids.testRun)
+	}},
+	{"cancel test run", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return post("", "/api/test-runs/%s/cancel", ids.testRun)
+	}},
+
+	// JSON Schemas
+	{"create json schema", entities.CompanyRoleEditor, http.StatusCreated, func(ids companyIDs) request {
+		return post(fmt.Sprintf(`{"company_id":"%s","name":"User response","schema":{"type":"object"}}`, ids.company), "/api/json-schemas")
+	}},
+	{"get json schema", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/json-schemas/%s", ids.jsonSchema)
+	}},
+	{"update json schema", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"description":"Updated"}`, "/api/json-schemas/%s", ids.jsonSchema)
+	}},
+	{"delete json schema", entities.CompanyRoleEditor, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/json-schemas/%s", ids.jsonSchema)
+	}},
+	{"list json schemas", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/json-schemas?company_id=%s", ids.company)
+	}},
+
+	// Webhooks
+	{"create webhook", entities.CompanyRoleAdmin, http.StatusCreated, func(ids companyIDs) request {
+		return post(fmt.Sprintf(`{"company_id":"%s","url":"https://hooks.example.com/new"}`, ids.company), "/api/webhooks")
+	}},
+	{"get webhook", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/webhooks/%s", ids.webhook)
+	}},
+	{"update webhook", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"enabled":false}`, "/api/webhooks/%s", ids.webhook)
+	}},
+	{"delete webhook", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/webhooks/%s", ids.webhook)
+	}},
+	{"list webhooks by company", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/webhooks/company/%s", ids.company)
+	}},
+	{"list webhook deliveries", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/webhooks/%s/deliveries", ids.webhook)
+	}},
+	{"send webhook test event", entities.CompanyRoleAdmin, http.StatusAccepted, func(ids companyIDs) request {
+		return post("", "/api/webhooks/%s/test", ids.webhook)
+	}},
+
+	// Canais de chat
+	{"create chat channel", entities.CompanyRoleAdmin, http.StatusCreated, func(ids companyIDs) request {
+		return post(fmt.Sprintf(`{"company_id":"%s","name":"#alerts","provider":"slack","webhook_url":"https://hooks.slack.com/services/T000/B000/XXXX"}`, ids.company),
+			"/api/chat-channels")
+	}},
+	{"get chat channel", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/chat-channels/%s", ids.chatChannel)
+	}},
+	{"update chat channel", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return put(`{"only_failures":true}`, "/api/chat-channels/%s", ids.chatChannel)
+	}},
+	{"delete chat channel", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return del("/api/chat-channels/%s", ids.chatChannel)
+	}},
+	{"list chat channels by company", entities.CompanyRoleViewer, http.StatusOK, func(ids companyIDs) request {
+		return get("/api/chat-channels/company/%s", ids.company)
+	}},
+	{"send chat channel test message", entities.CompanyRoleAdmin, http.StatusOK, func(ids companyIDs) request {
+		return post("", "/api/chat-channels/%s/test", ids.chatChannel)
+	}},
+}
+
+// TestCompanyRoutesRoleMatrix executa cada rota de empresa com cada papel: papéis com a permissão recebem o
+// status de sucesso, papéis sem ela recebem 403 e quem não é membro recebe 404
+func TestCompanyRoutesRoleMatrix(t *testing.T) {
+	for _, route := range companyRoutes {
+		for _, role := range roles {
+			t.Run(route.name+"/"+role, func(t *testing.T) {
+				f := newFixture(t)
+
+				want := http.StatusNotFound
+				if rank, member := roleRank[role]; member {
+					want = http.StatusForbidden
+					if rank >= roleRank[string(route.minRole)] {
+						want = route.status
+					}
+				}
+
+				req := route.request(f.home)
+				res := f.do(f.users[role], req)
+				if res.Code != want {
+					t.Fatalf("%s %s as %s: got status %d, want %d (body: %s)", req.method, req.path, role, res.Code, want, res.Body.String())
+				}
+			})
+		}
+	}
+}
+
+// TestCompanyRoutesRejectOtherCompanyIDs usa IDs de outra empresa: seja a empresa da rota, seja apenas o
+// recurso aninhado na empresa do usuário, o recurso não existe para ele
+func TestCompanyRoutesRejectOtherCompanyIDs(t *testing.T) {
+	for _, route := range companyRoutes {
+		t.Run(route.name+"/other company", func(t *testing.T) {
+			f := newFixture(t)
+
+			req := route.request(f.other)
+			res := f.do(f.users[string(entities.CompanyRoleOwner)], req)
+			if res.Code != http.StatusNotFound {
+				t.Fatalf("%s %s: got status %d, want %d (body: %s)", req.method, req.path, res.Code, http.StatusNotFound, res.Body.String())
+			}
+		})
+	}
+
+	// Recursos aninhados de outra empresa sob a empresa da qual o usuário é owner. Usuários não pertencem
+	// a empresas, então o candidato a membro continua o mesmo
+	nested := func(f *fixture) companyIDs {
+		ids := f.other
+		ids.company = f.home.company
+		ids.candidate = f.home.candidate
+		return ids
+	}
+	f := newFixture(t)
+	for _, route := range companyRoutes {
+		if route.request(nested(f)) == route.request(f.home) {
+			continue
+		}
+		t.Run(route.name+"/nested other company", func(t *testing.T) {
+			f := newFixture(t)
+
+			req := route.request(nested(f))
+			res := f.do(f.users[string(entities.CompanyRoleOwner)], req)
+			if res.Code != http.StatusNotFound {
+				t.Fatalf("%s %s: got status %d, want %d (body: %s)", req.method, req.path, res.Code, http.StatusNotFound, res.Body.String())
+			}
+		})
+	}
+}
+
+// TestCompanyRoutesRejectOtherCompanyReferences cria recursos da empresa do usuário que referenciam
+// recursos de outra empresa
+func TestCompanyRoutesRejectOtherCompanyReferences(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		request func(home, other companyIDs) request
+	}{
+		{"schedule with other company test suite", http.StatusNotFound, func(home, other companyIDs) request {
+			return post(fmt.Sprintf(`{"name":"cross","cron_expression":"0 * * * *","test_suite_ids":["%s"]}`, other.testSuite),
+				"/api/companies/%s/schedules", home.company)
+		}},
+		{"schedule with other company environment", http.StatusNotFound, func(home, other companyIDs) request {
+			return post(fmt.Sprintf(`{"name":"cross","cron_expression":"0 * * * *","environment_id":"%s"}`, other.environment),
+				"/api/companies/%s/schedules", home.company)
+		}},
+		{"test run with other company test suite", http.StatusNotFound, func(home, other companyIDs) request {
+			return post(fmt.Sprintf(`{"company_id":"%s","test_suite_ids":["%s"]}`, home.company, other.testSuite), "/api/test-runs")
+		}},
+		{"test run with other company environment", http.StatusNotFound, func(home, other companyIDs) request {
+			return post(fmt.Sprintf(`{"company_id":"%s","environment_id":"%s"}`, home.company, other.environment), "/api/test-runs")
+		}},
+		{"test suite with other company json schema", http.StatusNotFound, func(home, other companyIDs) request {
+			return post(fmt.Sprintf(`{"company_id":"%s","name":"Cross schema","method":"GET","url":"https://api.example.com/health","expected_status":200,"response_schema_id":"%s"}`, home.company, other.jsonSchema),
+				"/api/test-suites")
+		}},
+		{"test suite depending on other company test suite", http.StatusNotFound, func(home, other companyIDs) request {
+			return post(fmt.Sprintf(`{"company_id":"%s","name":"Cross dependency","method":"GET","url":"https://api.example.com/health","expected_status":200,"depends_on":["%s"]}`, home.company, other.testSuite),
+				"/api/test-suites")
+		}},
+		{"test suite updated with other company json schema", http.StatusNotFound, func(home, other companyIDs) request {
+			return put(fmt.Sprintf(`{"response_schema_id":"%s"}`, other.jsonSchema), "/api/test-suites/%s", home.testSuite)
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFixture(t)
+
+			req := tc.request(f.home, f.other)
+			res := f.do(f.users[string(entities.CompanyRoleOwner)], req)
+			if res.Code != tc.status {
+				t.Fatalf("%s %s: got status %d, want %d (body: %s)", req.method, req.path, res.Code, tc.status, res.Body.String())
+			}
+		})
+	}
+}
+
+// TestUserRoutesOnlyExposeUsersSharingACompany garante que a listagem e a busca de usuários não
+// revelam quem não compartilha nenhuma empresa com o usuário autenticado
+func TestUserRoutesOnlyExposeUsersSharingACompany(t *testing.T) {
+	f := newFixture(t)
+	owner := f.users[string(entities.CompanyRoleOwner)]
+
+	res := f.do(owner, get("/api/users/%s", f.users[string(entities.CompanyRoleViewer)]))
+	if res.Code != http.StatusOK {
+		t.Fatalf("get co-member: got status %d, want %d", res.Code, http.StatusOK)
+	}
+
+	for _, name := range []string{outsider, "candidate"} {
+		res := f.do(owner, get("/api/users/%s", f.users[name]))
+		if res.Code != http.StatusNotFound {
+			t.Fatalf("get %s: got status %d, want %d", name, res.Code, http.StatusNotFound)
+		}
+	}
+
+	res = f.do(owner, get("/api/users"))
+	if res.Code != http.StatusOK {
+		t.Fatalf("list users: got status %d, want %d", res.Code, http.StatusOK)
+	}
+	var list services.ListUsersResponse
+	if err := json.Unmarshal(res.Body.Bytes(), &list); err != nil {
+		t.Fatalf("failed to decode users: %v", err)
+	}
+	if list.Total != 4 || len(list.Users) != 4 {
+		t.Fatalf("list users: got %d users (total %d), want the 4 members of the company", len(list.Users), list.Total)
+	}
+	for _, user := range list.Users {
+		if _, member := roleRank[user.Username]; !member {
+			t.Fatalf("list users: unexpected user %s", user.Username)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,9 +12,26 @@ type Authenticator interface {
 	Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error)
 }
 
+// TokenRefresher define a renovação dos tokens a partir de um refresh token
+type TokenRefresher interface {
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*LoginResponse, error)
+}
+
 // TokenValidator define operações de validação de token
 type TokenValidator interface {
-	ValidateJWT(tokenString string) (*uuid.UUID, error)
+	ValidateJWT(ctx context.Context, tokenString string) (*TokenIdentity, error)
+}
+
+// SessionTerminator define o encerramento de sessões, revogando os tokens antes da expiração
+type SessionTerminator interface {
+	Logout(ctx context.Context, accessToken string) error
+	LogoutAll(ctx context.Context, userID uuid.UUID) error
+}
+
+// SessionManager define a consulta e o encerramento das sessões do usuário
+type SessionManager interface {
+	ListSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]*SessionResponse, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
 }
 
 // UserRegistrar define operações de registro de usuário
@@ -24,21 +42,55 @@ type UserRegistrar interface {
 // AuthService combina todas as operações de autenticação
 type AuthService interface {
 	Authenticator
+	TokenRefresher
 	TokenValidator
+	SessionTerminator
+	SessionManager
 	UserRegistrar
 }
 
-// LoginRequest representa uma solicitação de login
+// LoginRequest representa uma solicitação de login. UserAgent e IPAddress identificam
+// o dispositivo da sessão criada.
 type LoginRequest struct {
-	Username string `json:"username" validate:"required"`
-	Password string `json:"password" validate:"required"`
+	Username  string `json:"username" validate:"required"`
+	Password  string `json:"password" validate:"required"`
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+// RefreshTokenRequest representa a renovação dos tokens de uma sessão
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+	UserAgent    string `json:"-"`
+	IPAddress    string `json:"-"`
+}
+
+// TokenIdentity identifica o usuário e a sessão de um access token válido
+type TokenIdentity struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+}
+
+// SessionResponse representa uma sessão ativa do usuário. Current indica a sessão
+// do token usado na requisição.
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserAgent  string    `json:"user_agent" example:"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)"`
+	IPAddress  string    `json:"ip_address" example:"203.0.113.10"`
+	CreatedAt  time.Time `json:"created_at" example:"2023-01-01T00:00:00Z"`
+	LastUsedAt time.Time `json:"last_used_at" example:"2023-01-01T12:00:00Z"`
+	ExpiresAt  time.Time `json:"expires_at" example:"2023-01-08T12:00:00Z"`
+	Current    bool      `json:"current" example:"true"`
 }
 
-// LoginResponse representa a resposta de login
+// LoginResponse representa a resposta de login e de renovação dos tokens.
+// O refresh token é de uso único: cada renovação devolve um novo.
 type LoginResponse struct {
-	Token     string       `json:"token"`
-	User      UserResponse `json:"user"`
-	ExpiresAt int64        `json:"expires_at"`
+	Token        string       `json:"token"`
+	RefreshToken string       `json:"refresh_token"`
+	User         UserResponse `json:"user"`
+	ExpiresIn    int64        `json:"expires_in"`
+	ExpiresAt    int64        `json:"expires_at"`
 }
 
 // RegisterRequest representa uma solicitação de registro
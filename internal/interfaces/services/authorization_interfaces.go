@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// Authorizer define a verificação das permissões do usuário autenticado dentro de uma empresa.
+// Os erros envolvem errors.ErrNotFound quando o usuário não é membro da empresa e
+// errors.ErrForbidden quando o papel dele não concede a permissão.
+type Authorizer interface {
+	// Authorize verifica se o usuário do contexto (WithActor) tem a permissão na empresa
+	Authorize(ctx context.Context, companyID uuid.UUID, permission entities.Permission) (*entities.CompanyMember, error)
+	// ResourceCompanyID retorna a empresa dona do recurso. Recursos de empresas das quais o usuário
+	// do contexto não é membro são tratados como inexistentes (errors.ErrNotFound)
+	ResourceCompanyID(ctx context.Context, resource entities.ResourceType, id uuid.UUID) (uuid.UUID, error)
+}
+
+// actorKey é a chave do usuário autenticado no contexto da requisição
+type actorKey struct{}
+
+// WithActor retorna uma cópia do contexto com o usuário autenticado que executa a operação
+func WithActor(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+// ActorFromContext retorna o usuário autenticado gravado por WithActor
+func ActorFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(actorKey{}).(uuid.UUID)
+	return userID, ok && userID != uuid.Nil
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// BundleVersion é a versão do formato do pacote de suítes gerado pela exportação
+const BundleVersion = 1
+
+// BundleService define a exportação e a importação das suítes da empresa como um pacote portátil,
+// para versionamento em repositórios git
+type BundleService interface {
+	Export(ctx context.Context, companyID uuid.UUID) (*TestSuiteBundle, error)
+	Import(ctx context.Context, req *ImportBundleRequest) (*BundleDiff, error)
+}
+
+// TestSuiteBundle representa as suítes, asserções e ambientes de uma empresa.
+// As suítes e os ambientes são identificados pelo nome; segredos não são exportados.
+type TestSuiteBundle struct {
+	Version      int                 `json:"version" yaml:"version" example:"1"`
+	TestSuites   []BundleTestSuite   `json:"test_suites" yaml:"test_suites"`
+	Environments []BundleEnvironment `json:"environments" yaml:"environments"`
+}
+
+// BundleTestSuite representa uma suíte do pacote. DependsOn lista os nomes das suítes das quais
+// ela depende e ResponseSchemaRef o nome de um JSON Schema armazenado na empresa.
+type BundleTestSuite struct {
+	Name              string                   `json:"name" yaml:"name" example:"API Login Test"`
+	Method            string                   `json:"method" yaml:"method" example:"POST"`
+	URL               string                   `json:"url" yaml:"url" example:"{{baseUrl}}/login"`
+	Headers           map[string]string        `json:"headers,omitempty" yaml:"headers,omitempty"`
+	QueryParams       map[string]string        `json:"query_params,omitempty" yaml:"query_params,omitempty"`
+	BodyType          entities.RequestBodyType `json:"body_type,omitempty" yaml:"body_type,omitempty" example:"json"`
+	Body              string                   `json:"body,omitempty" yaml:"body,omitempty"`
+	FormFields        map[string]string        `json:"form_fields,omitempty" yaml:"form_fields,omitempty"`
+	ExpectedStatus    int                      `json:"expected_status" yaml:"expected_status" example:"200"`
+	ExpectedBody      string                   `json:"expected_body,omitempty" yaml:"expected_body,omitempty"`
+	ResponseSchema    interface{}              `json:"response_schema,omitempty" yaml:"response_schema,omitempty" swaggertype:"object"`
+	ResponseSchemaRef string                   `json:"response_schema_ref,omitempty" yaml:"response_schema_ref,omitempty" example:"User response"`
+	ImportKey         string                   `json:"import_key,omitempty" yaml:"import_key,omitempty" example:"openapi:createUser"`
+	Assertions        []BundleAssertion        `json:"assertions,omitempty" yaml:"assertions,omitempty"`
+	Extractions       []BundleExtraction       `json:"extractions,omitempty" yaml:"extractions,omitempty"`
+	DependsOn         []string                 `json:"depends_on,omitempty" yaml:"depends_on,omitempty"`
+}
+
+// BundleAssertion representa uma asserção do pacote, na ordem de execução
+type BundleAssertion struct {
+	Type     entities.AssertionType `json:"type" yaml:"type" example:"json_path_equals"`
+	Target   string                 `json:"target,omitempty" yaml:"target,omitempty" example:"$.user.id"`
+	Expected string                 `json:"expected,omitempty" yaml:"expected,omitempty" example:"42"`
+}
+
+// BundleExtraction representa uma regra de extração do pacote, na ordem de execução
+type BundleExtraction struct {
+	Name       string                    `json:"name" yaml:"name" example:"token"`
+	Source     entities.ExtractionSource `json:"source" yaml:"source" example:"json_path"`
+	Expression string                    `json:"expression" yaml:"expression" example:"$.access_token"`
+}
+
+// BundleEnvironment representa um ambiente do pacote
+type BundleEnvironment struct {
+	Name      string            `json:"name" yaml:"name" example:"staging"`
+	Variables map[string]string `json:"variables" yaml:"variables"`
+}
+
+// ImportBundleRequest representa a importação de um pacote. Com DryRun, apenas as diferenças
+// são calculadas e nada é gravado.
+type ImportBundleRequest struct {
+	CompanyID uuid.UUID
+	Bundle    *TestSuiteBundle
+	DryRun    bool
+}
+
+// BundleDiff representa as diferenças entre o pacote e a empresa: o que foi (ou seria, em dry run)
+// criado, atualizado e removido. Suítes e ambientes ausentes do pacote são removidos.
+type BundleDiff struct {
+	DryRun       bool          `json:"dry_run" example:"true"`
+	TestSuites   BundleChanges `json:"test_suites"`
+	Environments BundleChanges `json:"environments"`
+}
+
+// BundleChanges lista as alterações de um tipo de item, pelo nome
+type BundleChanges struct {
+	Created   []string       `json:"created"`
+	Updated   []BundleChange `json:"updated"`
+	Deleted   []string       `json:"deleted"`
+	Unchanged int            `json:"unchanged" example:"3"`
+}
+
+// BundleChange descreve um item atualizado e os campos alterados
+type BundleChange struct {
+	Name   string   `json:"name" example:"API Login Test"`
+	Fields []string `json:"fields" example:"url,assertions"`
+}
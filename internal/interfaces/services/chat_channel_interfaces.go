@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ChatChannelReader define operações de leitura de canais de chat
+type ChatChannelReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.ChatChannel, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.ChatChannel, error)
+}
+
+// ChatChannelWriter define operações de escrita de canais de chat
+type ChatChannelWriter interface {
+	Create(ctx context.Context, req *CreateChatChannelRequest) (*entities.ChatChannel, error)
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateChatChannelRequest) (*entities.ChatChannel, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	SendTestMessage(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+// ChatChannelService combina todas as operações de canais de chat
+type ChatChannelService interface {
+	ChatChannelReader
+	ChatChannelWriter
+}
+
+// CreateChatChannelRequest representa uma solicitação de criação de canal de chat.
+// Enabled nil cria o canal ativo.
+type CreateChatChannelRequest struct {
+	CompanyID    uuid.UUID             `json:"company_id" validate:"required"`
+	Name         string                `json:"name" validate:"required,min=1,max=100"`
+	Provider     entities.ChatProvider `json:"provider" validate:"required"`
+	WebhookURL   string                `json:"webhook_url" validate:"required,url"`
+	OnlyFailures bool                  `json:"only_failures"`
+	Enabled      *bool                 `json:"enabled" validate:"omitempty"`
+}
+
+// UpdateChatChannelRequest representa uma solicitação de atualização de canal de chat.
+// Campos vazios ou nil mantêm os valores atuais.
+type UpdateChatChannelRequest struct {
+	Name         string `json:"name" validate:"omitempty,min=1,max=100"`
+	WebhookURL   string `json:"webhook_url" validate:"omitempty,url"`
+	OnlyFailures *bool  `json:"only_failures" validate:"omitempty"`
+	Enabled      *bool  `json:"enabled" validate:"omitempty"`
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// CompanyMemberService define a gestão dos membros de uma empresa e dos seus papéis.
+// Só owners concedem, alteram ou removem o papel de owner, e a empresa nunca fica sem owner.
+type CompanyMemberService interface {
+	List(ctx context.Context, companyID uuid.UUID) ([]*entities.CompanyMember, error)
+	Add(ctx context.Context, companyID uuid.UUID, req *AddCompanyMemberRequest) (*entities.CompanyMember, error)
+	UpdateRole(ctx context.Context, companyID, userID uuid.UUID, role entities.CompanyRole) (*entities.CompanyMember, error)
+	Remove(ctx context.Context, companyID, userID uuid.UUID) error
+}
+
+// AddCompanyMemberRequest representa a inclusão de um usuário existente na empresa
+type AddCompanyMemberRequest struct {
+	UserID uuid.UUID            `json:"user_id" validate:"required"`
+	Role   entities.CompanyRole `json:"role" validate:"required"`
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// EnvironmentReader define operações de leitura de ambientes
+type EnvironmentReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Environment, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Environment, error)
+}
+
+// EnvironmentWriter define operações de escrita de ambientes
+type EnvironmentWriter interface {
+	Create(ctx context.Context, companyID uuid.UUID, req *CreateEnvironmentRequest) (*entities.Environment, error)
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateEnvironmentRequest) (*entities.Environment, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+// EnvironmentService combina todas as operações de ambiente
+type EnvironmentService interface {
+	EnvironmentReader
+	EnvironmentWriter
+}
+
+// CreateEnvironmentRequest representa uma solicitação de criação de ambiente
+type CreateEnvironmentRequest struct {
+	Name      string            `json:"name" validate:"required,min=1,max=100"`
+	Variables map[string]string `json:"variables" validate:"omitempty"`
+}
+
+// UpdateEnvironmentRequest representa uma solicitação de atualização de ambiente.
+// Variables nil mantém as variáveis atuais; um mapa (mesmo vazio) as substitui.
+type UpdateEnvironmentRequest struct {
+	Name      string            `json:"name" validate:"omitempty,min=1,max=100"`
+	Variables map[string]string `json:"variables" validate:"omitempty"`
+}
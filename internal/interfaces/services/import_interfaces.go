@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ImportService define operações de importação de coleções de outras ferramentas
+type ImportService interface {
+	ImportPostman(ctx context.Context, req *ImportRequest) (*ImportReport, error)
+	ImportOpenAPI(ctx context.Context, req *ImportRequest) (*ImportReport, error)
+	ImportHAR(ctx context.Context, req *ImportRequest) (*ImportReport, error)
+	ImportCurl(ctx context.Context, req *ImportRequest) (*ImportReport, error)
+}
+
+// ImportRequest representa a coleção enviada para importação.
+// EnvironmentName define o ambiente que recebe as variáveis da coleção; vazio usa o nome da coleção.
+type ImportRequest struct {
+	CompanyID       uuid.UUID
+	Data            []byte
+	EnvironmentName string
+}
+
+// ImportReport representa o resultado da importação: suítes criadas, suítes atualizadas por uma
+// reimportação, ambiente com as variáveis da coleção e itens que não puderam ser convertidos
+type ImportReport struct {
+	Source      string               `json:"source" example:"postman"`
+	Created     []ImportedTestSuite  `json:"created"`
+	Updated     []ImportedTestSuite  `json:"updated"`
+	Environment *ImportedEnvironment `json:"environment,omitempty"`
+	Skipped     []ImportIssue        `json:"skipped"`
+}
+
+// ImportedTestSuite resume uma suíte criada pela importação
+type ImportedTestSuite struct {
+	ID     uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name   string    `json:"name" example:"Users / Create user"`
+	Method string    `json:"method" example:"POST"`
+	URL    string    `json:"url" example:"{{baseUrl}}/users"`
+}
+
+// ImportedEnvironment resume o ambiente que recebeu as variáveis da coleção.
+// Variáveis já existentes no ambiente mantêm o valor atual.
+type ImportedEnvironment struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name      string    `json:"name" example:"My API"`
+	Created   bool      `json:"created" example:"true"`
+	Variables []string  `json:"variables"`
+}
+
+// ImportIssue descreve um item ignorado ou importado parcialmente
+type ImportIssue struct {
+	Item   string `json:"item" example:"Users / Create user"`
+	Reason string `json:"reason" example:"pre-request script was not imported"`
+}
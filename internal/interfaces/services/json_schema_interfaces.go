@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// JSONSchemaReader define operações de leitura de JSON Schemas
+type JSONSchemaReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.JSONSchema, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.JSONSchema, error)
+}
+
+// JSONSchemaWriter define operações de escrita de JSON Schemas
+type JSONSchemaWriter interface {
+	Create(ctx context.Context, req *CreateJSONSchemaRequest) (*entities.JSONSchema, error)
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateJSONSchemaRequest) (*entities.JSONSchema, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+// JSONSchemaService combina todas as operações de JSON Schema
+type JSONSchemaService interface {
+	JSONSchemaReader
+	JSONSchemaWriter
+}
+
+// CreateJSONSchemaRequest representa uma solicitação de criação de JSON Schema
+type CreateJSONSchemaRequest struct {
+	CompanyID   uuid.UUID       `json:"company_id" validate:"required"`
+	Name        string          `json:"name" validate:"required,min=3,max=100"`
+	Description string          `json:"description" validate:"omitempty,max=500"`
+	Schema      json.RawMessage `json:"schema" validate:"required"`
+}
+
+// UpdateJSONSchemaRequest representa uma solicitação de atualização de JSON Schema
+type UpdateJSONSchemaRequest struct {
+	Name        string          `json:"name" validate:"omitempty,min=3,max=100"`
+	Description string          `json:"description" validate:"omitempty,max=500"`
+	Schema      json.RawMessage `json:"schema" validate:"omitempty"`
+}
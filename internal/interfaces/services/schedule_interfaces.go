@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleReader define operações de leitura de agendamentos
+type ScheduleReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Schedule, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Schedule, error)
+}
+
+// ScheduleWriter define operações de escrita de agendamentos
+type ScheduleWriter interface {
+	Create(ctx context.Context, companyID uuid.UUID, req *CreateScheduleRequest) (*entities.Schedule, error)
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateScheduleRequest) (*entities.Schedule, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+// ScheduleService combina todas as operações de agendamento
+type ScheduleService interface {
+	ScheduleReader
+	ScheduleWriter
+}
+
+// CreateScheduleRequest representa uma solicitação de criação de agendamento.
+// TestSuiteIDs vazio executa todas as suítes da empresa; Enabled nil cria o agendamento ativo.
+type CreateScheduleRequest struct {
+	Name           string      `json:"name" validate:"required,min=1,max=100"`
+	CronExpression string      `json:"cron_expression" validate:"required"`
+	Timezone       string      `json:"timezone" validate:"omitempty"`
+	TestSuiteIDs   []uuid.UUID `json:"test_suite_ids" validate:"omitempty"`
+	EnvironmentID  *uuid.UUID  `json:"environment_id" validate:"omitempty"`
+	Enabled        *bool       `json:"enabled" validate:"omitempty"`
+}
+
+// UpdateScheduleRequest representa uma solicitação de atualização de agendamento.
+// Campos vazios ou nil mantêm os valores atuais; EnvironmentID uuid.Nil remove o ambiente.
+type UpdateScheduleRequest struct {
+	Name           string       `json:"name" validate:"omitempty,min=1,max=100"`
+	CronExpression string       `json:"cron_expression" validate:"omitempty"`
+	Timezone       string       `json:"timezone" validate:"omitempty"`
+	TestSuiteIDs   *[]uuid.UUID `json:"test_suite_ids" validate:"omitempty"`
+	EnvironmentID  *uuid.UUID   `json:"environment_id" validate:"omitempty"`
+	Enabled        *bool        `json:"enabled" validate:"omitempty"`
+}
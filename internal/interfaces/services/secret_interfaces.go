@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// SecretReader define operações de leitura de segredos (somente metadados, nunca o valor)
+type SecretReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Secret, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Secret, error)
+}
+
+// SecretWriter define operações de escrita de segredos
+type SecretWriter interface {
+	Create(ctx context.Context, companyID uuid.UUID, req *CreateSecretRequest) (*entities.Secret, error)
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateSecretRequest) (*entities.Secret, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+}
+
+// SecretService combina todas as operações de segredo
+type SecretService interface {
+	SecretReader
+	SecretWriter
+}
+
+// CreateSecretRequest representa uma solicitação de criação de segredo
+type CreateSecretRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=100"`
+	Value string `json:"value" validate:"required"`
+}
+
+// UpdateSecretRequest representa uma solicitação de atualização de segredo.
+// Value nil mantém o valor atual; informado, substitui (rotaciona) o valor.
+type UpdateSecretRequest struct {
+	Name  string  `json:"name" validate:"omitempty,min=1,max=100"`
+	Value *string `json:"value" validate:"omitempty"`
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// TestRunReader define operações de leitura de execuções de teste. As execuções são sempre buscadas
+// dentro da empresa informada; as de outras empresas respondem "not found".
+type TestRunReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error)
+	GetResults(ctx context.Context, companyID, id uuid.UUID) ([]*entities.TestResult, error)
+	GetReport(ctx context.Context, companyID, id uuid.UUID) (*TestRunReport, error)
+}
+
+// TestRunWriter define operações de escrita de execuções de teste
+type TestRunWriter interface {
+	Create(ctx context.Context, req *CreateTestRunRequest) (*entities.TestRun, error)
+	Cancel(ctx context.Context, companyID, id uuid.UUID) (*entities.TestRun, error)
+}
+
+// TestRunService combina todas as operações de execução de teste
+type TestRunService interface {
+	TestRunReader
+	TestRunWriter
+}
+
+// CreateTestRunRequest representa uma solicitação de execução de suítes de teste
+type CreateTestRunRequest struct {
+	CompanyID     uuid.UUID   `json:"company_id" validate:"required"`
+	TestSuiteIDs  []uuid.UUID `json:"test_suite_ids" validate:"omitempty"`
+	EnvironmentID *uuid.UUID  `json:"environment_id" validate:"omitempty"`
+}
+
+// TestRunReport representa o relatório de uma execução, com um caso de teste por suíte executada,
+// usado na geração dos formatos JUnit, TAP e JSON
+type TestRunReport struct {
+	TestRun   *entities.TestRun `json:"test_run"`
+	TimeMS    int               `json:"time_ms" example:"840"`
+	TestCases []TestCaseReport  `json:"test_cases"`
+}
+
+// TestCaseReport representa o resultado de uma suíte no relatório. Failures reúne a mensagem
+// de erro e as violações de schema; ResponseSnippet é o início do corpo da resposta.
+// A requisição é a enviada na execução, com as variáveis substituídas e os segredos ocultados.
+type TestCaseReport struct {
+	TestSuiteID     uuid.UUID                  `json:"test_suite_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Name            string                     `json:"name" example:"API Login Test"`
+	Method          string                     `json:"method,omitempty" example:"POST"`
+	URL             string                     `json:"url,omitempty" example:"https://api.example.com/login"`
+	RequestHeaders  map[string]string          `json:"request_headers,omitempty"`
+	QueryParams     map[string]string          `json:"query_params,omitempty"`
+	RequestBody     string                     `json:"request_body,omitempty" example:"{\"username\": \"admin\"}"`
+	FormFields      map[string]string          `json:"form_fields,omitempty"`
+	Status          entities.TestResultStatus  `json:"status" example:"failed"`
+	ResponseStatus  int                        `json:"response_status" example:"401"`
+	ResponseHeaders map[string][]string        `json:"response_headers,omitempty"`
+	TimeMS          int                        `json:"time_ms" example:"120"`
+	Assertions      []entities.AssertionResult `json:"assertions,omitempty"`
+	Failures        []string                   `json:"failures,omitempty"`
+	ResponseSnippet string                     `json:"response_snippet,omitempty" example:"{\"error\": \"invalid credentials\"}"`
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"TestGO/internal/domain/entities"
@@ -9,9 +10,10 @@ import (
 	"github.com/google/uuid"
 )
 
-// TestSuiteReader define operações de leitura de suítes de teste
+// TestSuiteReader define operações de leitura de suítes de teste. As suítes são sempre buscadas
+// dentro da empresa informada; as de outras empresas respondem "not found".
 type TestSuiteReader interface {
-	GetByID(ctx context.Context, id uuid.UUID) (*entities.TestSuite, error)
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.TestSuite, error)
 	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.TestSuite, error)
 	List(ctx context.Context, req *ListTestSuitesRequest) (*ListTestSuitesResponse, error)
 }
@@ -19,8 +21,8 @@ type TestSuiteReader interface {
 // TestSuiteWriter define operações de escrita de suítes de teste
 type TestSuiteWriter interface {
 	Create(ctx context.Context, req *CreateTestSuiteRequest) (*entities.TestSuite, error)
-	Update(ctx context.Context, id uuid.UUID, req *UpdateTestSuiteRequest) (*entities.TestSuite, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateTestSuiteRequest) (*entities.TestSuite, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
 }
 
 // TestSuiteService combina todas as operações de suíte de teste
@@ -31,28 +33,65 @@ type TestSuiteService interface {
 
 // CreateTestSuiteRequest representa uma solicitação de criação de suíte de teste
 type CreateTestSuiteRequest struct {
-	CompanyID      uuid.UUID `json:"company_id" validate:"required"`
-	Name           string    `json:"name" validate:"required,min=3,max=100"`
-	Method         string    `json:"method" validate:"required,oneof=GET POST PUT DELETE PATCH"`
-	URL            string    `json:"url" validate:"required,url"`
-	Headers        string    `json:"headers" validate:"omitempty"`
-	ExpectedStatus int       `json:"expected_status" validate:"required,min=100,max=599"`
-	ExpectedBody   string    `json:"expected_body" validate:"omitempty"`
+	CompanyID      uuid.UUID                `json:"company_id" validate:"required"`
+	Name           string                   `json:"name" validate:"required,min=3,max=100"`
+	Method         string                   `json:"method" validate:"required,oneof=GET POST PUT DELETE PATCH"`
+	URL            string                   `json:"url" validate:"required,url"`
+	Headers        map[string]string        `json:"headers" validate:"omitempty"`
+	QueryParams    map[string]string        `json:"query_params" validate:"omitempty"`
+	BodyType       entities.RequestBodyType `json:"body_type" validate:"omitempty"`
+	Body           string                   `json:"body" validate:"omitempty"`
+	FormFields     map[string]string        `json:"form_fields" validate:"omitempty"`
+	ExpectedStatus int                      `json:"expected_status" validate:"required,min=100,max=599"`
+	ExpectedBody   string                   `json:"expected_body" validate:"omitempty"`
+	Assertions     []AssertionRequest       `json:"assertions" validate:"omitempty,dive"`
+	Extractions    []ExtractionRequest      `json:"extractions" validate:"omitempty,dive"`
+	DependsOn      []uuid.UUID              `json:"depends_on" validate:"omitempty"`
+	// Schema da resposta: inline ou referência a um schema armazenado da empresa
+	ResponseSchema   json.RawMessage `json:"response_schema" validate:"omitempty"`
+	ResponseSchemaID *uuid.UUID      `json:"response_schema_id" validate:"omitempty"`
 }
 
 // UpdateTestSuiteRequest representa uma solicitação de atualização de suíte de teste
 type UpdateTestSuiteRequest struct {
-	Name           string `json:"name" validate:"omitempty,min=3,max=100"`
-	Method         string `json:"method" validate:"omitempty,oneof=GET POST PUT DELETE PATCH"`
-	URL            string `json:"url" validate:"omitempty,url"`
-	Headers        string `json:"headers" validate:"omitempty"`
-	ExpectedStatus int    `json:"expected_status" validate:"omitempty,min=100,max=599"`
-	ExpectedBody   string `json:"expected_body" validate:"omitempty"`
+	Name   string `json:"name" validate:"omitempty,min=3,max=100"`
+	Method string `json:"method" validate:"omitempty,oneof=GET POST PUT DELETE PATCH"`
+	URL    string `json:"url" validate:"omitempty,url"`
+	// Campos nil (ou body_type vazio) mantêm os valores atuais
+	Headers        map[string]string        `json:"headers" validate:"omitempty"`
+	QueryParams    map[string]string        `json:"query_params" validate:"omitempty"`
+	BodyType       entities.RequestBodyType `json:"body_type" validate:"omitempty"`
+	Body           *string                  `json:"body" validate:"omitempty"`
+	FormFields     map[string]string        `json:"form_fields" validate:"omitempty"`
+	ExpectedStatus int                      `json:"expected_status" validate:"omitempty,min=100,max=599"`
+	ExpectedBody   string                   `json:"expected_body" validate:"omitempty"`
+	// Assertions nil mantém as asserções atuais; uma lista vazia remove todas
+	Assertions *[]AssertionRequest `json:"assertions" validate:"omitempty"`
+	// Extractions e DependsOn nil mantêm os valores atuais; uma lista vazia remove todos
+	Extractions *[]ExtractionRequest `json:"extractions" validate:"omitempty"`
+	DependsOn   *[]uuid.UUID         `json:"depends_on" validate:"omitempty"`
+	// ResponseSchema nil mantém o atual e "null" o remove; ResponseSchemaID uuid.Nil remove a referência
+	ResponseSchema   json.RawMessage `json:"response_schema" validate:"omitempty"`
+	ResponseSchemaID *uuid.UUID      `json:"response_schema_id" validate:"omitempty"`
+}
+
+// AssertionRequest representa uma asserção tipada informada na criação ou atualização de suíte
+type AssertionRequest struct {
+	Type     entities.AssertionType `json:"type" validate:"required"`
+	Target   string                 `json:"target" validate:"omitempty"`
+	Expected string                 `json:"expected" validate:"omitempty"`
+}
+
+// ExtractionRequest representa uma regra de extração informada na criação ou atualização de suíte
+type ExtractionRequest struct {
+	Name       string                    `json:"name" validate:"required"`
+	Source     entities.ExtractionSource `json:"source" validate:"required"`
+	Expression string                    `json:"expression" validate:"required"`
 }
 
 // ListTestSuitesRequest representa uma solicitação de listagem de suítes de teste
 type ListTestSuitesRequest struct {
-	CompanyID uuid.UUID `json:"company_id" validate:"omitempty"`
+	CompanyID uuid.UUID `json:"company_id" validate:"required"`
 	Limit     int       `json:"limit" validate:"min=1,max=100"`
 	Offset    int       `json:"offset" validate:"min=0"`
 }
@@ -67,14 +106,23 @@ type ListTestSuitesResponse struct {
 
 // TestSuiteResponse representa a resposta completa de suíte de teste
 type TestSuiteResponse struct {
-	ID             uuid.UUID `json:"id"`
-	CompanyID      uuid.UUID `json:"company_id"`
-	Name           string    `json:"name"`
-	Method         string    `json:"method"`
-	URL            string    `json:"url"`
-	Headers        string    `json:"headers"`
-	ExpectedStatus int       `json:"expected_status"`
-	ExpectedBody   string    `json:"expected_body"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID               uuid.UUID                `json:"id"`
+	CompanyID        uuid.UUID                `json:"company_id"`
+	Name             string                   `json:"name"`
+	Method           string                   `json:"method"`
+	URL              string                   `json:"url"`
+	Headers          map[string]string        `json:"headers"`
+	QueryParams      map[string]string        `json:"query_params"`
+	BodyType         entities.RequestBodyType `json:"body_type"`
+	Body             string                   `json:"body"`
+	FormFields       map[string]string        `json:"form_fields"`
+	ExpectedStatus   int                      `json:"expected_status"`
+	ExpectedBody     string                   `json:"expected_body"`
+	ResponseSchema   json.RawMessage          `json:"response_schema,omitempty"`
+	ResponseSchemaID *uuid.UUID               `json:"response_schema_id,omitempty"`
+	Assertions       []*entities.Assertion    `json:"assertions"`
+	Extractions      []*entities.Extraction   `json:"extractions"`
+	DependsOn        []uuid.UUID              `json:"depends_on"`
+	CreatedAt        time.Time                `json:"created_at"`
+	UpdatedAt        time.Time                `json:"updated_at"`
 }
@@ -13,6 +13,10 @@ import (
 type UserReader interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.User, error)
 	GetByUsername(ctx context.Context, username string) (*entities.User, error)
+	// GetVisible busca um usuário que o usuário do contexto (WithActor) pode ver:
+	// ele mesmo ou alguém que compartilha uma empresa com ele
+	GetVisible(ctx context.Context, id uuid.UUID) (*entities.User, error)
+	// List lista apenas os usuários visíveis para o usuário do contexto (WithActor)
 	List(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error)
 }
 
@@ -40,6 +44,8 @@ type UpdateUserRequest struct {
 	Username string `json:"username" validate:"omitempty,min=3,max=50"`
 	Email    string `json:"email" validate:"omitempty,email"`
 	Name     string `json:"name" validate:"omitempty,min=2,max=100"`
+	// EmailAlerts ativa ou desativa os alertas por email; nil mantém o valor atual
+	EmailAlerts *bool `json:"email_alerts,omitempty"`
 }
 
 // ChangePasswordRequest representa uma solicitação de mudança de senha
@@ -64,11 +70,12 @@ type ListUsersResponse struct {
 
 // UserResponse representa a resposta completa de usuário
 type UserResponse struct {
-	ID        uuid.UUID  `json:"id"`
-	Username  string     `json:"username"`
-	Email     string     `json:"email"`
-	Name      string     `json:"name"`
-	CompanyID *uuid.UUID `json:"company_id"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	ID          uuid.UUID  `json:"id"`
+	Username    string     `json:"username"`
+	Email       string     `json:"email"`
+	Name        string     `json:"name"`
+	CompanyID   *uuid.UUID `json:"company_id"`
+	EmailAlerts bool       `json:"email_alerts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
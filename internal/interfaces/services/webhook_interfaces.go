@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+
+	"TestGO/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// WebhookReader define operações de leitura de webhooks
+type WebhookReader interface {
+	GetByID(ctx context.Context, companyID, id uuid.UUID) (*entities.Webhook, error)
+	GetByCompanyID(ctx context.Context, companyID uuid.UUID) ([]*entities.Webhook, error)
+	GetDeliveries(ctx context.Context, companyID, id uuid.UUID, limit int) ([]*entities.WebhookDelivery, error)
+}
+
+// WebhookWriter define operações de escrita de webhooks
+type WebhookWriter interface {
+	Create(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	Update(ctx context.Context, companyID, id uuid.UUID, req *UpdateWebhookRequest) (*entities.Webhook, error)
+	Delete(ctx context.Context, companyID, id uuid.UUID) error
+	SendTestEvent(ctx context.Context, companyID, id uuid.UUID) (*entities.WebhookDelivery, error)
+}
+
+// WebhookService combina todas as operações de webhook
+type WebhookService interface {
+	WebhookReader
+	WebhookWriter
+}
+
+// CreateWebhookRequest representa uma solicitação de criação de webhook.
+// Events vazio assina todos os eventos; Enabled nil cria o webhook ativo.
+type CreateWebhookRequest struct {
+	CompanyID uuid.UUID               `json:"company_id" validate:"required"`
+	URL       string                  `json:"url" validate:"required,url"`
+	Events    []entities.WebhookEvent `json:"events" validate:"omitempty"`
+	Enabled   *bool                   `json:"enabled" validate:"omitempty"`
+}
+
+// UpdateWebhookRequest representa uma solicitação de atualização de webhook.
+// Campos vazios ou nil mantêm os valores atuais.
+type UpdateWebhookRequest struct {
+	URL     string                  `json:"url" validate:"omitempty,url"`
+	Events  []entities.WebhookEvent `json:"events" validate:"omitempty"`
+	Enabled *bool                   `json:"enabled" validate:"omitempty"`
+}
+
+// CreateWebhookResponse representa o webhook criado com o segredo de assinatura,
+// devolvido somente nesta resposta
+type CreateWebhookResponse struct {
+	*entities.Webhook
+	Secret string `json:"secret" example:"whsec_3f1c..."`
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Resultados pertencem à execução e à suíte: removê-los junto com elas
+ALTER TABLE "test_results" DROP CONSTRAINT IF EXISTS "fk_test_results_test_run";
+ALTER TABLE "test_results" ADD CONSTRAINT "fk_test_results_test_run" FOREIGN KEY ("test_run_id") REFERENCES "test_runs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+ALTER TABLE "test_results" DROP CONSTRAINT IF EXISTS "fk_test_results_endpoint_test";
+ALTER TABLE "test_results" ADD CONSTRAINT "fk_test_results_endpoint_test" FOREIGN KEY ("endpoint_test_id") REFERENCES "test_suites" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+
+-- Índices para consultas de histórico
+CREATE INDEX IF NOT EXISTS "idx_test_runs_company_id" ON "test_runs" ("company_id", "created_at" DESC);
+CREATE INDEX IF NOT EXISTS "idx_test_results_test_run_id" ON "test_results" ("test_run_id");
+
+-- +goose Down
+DROP INDEX IF EXISTS "idx_test_results_test_run_id";
+DROP INDEX IF EXISTS "idx_test_runs_company_id";
+ALTER TABLE "test_results" DROP CONSTRAINT IF EXISTS "fk_test_results_endpoint_test";
+ALTER TABLE "test_results" ADD CONSTRAINT "fk_test_results_endpoint_test" FOREIGN KEY ("endpoint_test_id") REFERENCES "test_suites" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+ALTER TABLE "test_results" DROP CONSTRAINT IF EXISTS "fk_test_results_test_run";
+ALTER TABLE "test_results" ADD CONSTRAINT "fk_test_results_test_run" FOREIGN KEY ("test_run_id") REFERENCES "test_runs" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
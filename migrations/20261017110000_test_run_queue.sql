@@ -0,0 +1,10 @@
+-- +goose Up
+-- Suítes selecionadas para a execução (NULL = todas as suítes da empresa)
+ALTER TABLE "test_runs" ADD COLUMN IF NOT EXISTS "test_suite_ids" uuid[];
+
+-- Índice usado pelos workers para retirar execuções da fila
+CREATE INDEX IF NOT EXISTS "idx_test_runs_status_created_at" ON "test_runs" ("status", "created_at");
+
+-- +goose Down
+DROP INDEX IF EXISTS "idx_test_runs_status_created_at";
+ALTER TABLE "test_runs" DROP COLUMN IF EXISTS "test_suite_ids";
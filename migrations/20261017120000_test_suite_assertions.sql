@@ -0,0 +1,22 @@
+-- +goose Up
+-- Asserções tipadas de cada suíte de teste, avaliadas na ordem de "position"
+CREATE TABLE IF NOT EXISTS "test_suite_assertions" (
+  "id" uuid NOT NULL,
+  "test_suite_id" uuid NOT NULL,
+  "type" text NOT NULL,
+  "target" text NOT NULL DEFAULT '',
+  "expected" text NOT NULL DEFAULT '',
+  "position" integer NOT NULL DEFAULT 0,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_test_suite_assertions_test_suite" FOREIGN KEY ("test_suite_id") REFERENCES "test_suites" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_test_suite_assertions_test_suite_id" ON "test_suite_assertions" ("test_suite_id", "position");
+
+-- Resultado individual de cada asserção na execução
+ALTER TABLE "test_results" ADD COLUMN IF NOT EXISTS "assertion_results" jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE "test_results" DROP COLUMN IF EXISTS "assertion_results";
+DROP TABLE IF EXISTS "test_suite_assertions";
@@ -0,0 +1,30 @@
+-- +goose Up
+-- JSON Schemas (draft 2020-12) armazenados por empresa
+CREATE TABLE IF NOT EXISTS "json_schemas" (
+  "id" uuid NOT NULL,
+  "company_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "description" text NOT NULL DEFAULT '',
+  "schema" jsonb NOT NULL,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_json_schemas_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_json_schemas_company_id" ON "json_schemas" ("company_id");
+
+-- Schema da resposta de cada suíte: inline ou referência a um schema armazenado
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "response_schema" jsonb;
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "response_schema_id" uuid;
+ALTER TABLE "test_suites" DROP CONSTRAINT IF EXISTS "fk_test_suites_response_schema";
+ALTER TABLE "test_suites" ADD CONSTRAINT "fk_test_suites_response_schema" FOREIGN KEY ("response_schema_id") REFERENCES "json_schemas" ("id") ON UPDATE NO ACTION ON DELETE RESTRICT;
+
+-- Violações do schema encontradas na resposta
+ALTER TABLE "test_results" ADD COLUMN IF NOT EXISTS "schema_violations" jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE "test_results" DROP COLUMN IF EXISTS "schema_violations";
+ALTER TABLE "test_suites" DROP CONSTRAINT IF EXISTS "fk_test_suites_response_schema";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "response_schema_id";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "response_schema";
+DROP TABLE IF EXISTS "json_schemas";
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Headers passam de texto ("Chave: Valor" por linha) para um objeto JSON
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "headers_map" jsonb NOT NULL DEFAULT '{}';
+UPDATE "test_suites" SET "headers_map" = COALESCE((
+  SELECT jsonb_object_agg(trim(split_part(line, ':', 1)), trim(substr(line, strpos(line, ':') + 1)))
+  FROM regexp_split_to_table("headers", E'\r?\n') AS line
+  WHERE strpos(line, ':') > 1 AND trim(split_part(line, ':', 1)) <> ''
+), '{}'::jsonb)
+WHERE "headers" IS NOT NULL AND trim("headers") <> '';
+ALTER TABLE "test_suites" DROP COLUMN "headers";
+ALTER TABLE "test_suites" RENAME COLUMN "headers_map" TO "headers";
+
+-- Parâmetros de query e corpo da requisição
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "query_params" jsonb NOT NULL DEFAULT '{}';
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "body_type" text NOT NULL DEFAULT 'none';
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "body" text NOT NULL DEFAULT '';
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "form_fields" jsonb NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "form_fields";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "body";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "body_type";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "query_params";
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "headers_text" text;
+UPDATE "test_suites" SET "headers_text" = (
+  SELECT string_agg(key || ': ' || value, E'\n' ORDER BY key)
+  FROM jsonb_each_text("headers")
+);
+ALTER TABLE "test_suites" DROP COLUMN "headers";
+ALTER TABLE "test_suites" RENAME COLUMN "headers_text" TO "headers";
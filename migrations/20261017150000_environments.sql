@@ -0,0 +1,23 @@
+-- +goose Up
+-- Ambientes da empresa com as variáveis usadas em {{variável}} nas suítes
+CREATE TABLE IF NOT EXISTS "environments" (
+  "id" uuid NOT NULL,
+  "company_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "variables" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_environments_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_environments_company_id_name" ON "environments" ("company_id", "name");
+
+-- Ambiente escolhido ao disparar a execução
+ALTER TABLE "test_runs" ADD COLUMN IF NOT EXISTS "environment_id" uuid;
+ALTER TABLE "test_runs" DROP CONSTRAINT IF EXISTS "fk_test_runs_environment";
+ALTER TABLE "test_runs" ADD CONSTRAINT "fk_test_runs_environment" FOREIGN KEY ("environment_id") REFERENCES "environments" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE "test_runs" DROP CONSTRAINT IF EXISTS "fk_test_runs_environment";
+ALTER TABLE "test_runs" DROP COLUMN IF EXISTS "environment_id";
+DROP TABLE IF EXISTS "environments";
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Segredos da empresa, cifrados com AES-GCM (nonce || ciphertext || tag)
+CREATE TABLE IF NOT EXISTS "secrets" (
+  "id" uuid NOT NULL,
+  "company_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "encrypted_value" bytea NOT NULL,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_secrets_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_secrets_company_id_name" ON "secrets" ("company_id", "name");
+
+-- +goose Down
+DROP TABLE IF EXISTS "secrets";
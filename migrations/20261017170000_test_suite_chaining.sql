@@ -0,0 +1,26 @@
+-- +goose Up
+-- Regras de extração de valores da resposta para variáveis da execução ({{extracted.nome}})
+CREATE TABLE IF NOT EXISTS "test_suite_extractions" (
+  "id" uuid NOT NULL,
+  "test_suite_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "source" text NOT NULL,
+  "expression" text NOT NULL,
+  "position" integer NOT NULL DEFAULT 0,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_test_suite_extractions_test_suite" FOREIGN KEY ("test_suite_id") REFERENCES "test_suites" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_test_suite_extractions_test_suite_id" ON "test_suite_extractions" ("test_suite_id", "position");
+
+-- Suítes que precisam passar antes desta na mesma execução
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "depends_on" uuid[] NOT NULL DEFAULT '{}';
+
+-- Suítes puladas porque uma dependência não passou
+ALTER TABLE "test_runs" ADD COLUMN IF NOT EXISTS "skipped_tests" integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE "test_runs" DROP COLUMN IF EXISTS "skipped_tests";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "depends_on";
+DROP TABLE IF EXISTS "test_suite_extractions";
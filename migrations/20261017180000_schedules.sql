@@ -0,0 +1,33 @@
+-- +goose Up
+-- Agendamentos cron que enfileiram execuções da empresa
+CREATE TABLE IF NOT EXISTS "schedules" (
+  "id" uuid NOT NULL,
+  "company_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "cron_expression" text NOT NULL,
+  "timezone" text NOT NULL DEFAULT 'UTC',
+  "test_suite_ids" uuid[],
+  "environment_id" uuid,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "next_run_at" timestamp,
+  "last_run_at" timestamp,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_schedules_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_schedules_environment" FOREIGN KEY ("environment_id") REFERENCES "environments" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_schedules_company_id_name" ON "schedules" ("company_id", "name");
+
+-- Índice usado pelo agendador para encontrar os disparos vencidos
+CREATE INDEX IF NOT EXISTS "idx_schedules_next_run_at" ON "schedules" ("next_run_at") WHERE "enabled";
+
+-- Agendamento que disparou a execução, se houver
+ALTER TABLE "test_runs" ADD COLUMN IF NOT EXISTS "schedule_id" uuid;
+ALTER TABLE "test_runs" DROP CONSTRAINT IF EXISTS "fk_test_runs_schedule";
+ALTER TABLE "test_runs" ADD CONSTRAINT "fk_test_runs_schedule" FOREIGN KEY ("schedule_id") REFERENCES "schedules" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE "test_runs" DROP CONSTRAINT IF EXISTS "fk_test_runs_schedule";
+ALTER TABLE "test_runs" DROP COLUMN IF EXISTS "schedule_id";
+DROP TABLE IF EXISTS "schedules";
@@ -0,0 +1,39 @@
+-- +goose Up
+-- URLs da empresa que recebem eventos assinados com HMAC-SHA256
+CREATE TABLE IF NOT EXISTS "webhooks" (
+  "id" uuid NOT NULL,
+  "company_id" uuid NOT NULL,
+  "url" text NOT NULL,
+  "events" text[] NOT NULL DEFAULT '{}',
+  "enabled" boolean NOT NULL DEFAULT true,
+  "encrypted_secret" bytea NOT NULL,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_webhooks_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_webhooks_company_id" ON "webhooks" ("company_id");
+
+-- Entregas de eventos com o histórico de tentativas
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+  "id" uuid NOT NULL,
+  "webhook_id" uuid NOT NULL,
+  "event" text NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" text NOT NULL,
+  "attempts" jsonb NOT NULL DEFAULT '[]',
+  "next_attempt_at" timestamp,
+  "delivered_at" timestamp,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_webhook_deliveries_webhook" FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_webhook_id_created_at" ON "webhook_deliveries" ("webhook_id", "created_at");
+
+-- Índice usado pelo despachante para encontrar as entregas vencidas
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_next_attempt_at" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
+
+-- +goose Down
+DROP TABLE IF EXISTS "webhook_deliveries";
+DROP TABLE IF EXISTS "webhooks";
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Permite que cada usuário desative os alertas por email das execuções com falha
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "email_alerts" boolean NOT NULL DEFAULT true;
+
+-- +goose Down
+ALTER TABLE "users" DROP COLUMN IF EXISTS "email_alerts";
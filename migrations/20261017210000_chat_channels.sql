@@ -0,0 +1,19 @@
+-- +goose Up
+-- Canais de chat (Slack/Teams) que recebem o resumo das execuções por webhook de entrada
+CREATE TABLE IF NOT EXISTS "chat_channels" (
+  "id" uuid NOT NULL,
+  "company_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "provider" text NOT NULL,
+  "encrypted_url" bytea NOT NULL,
+  "only_failures" boolean NOT NULL DEFAULT false,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "created_at" timestamp,
+  "updated_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_chat_channels_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_chat_channels_company_id" ON "chat_channels" ("company_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "chat_channels";
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Chave estável das suítes geradas por importação (ex.: operationId do OpenAPI), usada para
+-- atualizar as suítes existentes ao reimportar o documento em vez de duplicá-las
+ALTER TABLE "test_suites" ADD COLUMN IF NOT EXISTS "import_key" text;
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_test_suites_company_import_key" ON "test_suites" ("company_id", "import_key") WHERE "import_key" IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS "idx_test_suites_company_import_key";
+ALTER TABLE "test_suites" DROP COLUMN IF EXISTS "import_key";
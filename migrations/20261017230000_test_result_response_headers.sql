@@ -0,0 +1,6 @@
+-- +goose Up
+-- Cabeçalhos da resposta de cada suíte executada, exibidos no relatório HTML da execução
+ALTER TABLE "test_results" ADD COLUMN IF NOT EXISTS "response_headers" jsonb NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE "test_results" DROP COLUMN IF EXISTS "response_headers";
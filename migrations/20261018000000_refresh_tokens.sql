@@ -0,0 +1,21 @@
+-- +goose Up
+-- Refresh tokens emitidos no login, gravados apenas pelo hash. Cada uso gera um novo token na mesma
+-- família; reutilizar um token já usado revoga a família inteira.
+CREATE TABLE IF NOT EXISTS "refresh_tokens" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "family_id" uuid NOT NULL,
+  "token_hash" text NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "used_at" timestamp,
+  "revoked_at" timestamp,
+  "created_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_refresh_tokens_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_refresh_tokens_token_hash" ON "refresh_tokens" ("token_hash");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_family_id" ON "refresh_tokens" ("family_id");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_user_id" ON "refresh_tokens" ("user_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "refresh_tokens";
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Access tokens revogados antes de expirar. Com token_id, revoga o token com aquele jti;
+-- sem token_id, revoga todos os tokens do usuário emitidos até created_at ("sair de todas as sessões").
+-- As linhas só são necessárias até expires_at, quando os tokens revogados já expiraram.
+CREATE TABLE IF NOT EXISTS "token_revocations" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "token_id" text,
+  "expires_at" timestamp NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_token_revocations_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_token_revocations_created_at" ON "token_revocations" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_token_revocations_expires_at" ON "token_revocations" ("expires_at");
+
+-- +goose Down
+DROP TABLE IF EXISTS "token_revocations";
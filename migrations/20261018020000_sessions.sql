@@ -0,0 +1,23 @@
+-- +goose Up
+-- Sessões dos usuários: uma por família de refresh tokens (o id da sessão é o family_id),
+-- com o dispositivo e o endereço do último uso
+CREATE TABLE IF NOT EXISTS "sessions" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "user_agent" text NOT NULL DEFAULT '',
+  "ip_address" text NOT NULL DEFAULT '',
+  "created_at" timestamp NOT NULL DEFAULT NOW(),
+  "last_used_at" timestamp NOT NULL DEFAULT NOW(),
+  "expires_at" timestamp NOT NULL,
+  "revoked_at" timestamp,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_sessions_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_sessions_user_id" ON "sessions" ("user_id");
+
+-- Revogação dos access tokens de uma sessão, ao encerrá-la
+ALTER TABLE "token_revocations" ADD COLUMN IF NOT EXISTS "session_id" uuid;
+
+-- +goose Down
+ALTER TABLE "token_revocations" DROP COLUMN IF EXISTS "session_id";
+DROP TABLE IF EXISTS "sessions";
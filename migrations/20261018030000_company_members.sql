@@ -0,0 +1,27 @@
+-- +goose Up
+-- Membros das empresas e o papel de cada um (owner, admin, editor ou viewer)
+CREATE TABLE IF NOT EXISTS "company_members" (
+  "company_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "role" text NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT NOW(),
+  "updated_at" timestamp NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("company_id", "user_id"),
+  CONSTRAINT "chk_company_members_role" CHECK ("role" IN ('owner', 'admin', 'editor', 'viewer')),
+  CONSTRAINT "fk_company_members_company" FOREIGN KEY ("company_id") REFERENCES "companies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_company_members_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_company_members_user_id" ON "company_members" ("user_id");
+
+-- Os usuários já vinculados passam a ser membros: o primeiro vinculado a cada empresa vira owner
+-- e os demais, editors
+INSERT INTO "company_members" ("company_id", "user_id", "role", "created_at", "updated_at")
+SELECT "company_id", "id",
+  CASE WHEN ROW_NUMBER() OVER (PARTITION BY "company_id" ORDER BY "created_at", "id") = 1 THEN 'owner' ELSE 'editor' END,
+  NOW(), NOW()
+FROM "users"
+WHERE "company_id" IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS "company_members";
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Indica que o corpo da resposta passou do limite lido pelo executor e foi gravado cortado
+ALTER TABLE "test_results" ADD COLUMN IF NOT EXISTS "response_body_truncated" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE "test_results" DROP COLUMN IF EXISTS "response_body_truncated";
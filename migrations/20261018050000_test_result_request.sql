@@ -0,0 +1,8 @@
+-- +goose Up
+-- Guarda o nome da suíte e a requisição enviada, com as variáveis substituídas, no momento da execução
+ALTER TABLE "test_results" ADD COLUMN IF NOT EXISTS "test_suite_name" text NOT NULL DEFAULT '';
+ALTER TABLE "test_results" ADD COLUMN IF NOT EXISTS "request" jsonb;
+
+-- +goose Down
+ALTER TABLE "test_results" DROP COLUMN IF EXISTS "request";
+ALTER TABLE "test_results" DROP COLUMN IF EXISTS "test_suite_name";
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Heartbeat renovado pela instância que executa a execução; só execuções com heartbeat expirado voltam à fila
+ALTER TABLE "test_runs" ADD COLUMN IF NOT EXISTS "heartbeat_at" timestamptz;
+UPDATE "test_runs" SET "heartbeat_at" = "updated_at" WHERE "status" = 'running';
+CREATE INDEX IF NOT EXISTS "idx_test_runs_running_heartbeat_at" ON "test_runs" ("heartbeat_at") WHERE "status" = 'running';
+
+-- +goose Down
+DROP INDEX IF EXISTS "idx_test_runs_running_heartbeat_at";
+ALTER TABLE "test_runs" DROP COLUMN IF EXISTS "heartbeat_at";